@@ -0,0 +1,110 @@
+package dcgm
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const defaultHostenginePortString = "5555"
+
+// parseAddress splits a TCP/IP nv-hostengine address into host and port. It accepts host names,
+// IPv4 addresses and IPv6 addresses, bare or in brackets, optionally followed by ":port".
+// The port defaults to 5555.
+func parseAddress(addr string) (host, port string, err error) {
+	invalid := func(reason string) (string, string, error) {
+		return "", "", fmt.Errorf("%w %q: %s", ErrInvalidAddress, addr, reason)
+	}
+
+	if addr == "" {
+		return invalid("address is empty")
+	}
+
+	switch {
+	case strings.HasPrefix(addr, "[") && strings.HasSuffix(addr, "]"):
+		host, port = addr[1:len(addr)-1], defaultHostenginePortString
+	case strings.HasPrefix(addr, "["):
+		if host, port, err = net.SplitHostPort(addr); err != nil {
+			return invalid("expected [ipv6]:port")
+		}
+	case strings.Count(addr, ":") > 1:
+		// A bare IPv6 literal, which cannot carry a port
+		host, port = addr, defaultHostenginePortString
+	case strings.Contains(addr, ":"):
+		if host, port, err = net.SplitHostPort(addr); err != nil {
+			return invalid("expected host:port")
+		}
+	default:
+		host, port = addr, defaultHostenginePortString
+	}
+
+	if n, err := strconv.ParseUint(port, 10, 16); err != nil || n == 0 {
+		return invalid(fmt.Sprintf("port %q is not a number between 1 and 65535", port))
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return host, port, nil
+	}
+	if strings.Contains(host, ":") {
+		return invalid(fmt.Sprintf("%q is not a valid IPv6 address", host))
+	}
+	if !validHostname(host) {
+		return invalid(fmt.Sprintf("%q is not a valid host name", host))
+	}
+
+	return host, port, nil
+}
+
+// resolveAddress validates addr and resolves a host name to an IP address, returning the
+// address in the host:port form passed to dcgmConnect_v2
+func resolveAddress(addr string, lookupHost func(string) ([]string, error)) (string, error) {
+	host, port, err := parseAddress(addr)
+	if err != nil {
+		return "", err
+	}
+
+	if net.ParseIP(host) == nil {
+		addrs, err := lookupHost(host)
+		if err != nil {
+			return "", fmt.Errorf("error resolving nv-hostengine address %q: %w", host, err)
+		}
+		if len(addrs) == 0 {
+			return "", fmt.Errorf("error resolving nv-hostengine address %q: no addresses found", host)
+		}
+		host = preferIPv4(addrs)
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+// preferIPv4 returns the first IPv4 address in addrs, or the first address if there is none,
+// as nv-hostengine listens on IPv4 by default
+func preferIPv4(addrs []string) string {
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil && ip.To4() != nil {
+			return addr
+		}
+	}
+	return addrs[0]
+}
+
+// validHostname reports whether name is a syntactically valid DNS host name
+func validHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		addr         string
+		expectedHost string
+		expectedPort string
+	}{
+		{addr: "localhost", expectedHost: "localhost", expectedPort: "5555"},
+		{addr: "10.0.0.1:5556", expectedHost: "10.0.0.1", expectedPort: "5556"},
+		{addr: "node-1.cluster.local:6000", expectedHost: "node-1.cluster.local", expectedPort: "6000"},
+		{addr: "fd00::1", expectedHost: "fd00::1", expectedPort: "5555"},
+		{addr: "[fd00::1]", expectedHost: "fd00::1", expectedPort: "5555"},
+		{addr: "[fd00::1]:5556", expectedHost: "fd00::1", expectedPort: "5556"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			host, port, err := parseAddress(tt.addr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedHost, host)
+			assert.Equal(t, tt.expectedPort, port)
+		})
+	}
+}
+
+func TestParseAddressInvalid(t *testing.T) {
+	for _, addr := range []string{
+		"",
+		"localhost:",
+		"localhost:http",
+		"localhost:70000",
+		"10.0.0.1:0",
+		"[fd00::1",
+		"[fd00::1]:x",
+		"fd00::zz",
+		"bad_host!",
+		"-leading-dash",
+	} {
+		t.Run(addr, func(t *testing.T) {
+			_, _, err := parseAddress(addr)
+			assert.ErrorIs(t, err, ErrInvalidAddress)
+		})
+	}
+}
+
+func TestResolveAddress(t *testing.T) {
+	lookup := func(host string) ([]string, error) {
+		if host == "node-1" {
+			return []string{"fd00::1", "10.0.0.1"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	addr, err := resolveAddress("node-1:5556", lookup)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:5556", addr)
+
+	addr, err = resolveAddress("fd00::2", lookup)
+	require.NoError(t, err)
+	assert.Equal(t, "[fd00::2]:5555", addr)
+
+	_, err = resolveAddress("node-2", lookup)
+	require.Error(t, err)
+}
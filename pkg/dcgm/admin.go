@@ -17,24 +17,22 @@
 package dcgm
 
 /*
-#cgo linux LDFLAGS: -ldl -Wl,--export-dynamic -Wl,--unresolved-symbols=ignore-in-object-files
-#cgo darwin LDFLAGS: -ldl -Wl,-undefined,dynamic_lookup
-
-#include <dlfcn.h>
 #include "dcgm_agent.h"
 #include "dcgm_structs.h"
-
 */
 import "C"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/exec"
 	"strconv"
 	"syscall"
+	"time"
 	"unsafe"
 )
 
@@ -49,184 +47,381 @@ const (
 
 type dcgmHandle struct{ handle C.dcgmHandle_t }
 
-var (
-	dcgmLibHandle        unsafe.Pointer
-	stopMode             mode
-	handle               dcgmHandle
-	hostengineAsChildPid int
-	socketPath           string
-)
+func newClient(m mode, args ...string) (*Client, error) {
+	return newClientContext(context.Background(), m, args...)
+}
 
-func initDCGM(m mode, args ...string) (err error) {
-	const (
-		dcgmLib = "libdcgm.so.4"
-	)
-	lib := C.CString(dcgmLib)
-	defer freeCString(lib)
+func newClientContext(ctx context.Context, m mode, args ...string) (*Client, error) {
+	c := &Client{mode: m}
 
-	dcgmLibHandle = C.dlopen(lib, C.RTLD_LAZY|C.RTLD_GLOBAL)
-	if dcgmLibHandle == nil {
-		return fmt.Errorf("%s not found", dcgmLib)
+	switch m {
+	case Embedded:
+		opMode, err := parseEmbeddedArgs(args...)
+		if err != nil {
+			return nil, err
+		}
+		c.opMode = opMode
+	case Standalone:
+		opts, err := parseConnectArgs(args...)
+		if err != nil {
+			return nil, err
+		}
+		c.connectOpts = opts
+	case StartHostengine:
+	default:
+		return nil, ErrInvalidMode
 	}
 
-	// set the stopMode for shutdown()
-	stopMode = m
+	return c.openContext(ctx)
+}
 
-	switch m {
+// openContext opens the client in the background and abandons it if ctx is done
+// first. An abandoned client is closed as soon as the pending call into DCGM returns.
+func (c *Client) openContext(ctx context.Context) (*Client, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("error initializing DCGM: %w", err)
+	}
+
+	if ctx.Done() == nil {
+		if err := c.open(ctx); err != nil {
+			return nil, err
+		}
+		c.watchForLeaks()
+		return c, nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.open(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+		c.watchForLeaks()
+		return c, nil
+	case <-ctx.Done():
+		go func() {
+			if err := <-done; err == nil {
+				_ = c.Close()
+			}
+		}()
+		return nil, fmt.Errorf("error initializing DCGM: %w", ctx.Err())
+	}
+}
+
+func (c *Client) open(ctx context.Context) (err error) {
+	if err = loadLibrary(); err != nil {
+		return
+	}
+
+	switch c.mode {
 	case Embedded:
-		return startEmbedded()
+		err = c.startEmbedded()
 	case Standalone:
-		return connectStandalone(args...)
+		err = c.connectStandaloneContext(ctx)
 	case StartHostengine:
-		return startHostengine()
+		err = c.startHostengine()
 	default:
-		panic(ErrInvalidMode)
+		err = ErrInvalidMode
+	}
+
+	if err != nil {
+		_ = unloadLibrary()
 	}
+	return
 }
 
-func shutdown() (err error) {
-	switch stopMode {
+func (c *Client) shutdown() (err error) {
+	switch c.mode {
 	case Embedded:
-		err = stopEmbedded()
+		err = c.stopEmbedded()
 	case Standalone:
-		err = disconnectStandalone()
+		err = c.disconnectStandalone()
 	case StartHostengine:
-		err = stopHostengine()
+		err = c.stopHostengine()
 	}
 
-	C.dlclose(dcgmLibHandle)
+	if unloadErr := unloadLibrary(); err == nil {
+		err = unloadErr
+	}
 	return
 }
 
-func startEmbedded() (err error) {
-	result := C.dcgmInit()
-	if err = errorString(result); err != nil {
-		return fmt.Errorf("error initializing DCGM: %s", err)
+// parseEmbeddedArgs converts the optional Init argument for Embedded mode, "auto" or
+// "manual", into an OperationMode
+func parseEmbeddedArgs(args ...string) (OperationMode, error) {
+	if len(args) == 0 {
+		return DCGM_OPERATION_MODE_AUTO, nil
+	}
+
+	switch args[0] {
+	case "auto":
+		return DCGM_OPERATION_MODE_AUTO, nil
+	case "manual":
+		return DCGM_OPERATION_MODE_MANUAL, nil
+	default:
+		return 0, fmt.Errorf("invalid operation mode %q, expected \"auto\" or \"manual\"", args[0])
+	}
+}
+
+func (c *Client) startEmbedded() (err error) {
+	opMode := c.opMode
+	if opMode == 0 {
+		opMode = DCGM_OPERATION_MODE_AUTO
 	}
 
 	var cHandle C.dcgmHandle_t
-	result = C.dcgmStartEmbedded(C.DCGM_OPERATION_MODE_AUTO, &cHandle)
+	result := C.dcgmStartEmbedded(C.dcgmOperationMode_t(opMode), &cHandle)
 	if err = errorString(result); err != nil {
-		return fmt.Errorf("error starting nv-hostengine: %s", err)
+		return fmt.Errorf("error starting nv-hostengine: %w", &Error{msg: C.GoString(C.errorString(result)), Code: result})
 	}
-	handle = dcgmHandle{cHandle}
+	c.handle = dcgmHandle{cHandle}
 	return
 }
 
-func stopEmbedded() (err error) {
-	result := C.dcgmStopEmbedded(handle.handle)
+func (c *Client) stopEmbedded() (err error) {
+	result := C.dcgmStopEmbedded(c.handle.handle)
 	if err = errorString(result); err != nil {
-		return fmt.Errorf("error stopping nv-hostengine: %s", err)
+		return fmt.Errorf("error stopping nv-hostengine: %w", &Error{msg: C.GoString(C.errorString(result)), Code: result})
 	}
+	return
+}
 
-	result = C.dcgmShutdown()
-	if err = errorString(result); err != nil {
-		return fmt.Errorf("error shutting down DCGM: %s", err)
+// parseConnectArgs converts the Init arguments for Standalone mode, an address
+// followed by "1" for a Unix socket or "0" for TCP/IP and optionally "1" to persist
+// state after disconnect, into ConnectOptions
+func parseConnectArgs(args ...string) (ConnectOptions, error) {
+	if len(args) < 2 {
+		return ConnectOptions{}, errors.New("missing dcgm address and / or port")
 	}
-	return
+
+	sck, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return ConnectOptions{}, fmt.Errorf("error parsing %s: %v", args[1], err)
+	}
+
+	opts := ConnectOptions{
+		Addr:       args[0],
+		UnixSocket: sck != 0,
+	}
+
+	if !opts.UnixSocket {
+		if _, _, err = parseAddress(opts.Addr); err != nil {
+			return ConnectOptions{}, err
+		}
+	}
+
+	if len(args) > 2 {
+		persist, err := strconv.ParseUint(args[2], 10, 32)
+		if err != nil {
+			return ConnectOptions{}, fmt.Errorf("error parsing %s: %v", args[2], err)
+		}
+		opts.PersistAfterDisconnect = persist != 0
+	}
+
+	return opts, nil
 }
 
-func connectStandalone(args ...string) (err error) {
+func (c *Client) connectStandalone(opts ConnectOptions) (err error) {
 	var (
 		cHandle       C.dcgmHandle_t
 		connectParams C.dcgmConnectV2Params_v2
 	)
 
-	if len(args) < 2 {
-		return errors.New("missing dcgm address and / or port")
+	address := opts.Addr
+	if !opts.UnixSocket {
+		if address, err = resolveAddress(opts.Addr, net.LookupHost); err != nil {
+			return
+		}
 	}
 
-	result := C.dcgmInit()
+	addr := C.CString(address)
+	defer freeCString(addr)
+	connectParams.version = makeVersion2(unsafe.Sizeof(connectParams))
+	connectParams.addressIsUnixSocket = boolToCUint(opts.UnixSocket)
+	connectParams.persistAfterDisconnect = boolToCUint(opts.PersistAfterDisconnect)
+	connectParams.timeoutMs = C.uint(opts.TimeoutMs)
+
+	result := C.dcgmConnect_v2(addr, &connectParams, &cHandle)
 	if err = errorString(result); err != nil {
-		return fmt.Errorf("error initializing DCGM: %s", err)
+		return &ErrConnectFailed{Addr: address, Code: int(result), msg: err.Error()}
 	}
 
-	addr := C.CString(args[0])
-	defer freeCString(addr)
-	connectParams.version = makeVersion2(unsafe.Sizeof(connectParams))
+	c.handle = dcgmHandle{cHandle}
 
-	sck, err := strconv.ParseUint(args[1], 10, 32)
-	if err != nil {
-		return fmt.Errorf("error parsing %s: %v", args[1], err)
+	return
+}
+
+// connectStandaloneContext connects to nv-hostengine as described by c.connectOpts, starting
+// a relay first if a Dialer is configured
+func (c *Client) connectStandaloneContext(ctx context.Context) (err error) {
+	if c.connectOpts.Dialer != nil {
+		if c.relay, err = startRelay(c.connectOpts.Dialer); err != nil {
+			return fmt.Errorf("error starting relay to nv-hostengine: %s", err)
+		}
+		c.connectOpts.Addr = c.relay.path
+		c.connectOpts.UnixSocket = true
 	}
-	connectParams.addressIsUnixSocket = C.uint(sck)
 
-	result = C.dcgmConnect_v2(addr, &connectParams, &cHandle)
-	if err = errorString(result); err != nil {
-		return fmt.Errorf("error connecting to nv-hostengine: %s", err)
+	if err = c.connectWithRetry(ctx, c.connectOpts); err != nil && c.relay != nil {
+		_ = c.relay.close()
+		c.relay = nil
 	}
+	return
+}
 
-	handle = dcgmHandle{cHandle}
+// connectWithRetry connects as described by opts, retrying according to opts.Retry until ctx
+// is done. The deadline of ctx, if any, also bounds the timeout of each attempt.
+func (c *Client) connectWithRetry(ctx context.Context, opts ConnectOptions) (err error) {
+	backoff := opts.Retry.initialBackoff()
+	for attempt := 1; ; attempt++ {
+		attemptOpts := opts
+		attemptOpts.TimeoutMs = timeoutWithin(ctx, opts.TimeoutMs)
+		if err = c.connectStandalone(attemptOpts); err == nil {
+			return nil
+		}
+
+		if attempt >= opts.Retry.Attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, opts.Retry.maxBackoff())
+	}
+}
 
-	return
+// timeoutWithin returns the shorter of timeoutMs and the time left until the deadline of ctx.
+// Zero selects the DCGM default.
+func timeoutWithin(ctx context.Context, timeoutMs uint) uint {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return timeoutMs
+	}
+
+	ctxTimeoutMs := uint(max(time.Until(deadline).Milliseconds(), 1))
+	if timeoutMs == 0 || ctxTimeoutMs < timeoutMs {
+		return ctxTimeoutMs
+	}
+	return timeoutMs
 }
 
-func disconnectStandalone() (err error) {
-	result := C.dcgmDisconnect(handle.handle)
+func (c *Client) disconnectStandalone() (err error) {
+	result := C.dcgmDisconnect(c.handle.handle)
 	if err = errorString(result); err != nil {
-		return fmt.Errorf("error disconnecting from nv-hostengine: %s", err)
+		err = fmt.Errorf("error disconnecting from nv-hostengine: %w", &Error{msg: C.GoString(C.errorString(result)), Code: result})
 	}
 
-	result = C.dcgmShutdown()
-	if err = errorString(result); err != nil {
-		return fmt.Errorf("error shutting down DCGM: %s", err)
+	if c.relay != nil {
+		if relayErr := c.relay.close(); err == nil {
+			err = relayErr
+		}
+		c.relay = nil
 	}
 	return
 }
 
-func startHostengine() (err error) {
-	var (
-		procAttr      syscall.ProcAttr
-		cHandle       C.dcgmHandle_t
-		connectParams C.dcgmConnectV2Params_v2
-	)
+func (c *Client) startHostengine() (err error) {
+	opts := c.hostengineOpts
+	if !opts.TCP {
+		dir := opts.SocketDir
+		if dir == "" {
+			dir = defaultHostengineSocketDir
+		}
+		tmpfile, err := os.CreateTemp(dir, "dcgm")
+		if err != nil {
+			return fmt.Errorf("error creating temporary file in %s directory: %s", dir, err)
+		}
+		tmpfile.Close()
+		c.socketPath = tmpfile.Name()
+	}
+
+	if c.hostengineAsChildPid, err = c.spawnHostengine(); err != nil {
+		return
+	}
+
+	if opts.TCP {
+		c.connectOpts = ConnectOptions{Addr: opts.address()}
+	} else {
+		c.connectOpts = ConnectOptions{Addr: c.socketPath, UnixSocket: true}
+	}
+
+	if err = c.connectSpawned(); err != nil {
+		return
+	}
+
+	if opts.Supervisor != nil {
+		c.startSupervisor(*opts.Supervisor)
+	}
+	return
+}
+
+// spawnHostengine fork-execs nv-hostengine and returns its pid. Its output is forwarded
+// to the configured logger.
+func (c *Client) spawnHostengine() (pid int, err error) {
+	var procAttr syscall.ProcAttr
 
 	bin, err := exec.LookPath("nv-hostengine")
 	if err != nil {
-		return fmt.Errorf("error finding nv-hostengine: %s", err)
+		return 0, fmt.Errorf("%w: %s", ErrHostengineNotFound, err)
+	}
+
+	opts := c.hostengineOpts
+
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		return 0, fmt.Errorf("error creating pipe for nv-hostengine output: %s", err)
+	}
+	stderrR, stderrW, err := os.Pipe()
+	if err != nil {
+		stdoutR.Close()
+		stdoutW.Close()
+		return 0, fmt.Errorf("error creating pipe for nv-hostengine output: %s", err)
 	}
+
 	procAttr.Files = []uintptr{
 		uintptr(syscall.Stdin),
-		uintptr(syscall.Stdout),
-		uintptr(syscall.Stderr),
+		stdoutW.Fd(),
+		stderrW.Fd(),
 	}
 	procAttr.Sys = &syscall.SysProcAttr{Setpgid: true}
 
-	dir := "/tmp"
-	tmpfile, err := os.CreateTemp(dir, "dcgm")
-	if err != nil {
-		return fmt.Errorf("error creating temporary file in %s directory: %s", dir, err)
+	// The write ends belong to the child once it is started
+	defer stdoutW.Close()
+	defer stderrW.Close()
+
+	args := opts.args(bin, c.socketPath)
+	if opts.Supervisor != nil {
+		// The supervisor waits on the child, so it must not daemonize
+		args = append(args, "--no-daemon")
 	}
-	socketPath = tmpfile.Name()
 
-	connectArg := "--domain-socket"
-	hostengineAsChildPid, err = syscall.ForkExec(bin, []string{bin, connectArg, socketPath}, &procAttr)
+	pid, err = syscall.ForkExec(bin, args, &procAttr)
 	if err != nil {
-		return fmt.Errorf("error fork-execing nv-hostengine: %s", err)
+		stdoutR.Close()
+		stderrR.Close()
+		return 0, fmt.Errorf("error fork-execing nv-hostengine: %s", err)
 	}
 
-	result := C.dcgmInit()
-	if err = errorString(result); err != nil {
-		return fmt.Errorf("error initializing DCGM: %s", err)
-	}
+	go opts.forwardOutput(stdoutR)
+	go opts.forwardOutput(stderrR)
 
-	connectParams.version = makeVersion2(unsafe.Sizeof(connectParams))
-	isSocket := C.uint(1)
-	connectParams.addressIsUnixSocket = isSocket
-	cSockPath := C.CString(socketPath)
-	defer freeCString(cSockPath)
-	result = C.dcgmConnect_v2(cSockPath, &connectParams, &cHandle)
-	if err = errorString(result); err != nil {
-		return fmt.Errorf("error connecting to nv-hostengine: %s", err)
-	}
-
-	handle = dcgmHandle{cHandle}
-	return
+	return pid, nil
 }
 
-func stopHostengine() (err error) {
-	defer os.Remove(socketPath)
-	if err = disconnectStandalone(); err != nil {
+func (c *Client) stopHostengine() (err error) {
+	c.stopSupervisor()
+
+	if c.socketPath != "" {
+		defer os.Remove(c.socketPath)
+	}
+	if err = c.disconnectStandalone(); err != nil {
 		return
 	}
 
@@ -238,5 +433,10 @@ func stopHostengine() (err error) {
 
 	log.Println("Successfully terminated nv-hostengine.")
 
-	return syscall.Kill(hostengineAsChildPid, syscall.SIGKILL)
+	err = syscall.Kill(c.childPid(), syscall.SIGKILL)
+	if errors.Is(err, syscall.ESRCH) {
+		// The child has already exited and been reaped by the supervisor
+		return nil
+	}
+	return err
 }
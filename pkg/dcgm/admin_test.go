@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConnectArgs(t *testing.T) {
+	opts, err := parseConnectArgs("localhost", "0")
+	require.NoError(t, err)
+	assert.Equal(t, ConnectOptions{Addr: "localhost"}, opts)
+
+	opts, err = parseConnectArgs("/tmp/nv-hostengine", "1")
+	require.NoError(t, err)
+	assert.Equal(t, ConnectOptions{Addr: "/tmp/nv-hostengine", UnixSocket: true}, opts)
+
+	_, err = parseConnectArgs("localhost")
+	require.Error(t, err)
+
+	_, err = parseConnectArgs("localhost", "yes")
+	require.Error(t, err)
+}
+
+func TestParseConnectArgsPersist(t *testing.T) {
+	opts, err := parseConnectArgs("localhost", "0", "1")
+	require.NoError(t, err)
+	assert.True(t, opts.PersistAfterDisconnect)
+
+	opts, err = parseConnectArgs("localhost", "0", "0")
+	require.NoError(t, err)
+	assert.False(t, opts.PersistAfterDisconnect)
+
+	_, err = parseConnectArgs("localhost", "0", "always")
+	require.Error(t, err)
+}
+
+func TestParseEmbeddedArgs(t *testing.T) {
+	opMode, err := parseEmbeddedArgs()
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_OPERATION_MODE_AUTO, opMode)
+
+	opMode, err = parseEmbeddedArgs("manual")
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_OPERATION_MODE_MANUAL, opMode)
+
+	_, err = parseEmbeddedArgs("sometimes")
+	require.Error(t, err)
+}
+
+func TestTimeoutWithin(t *testing.T) {
+	assert.Equal(t, uint(0), timeoutWithin(context.Background(), 0))
+	assert.Equal(t, uint(500), timeoutWithin(context.Background(), 500))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	assert.Equal(t, uint(500), timeoutWithin(ctx, 500))
+	assert.Greater(t, timeoutWithin(ctx, 0), uint(0))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.LessOrEqual(t, timeoutWithin(ctx, 5000), uint(100))
+}
@@ -0,0 +1,103 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// FieldAggregate reduces the values of one field across entities, e.g. to the hottest GPU
+// temperature of a node. Values of integer fields are converted to float64, and entities without
+// a valid value are left out. Min, Max, Avg and Sum are NaN if no entity has a valid value.
+type FieldAggregate struct {
+	FieldID Short
+	Min     float64
+	Max     float64
+	Avg     float64
+	Sum     float64
+	// MinEntity and MaxEntity are the entities holding the minimum and maximum
+	MinEntity Entity
+	MaxEntity Entity
+	// Count is the number of entities with a valid value
+	Count int
+
+	sorted []float64
+}
+
+// Percentile returns the p-th percentile, 0 <= p <= 100, of the values, interpolating linearly
+// between the closest values. It returns NaN if there are no values or p is out of range.
+func (a FieldAggregate) Percentile(p float64) float64 {
+	if len(a.sorted) == 0 || p < 0 || p > 100 {
+		return math.NaN()
+	}
+
+	rank := p / 100 * float64(len(a.sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := min(lower+1, len(a.sorted)-1)
+	return a.sorted[lower] + (rank-float64(lower))*(a.sorted[upper]-a.sorted[lower])
+}
+
+// AggregateValues reduces the values of field found in values across their entities. Values of
+// other fields are ignored.
+func AggregateValues(values []FieldValue_v2, field Short) FieldAggregate {
+	nan := math.NaN()
+	aggregate := FieldAggregate{FieldID: field, Min: nan, Max: nan, Avg: nan, Sum: nan}
+
+	for _, value := range values {
+		if value.FieldID != field || !value.IsValid() {
+			continue
+		}
+		v, ok := scaledValue(value, 1)
+		if !ok {
+			continue
+		}
+
+		entity := Entity{Group: value.EntityGroupId, ID: value.EntityID}
+		if aggregate.Count == 0 {
+			aggregate.Min, aggregate.Max, aggregate.Sum = v, v, 0
+			aggregate.MinEntity, aggregate.MaxEntity = entity, entity
+		}
+		if v < aggregate.Min {
+			aggregate.Min, aggregate.MinEntity = v, entity
+		}
+		if v > aggregate.Max {
+			aggregate.Max, aggregate.MaxEntity = v, entity
+		}
+		aggregate.Sum += v
+		aggregate.Count++
+		aggregate.sorted = append(aggregate.sorted, v)
+	}
+
+	if aggregate.Count > 0 {
+		aggregate.Avg = aggregate.Sum / float64(aggregate.Count)
+		slices.Sort(aggregate.sorted)
+	}
+	return aggregate
+}
+
+// AggregateGroupField reduces the latest values of a field across the entities of a group. The
+// field must be watched on the group.
+func AggregateGroupField(group GroupHandle, field Short) (FieldAggregate, error) {
+	return defaultClient.AggregateGroupField(group, field)
+}
+
+// AggregateGroupField reduces the latest values of a field across the entities of a group. The
+// field must be watched on the group.
+func (c *Client) AggregateGroupField(group GroupHandle, field Short) (FieldAggregate, error) {
+	if err := c.beginCall(); err != nil {
+		return FieldAggregate{}, err
+	}
+	defer c.endCall()
+
+	info, err := c.GetGroupInfo(group)
+	if err != nil {
+		return FieldAggregate{}, fmt.Errorf("error getting group members: %w", err)
+	}
+
+	values, err := c.EntitiesGetLatestValues(info.EntityList, []Short{field}, 0)
+	if err != nil {
+		return FieldAggregate{}, err
+	}
+
+	return AggregateValues(values, field), nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateValues(t *testing.T) {
+	aggregate := AggregateValues([]FieldValue_v2{
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, 60, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 1}, DCGM_FI_DEV_GPU_TEMP, 80, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 2}, DCGM_FI_DEV_GPU_TEMP, 40, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 3}, DCGM_FI_DEV_GPU_TEMP, 70, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 4}, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_BLANK, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 1}, DCGM_FI_DEV_POWER_USAGE, 500, 0),
+	}, DCGM_FI_DEV_GPU_TEMP)
+
+	assert.Equal(t, 4, aggregate.Count)
+	assert.InDelta(t, 40, aggregate.Min, 0)
+	assert.Equal(t, Entity{Group: FE_GPU, ID: 2}, aggregate.MinEntity)
+	assert.InDelta(t, 80, aggregate.Max, 0)
+	assert.Equal(t, Entity{Group: FE_GPU, ID: 1}, aggregate.MaxEntity)
+	assert.InDelta(t, 62.5, aggregate.Avg, 1e-9)
+	assert.InDelta(t, 250, aggregate.Sum, 0)
+	assert.InDelta(t, 40, aggregate.Percentile(0), 0)
+	assert.InDelta(t, 65, aggregate.Percentile(50), 1e-9)
+	assert.InDelta(t, 80, aggregate.Percentile(100), 0)
+	assert.True(t, math.IsNaN(aggregate.Percentile(101)))
+
+	empty := AggregateValues(nil, DCGM_FI_DEV_GPU_TEMP)
+	assert.Zero(t, empty.Count)
+	assert.True(t, math.IsNaN(empty.Max))
+	assert.True(t, math.IsNaN(empty.Percentile(50)))
+}
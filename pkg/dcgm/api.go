@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"strconv"
 	"sync"
 	"time"
 )
@@ -21,43 +20,65 @@ var (
 // - Standalone: Connect to an already running nv-hostengine
 // - StartHostengine: Start and connect to nv-hostengine, terminate before exiting
 // Returns a cleanup function and any error encountered
+// In Embedded mode args may hold the operation mode, "auto" (the default) or "manual".
+// In Standalone mode args are the address, "1" if it is a Unix socket or "0" otherwise,
+// and optionally "1" to keep groups and watches on the hostengine after disconnecting
 func Init(m mode, args ...string) (cleanup func(), err error) {
+	return InitWithContext(context.Background(), m, args...)
+}
+
+// InitWithContext is like Init but gives up once ctx is cancelled or its deadline expires.
+// The returned error wraps the context error in that case.
+func InitWithContext(ctx context.Context, m mode, args ...string) (cleanup func(), err error) {
 	mux.Lock()
 	defer mux.Unlock()
 
-	if dcgmInitCounter < 0 {
-		count := strconv.Itoa(dcgmInitCounter)
-		err = fmt.Errorf("shutdown() is called %s times, before init()", count[1:])
-	}
-
 	if dcgmInitCounter == 0 {
-		err = initDCGM(m, args...)
-		if err != nil {
-			return nil, err
+		client, initErr := newClientContext(ctx, m, args...)
+		if initErr != nil {
+			return nil, initErr
 		}
+		defaultClient = client
 	}
 
 	dcgmInitCounter += 1
 
+	var once sync.Once
 	return func() {
-		if shutdownErr := Shutdown(); shutdownErr != nil {
-			fmt.Fprintf(os.Stderr, "Failed to shutdown DCGM with error: `%v`", shutdownErr)
-		}
-	}, err
+		once.Do(func() {
+			if shutdownErr := Shutdown(); shutdownErr != nil {
+				fmt.Fprintf(os.Stderr, "Failed to shutdown DCGM with error: `%v`", shutdownErr)
+			}
+		})
+	}, nil
 }
 
 // Shutdown stops DCGM and destroys all connections
 // Returns an error if DCGM is not initialized
+// Init and Shutdown are reference counted: each successful Init must be matched by exactly one
+// Shutdown, or one call to the cleanup function it returned, and DCGM is only stopped by the
+// Shutdown matching the first Init. Calling the cleanup function more than once has no effect.
 func Shutdown() (err error) {
+	return ShutdownWithTimeout(0)
+}
+
+// ShutdownWithTimeout is like Shutdown but gives up waiting for calls in progress on other
+// goroutines after timeout. DCGM is left running and ErrShutdownTimeout is returned in that
+// case, so Shutdown can be retried. A timeout of zero waits indefinitely.
+func ShutdownWithTimeout(timeout time.Duration) (err error) {
 	mux.Lock()
 	defer mux.Unlock()
 
 	if dcgmInitCounter <= 0 {
-		err = errors.New("init() needs to be called before shutdown()")
+		return errors.New("init() needs to be called before shutdown()")
 	}
 
 	if dcgmInitCounter == 1 {
-		err = shutdown()
+		err = defaultClient.CloseWithTimeout(timeout)
+		if errors.Is(err, ErrShutdownTimeout) {
+			return
+		}
+		defaultClient = &Client{}
 	}
 
 	dcgmInitCounter -= 1
@@ -67,74 +88,107 @@ func Shutdown() (err error) {
 
 // GetAllDeviceCount returns the count of all GPUs in the system
 func GetAllDeviceCount() (uint, error) {
-	return getAllDeviceCount()
+	return defaultClient.GetAllDeviceCount()
 }
 
 // GetEntityGroupEntities returns all entities of the specified group type
 func GetEntityGroupEntities(entityGroup Field_Entity_Group) ([]uint, error) {
-	return getEntityGroupEntities(entityGroup)
+	return defaultClient.GetEntityGroupEntities(entityGroup)
 }
 
 // GetSupportedDevices returns a list of DCGM-supported GPU IDs
 func GetSupportedDevices() ([]uint, error) {
-	return getSupportedDevices()
+	return defaultClient.GetSupportedDevices()
 }
 
 // GetDeviceInfo returns detailed information about the specified GPU
 func GetDeviceInfo(gpuID uint) (Device, error) {
-	return getDeviceInfo(gpuID)
+	return defaultClient.GetDeviceInfo(gpuID)
+}
+
+// GetDeviceAttributes returns all the static attributes of the specified GPU, including its
+// supported clocks, thermal thresholds, power limits and memory usage
+func GetDeviceAttributes(gpuID uint) (DeviceAttributes, error) {
+	return defaultClient.GetDeviceAttributes(gpuID)
+}
+
+// GetPersistenceMode reports whether persistence mode is enabled on the specified GPU. DCGM can
+// only read this setting; it is enabled with nvidia-smi -pm 1 or nvmlDeviceSetPersistenceMode.
+func GetPersistenceMode(gpuID uint) (bool, error) {
+	return defaultClient.GetPersistenceMode(gpuID)
+}
+
+// GetAllDeviceInfo returns detailed information about all GPUs in the system in a single pass
+func GetAllDeviceInfo() ([]Device, error) {
+	return defaultClient.GetAllDeviceInfo()
+}
+
+// DeviceByUUID returns the ID of the GPU with the given UUID. The "GPU-" prefix is optional
+// and the comparison ignores case.
+func DeviceByUUID(uuid string) (uint, error) {
+	return defaultClient.DeviceByUUID(uuid)
+}
+
+// DeviceBySerial returns the ID of the GPU with the given board serial number
+func DeviceBySerial(serial string) (uint, error) {
+	return defaultClient.DeviceBySerial(serial)
+}
+
+// DeviceByPCIBusID returns the ID of the GPU at the given PCI address. Both the 4 and 8 digit
+// domain forms are accepted, e.g. "0000:3b:00.0" and "00000000:3B:00.0".
+func DeviceByPCIBusID(busID string) (uint, error) {
+	return defaultClient.DeviceByPCIBusID(busID)
 }
 
 // GetDeviceStatus returns current status information about the specified GPU
 func GetDeviceStatus(gpuID uint) (DeviceStatus, error) {
-	return latestValuesForDevice(gpuID)
+	return defaultClient.GetDeviceStatus(gpuID)
 }
 
 // GetDeviceTopology returns the topology (connectivity) information for the specified GPU
 func GetDeviceTopology(gpuID uint) ([]P2PLink, error) {
-	return getDeviceTopology(gpuID)
+	return defaultClient.GetDeviceTopology(gpuID)
 }
 
 // WatchPidFields configures DCGM to start recording stats for GPU processes
 // Must be called before GetProcessInfo
 func WatchPidFields() (GroupHandle, error) {
-	return watchPidFields(time.Microsecond*time.Duration(defaultUpdateFreq), time.Second*time.Duration(defaultMaxKeepAge), defaultMaxKeepSamples)
+	return defaultClient.WatchPidFields()
 }
 
 // GetProcessInfo returns detailed per-GPU statistics for the specified process
 func GetProcessInfo(group GroupHandle, pid uint) ([]ProcessInfo, error) {
-	return getProcessInfo(group, pid)
+	return defaultClient.GetProcessInfo(group, pid)
 }
 
 // HealthCheckByGpuId performs a health check on the specified GPU
 func HealthCheckByGpuId(gpuID uint) (DeviceHealth, error) {
-	return healthCheckByGpuId(gpuID)
+	return defaultClient.HealthCheckByGpuId(gpuID)
 }
 
 // ListenForPolicyViolations sets up monitoring for the specified policy conditions on all GPUs
 // Returns a channel that receives policy violations and any error encountered
 func ListenForPolicyViolations(ctx context.Context, typ ...policyCondition) (<-chan PolicyViolation, error) {
-	groupID := GroupAllGPUs()
-	return ListenForPolicyViolationsForGroup(ctx, groupID, typ...)
+	return defaultClient.ListenForPolicyViolations(ctx, typ...)
 }
 
 // ListenForPolicyViolationsForGroup sets up policy monitoring for the specified GPU group
 // Returns a channel that receives policy violations and any error encountered
 func ListenForPolicyViolationsForGroup(ctx context.Context, group GroupHandle, typ ...policyCondition) (<-chan PolicyViolation, error) {
-	return registerPolicy(ctx, group, typ...)
+	return defaultClient.ListenForPolicyViolationsForGroup(ctx, group, typ...)
 }
 
 // Introspect returns memory and CPU usage statistics for the DCGM hostengine
 func Introspect() (Status, error) {
-	return introspect()
+	return defaultClient.Introspect()
 }
 
 // GetSupportedMetricGroups returns all supported metric groups for the specified GPU
 func GetSupportedMetricGroups(gpuID uint) ([]MetricGroup, error) {
-	return getSupportedMetricGroups(gpuID)
+	return defaultClient.GetSupportedMetricGroups(gpuID)
 }
 
 // GetNvLinkLinkStatus returns the status of all NVLink connections
 func GetNvLinkLinkStatus() ([]NvLinkStatus, error) {
-	return getNvLinkLinkStatus()
+	return defaultClient.GetNvLinkLinkStatus()
 }
@@ -17,8 +17,12 @@
 package dcgm
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,3 +44,319 @@ func TestGetEntityGroupEntities(t *testing.T) {
 		require.NotEmpty(t, nvlinkEntities)
 	})
 }
+
+func TestHostengineIsHealthy(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	health, err := HostengineIsHealthy()
+	require.NoError(t, err)
+	assert.True(t, health.Healthy())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = Ping(ctx)
+	require.NoError(t, err)
+}
+
+func TestShutdownWithoutInit(t *testing.T) {
+	require.Error(t, Shutdown())
+	require.Error(t, Shutdown())
+	assert.Equal(t, 0, dcgmInitCounter)
+}
+
+func TestGetAllDeviceInfo(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	devices, err := GetAllDeviceInfo()
+	require.NoError(t, err)
+
+	count, err := GetAllDeviceCount()
+	require.NoError(t, err)
+	require.Len(t, devices, int(count))
+
+	for _, device := range devices {
+		expected, err := GetDeviceInfo(device.GPU)
+		require.NoError(t, err)
+		assert.Equal(t, expected, device)
+	}
+}
+
+func TestDeviceLookup(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+
+	for _, gpu := range gpus {
+		device, err := GetDeviceInfo(gpu)
+		require.NoError(t, err)
+
+		id, err := DeviceByUUID(device.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, gpu, id)
+
+		id, err = DeviceByPCIBusID(device.PCI.BusID)
+		require.NoError(t, err)
+		assert.Equal(t, gpu, id)
+	}
+
+	_, err = DeviceByUUID("GPU-00000000-0000-0000-0000-000000000000")
+	require.ErrorIs(t, err, ErrDeviceNotFound)
+}
+
+func TestGetDeviceAttributes(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+
+	for _, gpu := range gpus {
+		attrs, err := GetDeviceAttributes(gpu)
+		require.NoError(t, err)
+
+		device, err := GetDeviceInfo(gpu)
+		require.NoError(t, err)
+
+		assert.Equal(t, device.UUID, attrs.UUID)
+		assert.Equal(t, device.Identifiers, attrs.Identifiers)
+		assert.Equal(t, device.Power, attrs.PowerLimits.Default)
+		assert.Equal(t, device.PCI.FBTotal, attrs.Memory.FBTotal)
+		assert.LessOrEqual(t, attrs.PowerLimits.Min, attrs.PowerLimits.Max)
+
+		persistence, err := GetPersistenceMode(gpu)
+		require.NoError(t, err)
+		assert.Equal(t, attrs.Settings.PersistenceMode, persistence)
+	}
+}
+
+func TestGetNvSwitchInfo(t *testing.T) {
+	withNvsdmMockConfig(t, "testdata/one_switch.yaml", func(t *testing.T) {
+		teardownTest := setupTest(t)
+		defer teardownTest(t)
+
+		runOnlyWithLiveGPUs(t)
+
+		count, err := GetNvSwitchCount()
+		require.NoError(t, err)
+		require.NotZero(t, count)
+
+		switches, err := GetNvSwitchInfo()
+		require.NoError(t, err)
+		require.Len(t, switches, int(count))
+
+		for _, sw := range switches {
+			for _, link := range sw.Links {
+				assert.Equal(t, FE_SWITCH, link.ParentType)
+				assert.Equal(t, sw.ID, link.ParentId)
+			}
+		}
+	})
+}
+
+func TestHealthCheckEntities(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+	require.NotEmpty(t, gpus)
+
+	response, err := HealthCheckEntities(Entity{Group: FE_GPU, ID: gpus[0]})
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_HEALTH_RESULT_PASS, response.OverallHealth)
+}
+
+func TestGetDeviceMappings(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	mappings, err := GetDeviceMappings()
+	require.NoError(t, err)
+
+	count, err := GetAllDeviceCount()
+	require.NoError(t, err)
+	require.Len(t, mappings, int(count))
+
+	for i, mapping := range mappings {
+		assert.Equal(t, i, mapping.PCIOrder)
+		assert.GreaterOrEqual(t, mapping.NVMLIndex, 0)
+
+		id, err := DeviceByUUID(mapping.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, mapping.GPU, id)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+
+	var iterated []uint
+	for device, err := range Devices() {
+		require.NoError(t, err)
+		iterated = append(iterated, device.GPU)
+	}
+	assert.Equal(t, gpus, iterated)
+
+	info, err := GetGroupInfo(GroupAllGPUs())
+	require.NoError(t, err)
+
+	var members []GroupEntityPair
+	for member, err := range GroupMembers(GroupAllGPUs()) {
+		require.NoError(t, err)
+		members = append(members, member)
+	}
+	assert.Equal(t, info.EntityList, members)
+
+	fields := []Short{DCGM_FI_DEV_NAME, DCGM_FI_DEV_GPU_TEMP}
+	values, err := EntitiesGetLatestValues(members, fields, DCGM_FV_FLAG_LIVE_DATA)
+	require.NoError(t, err)
+
+	count := 0
+	for value, err := range LatestValues(members, fields, DCGM_FV_FLAG_LIVE_DATA) {
+		require.NoError(t, err)
+		assert.Equal(t, values[count].FieldID, value.FieldID)
+		assert.Equal(t, values[count].EntityID, value.EntityID)
+		count++
+	}
+	assert.Len(t, values, count)
+}
+
+func TestWatchXidEvents(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := withInjectionGPUs(t, 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	events, err := WatchXidEvents(ctx)
+	require.NoError(t, err)
+
+	err = InjectFieldValue(gpus[0], DCGM_FI_DEV_XID_ERRORS, DCGM_FT_INT64, 0, time.Now().UnixMicro(), int64(48))
+	require.NoError(t, err)
+
+	for event := range events {
+		if event.GPU != gpus[0] {
+			continue
+		}
+		assert.Equal(t, uint(48), event.Xid)
+		assert.Equal(t, XidDescription(48), event.Description)
+		return
+	}
+	t.Fatal("no XID event received")
+}
+
+func TestProcessUtilizationSampler(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	sampler, err := NewProcessUtilizationSampler(100 * time.Millisecond)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, sampler.Close()) }()
+
+	first, err := sampler.Sample()
+	require.NoError(t, err)
+	assert.False(t, first.End.Before(first.Start))
+
+	second, err := sampler.Sample()
+	require.NoError(t, err)
+	assert.False(t, second.Start.Before(first.End))
+	for _, process := range second.Processes {
+		assert.NotZero(t, process.PID)
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	fast, err := FieldGroupCreate("schedulerFast", []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fast) }()
+
+	slow, err := FieldGroupCreate("schedulerSlow", []Short{DCGM_FI_DEV_FB_TOTAL})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(slow) }()
+
+	var mu sync.Mutex
+	batches := map[string]int{}
+	count := func(name string) func(FieldValueBatch) {
+		return func(batch FieldValueBatch) {
+			mu.Lock()
+			defer mu.Unlock()
+			if batch.Err == nil {
+				batches[name]++
+			}
+		}
+	}
+
+	scheduler, err := StartScheduler(context.Background(), GroupAllGPUs(),
+		Schedule{FieldGroup: fast, Interval: 100 * time.Millisecond, OnBatch: count("fast")},
+		Schedule{FieldGroup: slow, Interval: time.Minute, OnBatch: count("slow")},
+	)
+	require.NoError(t, err)
+
+	time.Sleep(time.Second)
+	scheduler.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Positive(t, batches["fast"])
+	assert.Zero(t, batches["slow"])
+}
+
+func TestListWatches(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+	require.NotEmpty(t, gpus)
+
+	fieldGroup, err := FieldGroupCreate("listWatches", []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldGroup) }()
+
+	require.NoError(t, WatchFieldsWithOptions(fieldGroup, GroupAllGPUs(), WatchOptions{
+		UpdateFreq: 250 * time.Millisecond,
+		MaxKeepAge: time.Minute,
+	}))
+	defer func() { _ = UnwatchFields(GroupAllGPUs(), fieldGroup) }()
+
+	watches, err := ListWatches([]Entity{{Group: FE_GPU, ID: gpus[0]}}, []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	require.Len(t, watches, 1)
+	assert.LessOrEqual(t, watches[0].UpdateInterval, 250*time.Millisecond)
+	assert.NotEmpty(t, watches[0].Watchers)
+}
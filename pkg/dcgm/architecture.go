@@ -0,0 +1,118 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+)
+
+// Architecture is the chip architecture of a GPU
+type Architecture uint
+
+const (
+	// ArchitectureUnknown is reported for GPUs whose architecture cannot be determined
+	ArchitectureUnknown Architecture = iota
+	// ArchitectureKepler represents Kepler GPUs
+	ArchitectureKepler
+	// ArchitectureMaxwell represents Maxwell GPUs
+	ArchitectureMaxwell
+	// ArchitecturePascal represents Pascal GPUs
+	ArchitecturePascal
+	// ArchitectureVolta represents Volta GPUs
+	ArchitectureVolta
+	// ArchitectureTuring represents Turing GPUs
+	ArchitectureTuring
+	// ArchitectureAmpere represents Ampere GPUs
+	ArchitectureAmpere
+	// ArchitectureAda represents Ada Lovelace GPUs
+	ArchitectureAda
+	// ArchitectureHopper represents Hopper GPUs
+	ArchitectureHopper
+	// ArchitectureBlackwell represents Blackwell GPUs
+	ArchitectureBlackwell
+)
+
+func (a Architecture) String() string {
+	switch a {
+	case ArchitectureKepler:
+		return "Kepler"
+	case ArchitectureMaxwell:
+		return "Maxwell"
+	case ArchitecturePascal:
+		return "Pascal"
+	case ArchitectureVolta:
+		return "Volta"
+	case ArchitectureTuring:
+		return "Turing"
+	case ArchitectureAmpere:
+		return "Ampere"
+	case ArchitectureAda:
+		return "Ada"
+	case ArchitectureHopper:
+		return "Hopper"
+	case ArchitectureBlackwell:
+		return "Blackwell"
+	}
+	return "Unknown"
+}
+
+// ComputeCapability is the CUDA compute capability of a GPU
+type ComputeCapability struct {
+	Major uint
+	Minor uint
+}
+
+func (cc ComputeCapability) String() string {
+	return fmt.Sprintf("%d.%d", cc.Major, cc.Minor)
+}
+
+// AtLeast reports whether the compute capability is major.minor or newer
+func (cc ComputeCapability) AtLeast(major, minor uint) bool {
+	if cc.Major != major {
+		return cc.Major > major
+	}
+	return cc.Minor >= minor
+}
+
+// Architecture returns the chip architecture of GPUs with this compute capability
+func (cc ComputeCapability) Architecture() Architecture {
+	switch cc.Major {
+	case 3:
+		return ArchitectureKepler
+	case 5:
+		return ArchitectureMaxwell
+	case 6:
+		return ArchitecturePascal
+	case 7:
+		if cc.Minor >= 5 {
+			return ArchitectureTuring
+		}
+		return ArchitectureVolta
+	case 8:
+		if cc.Minor >= 9 {
+			return ArchitectureAda
+		}
+		return ArchitectureAmpere
+	case 9:
+		return ArchitectureHopper
+	case 10, 11, 12:
+		return ArchitectureBlackwell
+	}
+	return ArchitectureUnknown
+}
+
+// toComputeCapability decodes a DCGM_FI_DEV_CUDA_COMPUTE_CAPABILITY value, 16 bits of major version
+// followed by 16 bits of minor version
+func toComputeCapability(value int64) ComputeCapability {
+	if value < 0 || value >= DCGM_FT_INT64_BLANK {
+		return ComputeCapability{}
+	}
+	return ComputeCapability{
+		Major: uint(value>>16) & 0xFFFF,
+		Minor: uint(value) & 0xFFFF,
+	}
+}
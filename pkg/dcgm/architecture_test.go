@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeCapability(t *testing.T) {
+	tests := []struct {
+		value        int64
+		expected     ComputeCapability
+		architecture Architecture
+	}{
+		{value: 7<<16 | 0, expected: ComputeCapability{7, 0}, architecture: ArchitectureVolta},
+		{value: 7<<16 | 5, expected: ComputeCapability{7, 5}, architecture: ArchitectureTuring},
+		{value: 8<<16 | 6, expected: ComputeCapability{8, 6}, architecture: ArchitectureAmpere},
+		{value: 8<<16 | 9, expected: ComputeCapability{8, 9}, architecture: ArchitectureAda},
+		{value: 9<<16 | 0, expected: ComputeCapability{9, 0}, architecture: ArchitectureHopper},
+		{value: 10<<16 | 0, expected: ComputeCapability{10, 0}, architecture: ArchitectureBlackwell},
+		{value: DCGM_FT_INT64_NOT_SUPPORTED, expected: ComputeCapability{}, architecture: ArchitectureUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			cc := toComputeCapability(tt.value)
+			assert.Equal(t, tt.expected, cc)
+			assert.Equal(t, tt.architecture, cc.Architecture())
+		})
+	}
+
+	assert.True(t, ComputeCapability{9, 0}.AtLeast(8, 0))
+	assert.True(t, ComputeCapability{8, 6}.AtLeast(8, 6))
+	assert.False(t, ComputeCapability{8, 6}.AtLeast(8, 9))
+}
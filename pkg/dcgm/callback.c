@@ -1,4 +1,6 @@
-int violationNotify(void* p) {
-    int ViolationRegistration(void*);
-    return ViolationRegistration(p);
+#include <stdint.h>
+
+int violationNotify(void* p, uint64_t userData) {
+    int ViolationRegistration(void*, uint64_t);
+    return ViolationRegistration(p, userData);
 }
@@ -0,0 +1,465 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// Client represents a connection to a single DCGM hostengine. A process may hold
+// several clients at once, for example an embedded hostengine alongside a remote one.
+// The package-level functions operate on the client created by Init.
+type Client struct {
+	handle               dcgmHandle
+	mode                 mode
+	hostengineAsChildPid int
+	socketPath           string
+	opMode               OperationMode
+	connectOpts          ConnectOptions
+	hostengineOpts       HostengineOptions
+	relay                *relay
+
+	// mu guards the connection handle, the child pid and the background monitors' state
+	mu         sync.RWMutex
+	reconnect  *reconnectState
+	supervisor *supervisorState
+	keepalive  *keepaliveState
+	leaks      *leakTracker
+
+	// closeMu serializes Close and guards closed
+	closeMu sync.Mutex
+	closed  bool
+
+	// hookMu guards the OnDisconnect hooks, whether they ran for the current connection and the
+	// managed groups destroyed on Close
+	hookMu          sync.Mutex
+	disconnectHooks []func(error)
+	disconnected    bool
+	managedGroups   map[*ManagedGroup]struct{}
+
+	// callMu guards the in-flight call tracking used to drain the client on Close
+	callMu  sync.Mutex
+	calls   int
+	closing bool
+	drained chan struct{}
+}
+
+var _ io.Closer = (*Client)(nil)
+
+// defaultClient is the client used by the package-level API. It is replaced by Init
+// and reset by the final Shutdown.
+var defaultClient = &Client{}
+
+// NewClient starts DCGM in the specified mode and returns a Client bound to it.
+// The arguments have the same meaning as for Init. The returned client must be
+// released with Close.
+func NewClient(m mode, args ...string) (*Client, error) {
+	return newClient(m, args...)
+}
+
+// NewEmbeddedClient starts an embedded hostengine in the given operation mode and returns
+// a Client bound to it. In DCGM_OPERATION_MODE_MANUAL watched fields are only sampled when
+// UpdateAllFields or UpdateAllFieldsEx is called.
+func NewEmbeddedClient(opMode OperationMode) (*Client, error) {
+	c := &Client{mode: Embedded, opMode: opMode}
+	return c.openContext(context.Background())
+}
+
+// ConnectOptions describes how to connect to an already running nv-hostengine
+type ConnectOptions struct {
+	// Addr is the TCP/IP address of nv-hostengine, or the path of its Unix domain socket if
+	// UnixSocket is set. A TCP/IP address is a host name, an IPv4 address or an IPv6 address,
+	// optionally followed by ":port"; IPv6 addresses must be in brackets to carry a port.
+	// Host names are resolved on every connection attempt.
+	Addr string
+	// UnixSocket indicates that Addr is a Unix domain socket path
+	UnixSocket bool
+	// TimeoutMs is how long to wait for the connection to be established, in milliseconds.
+	// Zero selects the DCGM default.
+	TimeoutMs uint
+	// PersistAfterDisconnect keeps the groups and field watches created by this connection
+	// alive on the hostengine after the connection is closed
+	PersistAfterDisconnect bool
+	// Retry controls whether failed connection attempts are retried
+	Retry RetryPolicy
+	// Dialer, if set, is used to reach nv-hostengine instead of Addr and UnixSocket, for example
+	// to tunnel the connection through SSH or a proxy. DCGM connects to a private Unix domain
+	// socket and every connection made to it is forwarded over a connection opened by Dialer.
+	Dialer Dialer
+}
+
+// RetryPolicy controls how often connecting to nv-hostengine is attempted, which helps when
+// the hostengine is still starting, for example right after a node boots
+type RetryPolicy struct {
+	// Attempts is the maximum number of connection attempts. Zero or one means a single attempt.
+	Attempts int
+	// InitialBackoff is the delay after the first failed attempt. Defaults to 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+const (
+	defaultRetryInitialBackoff = time.Second
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+func (p RetryPolicy) initialBackoff() time.Duration {
+	if p.InitialBackoff <= 0 {
+		return defaultRetryInitialBackoff
+	}
+	return p.InitialBackoff
+}
+
+func (p RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff < p.initialBackoff() {
+		return max(defaultRetryMaxBackoff, p.initialBackoff())
+	}
+	return p.MaxBackoff
+}
+
+// ConnectWithOptions connects to an already running nv-hostengine as described by opts
+func ConnectWithOptions(opts ConnectOptions) (*Client, error) {
+	return ConnectWithOptionsContext(context.Background(), opts)
+}
+
+// ConnectWithOptionsContext is like ConnectWithOptions but gives up once ctx is cancelled
+// or its deadline expires.
+func ConnectWithOptionsContext(ctx context.Context, opts ConnectOptions) (*Client, error) {
+	if opts.Addr == "" && opts.Dialer == nil {
+		return nil, errors.New("missing dcgm address")
+	}
+	if !opts.UnixSocket && opts.Dialer == nil {
+		if _, _, err := parseAddress(opts.Addr); err != nil {
+			return nil, err
+		}
+	}
+
+	c := &Client{mode: Standalone, connectOpts: opts}
+	return c.openContext(ctx)
+}
+
+// Connect connects to an already running nv-hostengine listening on address.
+// If isUnixSocket is true, address is treated as the path of a Unix domain socket.
+func Connect(address string, isUnixSocket bool) (*Client, error) {
+	return ConnectWithContext(context.Background(), address, isUnixSocket)
+}
+
+// ConnectWithContext is like Connect but gives up once ctx is cancelled or its deadline
+// expires. The deadline, if any, is also used as the dcgmConnect_v2 timeout.
+func ConnectWithContext(ctx context.Context, address string, isUnixSocket bool) (*Client, error) {
+	return ConnectWithOptionsContext(ctx, ConnectOptions{Addr: address, UnixSocket: isUnixSocket})
+}
+
+// PersistAfterDisconnect reports whether the groups and field watches created through this
+// client outlive its connection to nv-hostengine
+func (c *Client) PersistAfterDisconnect() bool {
+	return c.mode == Standalone && c.connectOpts.PersistAfterDisconnect
+}
+
+// Close stops or disconnects from the hostengine this client is bound to. It waits for
+// calls in progress on other goroutines to finish; calls made afterwards return ErrClientClosed.
+// Closing a client that is already closed does nothing.
+func (c *Client) Close() error {
+	return c.CloseWithTimeout(0)
+}
+
+// CloseWithTimeout is like Close but gives up waiting for calls in progress after timeout,
+// returning ErrShutdownTimeout. The client is then left open and working, with its keepalive,
+// auto-reconnect and managed groups, so Close can be retried. A timeout of zero waits
+// indefinitely.
+func (c *Client) CloseWithTimeout(timeout time.Duration) error {
+	return c.closeWithTimeout(timeout, c.shutdown)
+}
+
+// closeWithTimeout closes the client, stopping or disconnecting from the hostengine with shutdown
+func (c *Client) closeWithTimeout(timeout time.Duration, shutdown func() error) error {
+	c.closeMu.Lock()
+	defer c.closeMu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	if err := c.drain(timeout); err != nil {
+		return err
+	}
+
+	c.disableAutoReconnect()
+	c.stopKeepalive()
+	c.closeManagedGroups(c.destroyGroup)
+
+	if err := shutdown(); err != nil {
+		// the library reference of the client is released even when shutting down fails, so
+		// closing again must not release it a second time
+		c.closed = true
+		return err
+	}
+
+	c.closed = true
+	c.reportLeaks()
+	return nil
+}
+
+// beginCall registers a call that uses the connection, failing once the client is closing
+func (c *Client) beginCall() error {
+	c.callMu.Lock()
+	defer c.callMu.Unlock()
+
+	if c.closing {
+		return ErrClientClosed
+	}
+	c.calls++
+	return nil
+}
+
+// endCall marks a call registered by beginCall as finished
+func (c *Client) endCall() {
+	c.callMu.Lock()
+	defer c.callMu.Unlock()
+
+	c.calls--
+	if c.calls == 0 && c.drained != nil {
+		close(c.drained)
+		c.drained = nil
+	}
+}
+
+// drain rejects new calls and waits up to timeout for the calls in progress to finish. If they do
+// not finish in time, new calls are accepted again.
+func (c *Client) drain(timeout time.Duration) error {
+	c.callMu.Lock()
+	c.closing = true
+	if c.calls == 0 {
+		c.callMu.Unlock()
+		return nil
+	}
+	if c.drained == nil {
+		c.drained = make(chan struct{})
+	}
+	drained := c.drained
+	c.callMu.Unlock()
+
+	if timeout <= 0 {
+		<-drained
+		return nil
+	}
+
+	select {
+	case <-drained:
+		return nil
+	case <-time.After(timeout):
+		c.callMu.Lock()
+		c.closing = false
+		c.callMu.Unlock()
+		return ErrShutdownTimeout
+	}
+}
+
+// GetAllDeviceCount returns the count of all GPUs in the system
+func (c *Client) GetAllDeviceCount() (uint, error) {
+	if err := c.beginCall(); err != nil {
+		return 0, err
+	}
+	defer c.endCall()
+
+	return c.getAllDeviceCount()
+}
+
+// GetEntityGroupEntities returns all entities of the specified group type
+func (c *Client) GetEntityGroupEntities(entityGroup Field_Entity_Group) ([]uint, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getEntityGroupEntities(entityGroup)
+}
+
+// GetSupportedDevices returns a list of DCGM-supported GPU IDs
+func (c *Client) GetSupportedDevices() ([]uint, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getSupportedDevices()
+}
+
+// GetDeviceInfo returns detailed information about the specified GPU
+func (c *Client) GetDeviceInfo(gpuID uint) (Device, error) {
+	if err := c.beginCall(); err != nil {
+		return Device{}, err
+	}
+	defer c.endCall()
+
+	return c.getDeviceInfo(gpuID)
+}
+
+// GetDeviceAttributes returns all the static attributes of the specified GPU, including its
+// supported clocks, thermal thresholds, power limits and memory usage
+func (c *Client) GetDeviceAttributes(gpuID uint) (DeviceAttributes, error) {
+	if err := c.beginCall(); err != nil {
+		return DeviceAttributes{}, err
+	}
+	defer c.endCall()
+
+	return c.getDeviceAttributes(gpuID)
+}
+
+// GetPersistenceMode reports whether persistence mode is enabled on the specified GPU. DCGM can
+// only read this setting; it is enabled with nvidia-smi -pm 1 or nvmlDeviceSetPersistenceMode.
+func (c *Client) GetPersistenceMode(gpuID uint) (bool, error) {
+	if err := c.beginCall(); err != nil {
+		return false, err
+	}
+	defer c.endCall()
+
+	attrs, err := c.getDeviceAttributes(gpuID)
+	if err != nil {
+		return false, err
+	}
+	return attrs.Settings.PersistenceMode, nil
+}
+
+// GetAllDeviceInfo returns detailed information about all GPUs in the system in a single pass
+func (c *Client) GetAllDeviceInfo() ([]Device, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getAllDeviceInfo()
+}
+
+// DeviceByUUID returns the ID of the GPU with the given UUID. The "GPU-" prefix is optional
+// and the comparison ignores case.
+func (c *Client) DeviceByUUID(uuid string) (uint, error) {
+	if err := c.beginCall(); err != nil {
+		return 0, err
+	}
+	defer c.endCall()
+
+	return c.deviceByUUID(uuid)
+}
+
+// DeviceBySerial returns the ID of the GPU with the given board serial number
+func (c *Client) DeviceBySerial(serial string) (uint, error) {
+	if err := c.beginCall(); err != nil {
+		return 0, err
+	}
+	defer c.endCall()
+
+	return c.deviceBySerial(serial)
+}
+
+// DeviceByPCIBusID returns the ID of the GPU at the given PCI address. Both the 4 and 8 digit
+// domain forms are accepted, e.g. "0000:3b:00.0" and "00000000:3B:00.0".
+func (c *Client) DeviceByPCIBusID(busID string) (uint, error) {
+	if err := c.beginCall(); err != nil {
+		return 0, err
+	}
+	defer c.endCall()
+
+	return c.deviceByPCIBusID(busID)
+}
+
+// GetDeviceStatus returns current status information about the specified GPU
+func (c *Client) GetDeviceStatus(gpuID uint) (DeviceStatus, error) {
+	if err := c.beginCall(); err != nil {
+		return DeviceStatus{}, err
+	}
+	defer c.endCall()
+
+	return c.latestValuesForDevice(gpuID)
+}
+
+// GetDeviceTopology returns the topology (connectivity) information for the specified GPU
+func (c *Client) GetDeviceTopology(gpuID uint) ([]P2PLink, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getDeviceTopology(gpuID)
+}
+
+// WatchPidFields configures DCGM to start recording stats for GPU processes
+// Must be called before GetProcessInfo
+func (c *Client) WatchPidFields() (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
+
+	return c.watchPidFields(time.Microsecond*time.Duration(defaultUpdateFreq), time.Second*time.Duration(defaultMaxKeepAge), defaultMaxKeepSamples)
+}
+
+// GetProcessInfo returns detailed per-GPU statistics for the specified process
+func (c *Client) GetProcessInfo(group GroupHandle, pid uint) ([]ProcessInfo, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getProcessInfo(group, pid)
+}
+
+// HealthCheckByGpuId performs a health check on the specified GPU
+func (c *Client) HealthCheckByGpuId(gpuID uint) (DeviceHealth, error) {
+	if err := c.beginCall(); err != nil {
+		return DeviceHealth{}, err
+	}
+	defer c.endCall()
+
+	return c.healthCheckByGpuId(gpuID)
+}
+
+// ListenForPolicyViolations sets up monitoring for the specified policy conditions on all GPUs
+// Returns a channel that receives policy violations and any error encountered
+func (c *Client) ListenForPolicyViolations(ctx context.Context, typ ...policyCondition) (<-chan PolicyViolation, error) {
+	return c.ListenForPolicyViolationsForGroup(ctx, GroupAllGPUs(), typ...)
+}
+
+// ListenForPolicyViolationsForGroup sets up policy monitoring for the specified GPU group
+// Returns a channel that receives policy violations and any error encountered
+func (c *Client) ListenForPolicyViolationsForGroup(ctx context.Context, group GroupHandle, typ ...policyCondition) (<-chan PolicyViolation, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.registerPolicy(ctx, group, typ...)
+}
+
+// Introspect returns memory and CPU usage statistics for the DCGM hostengine
+func (c *Client) Introspect() (Status, error) {
+	if err := c.beginCall(); err != nil {
+		return Status{}, err
+	}
+	defer c.endCall()
+
+	return c.introspect()
+}
+
+// GetSupportedMetricGroups returns all supported metric groups for the specified GPU
+func (c *Client) GetSupportedMetricGroups(gpuID uint) ([]MetricGroup, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getSupportedMetricGroups(gpuID)
+}
+
+// GetNvLinkLinkStatus returns the status of all NVLink connections
+func (c *Client) GetNvLinkLinkStatus() ([]NvLinkStatus, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getNvLinkLinkStatus()
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAlongsideDefault(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	client, err := NewClient(Embedded)
+	require.NoError(t, err)
+
+	expected, err := GetAllDeviceCount()
+	require.NoError(t, err)
+
+	count, err := client.GetAllDeviceCount()
+	require.NoError(t, err)
+	assert.Equal(t, expected, count)
+
+	require.NoError(t, client.Close())
+
+	// The default client must keep working after another client is closed
+	_, err = GetAllDeviceCount()
+	require.NoError(t, err)
+}
+
+func TestConnectWithContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client, err := ConnectWithContext(ctx, "localhost", false)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, client)
+}
+
+func TestConnectWithOptionsMissingAddress(t *testing.T) {
+	client, err := ConnectWithOptions(ConnectOptions{})
+	require.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestClientDrainWaitsForCalls(t *testing.T) {
+	c := &Client{}
+	require.NoError(t, c.beginCall())
+
+	assert.ErrorIs(t, c.drain(10*time.Millisecond), ErrShutdownTimeout)
+	require.NoError(t, c.beginCall())
+	c.endCall()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		c.endCall()
+	}()
+
+	assert.NoError(t, c.drain(0))
+}
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	var p RetryPolicy
+	assert.Equal(t, time.Second, p.initialBackoff())
+	assert.Equal(t, 30*time.Second, p.maxBackoff())
+
+	p = RetryPolicy{InitialBackoff: time.Minute}
+	assert.Equal(t, time.Minute, p.maxBackoff())
+}
+
+func TestCloseAlreadyClosed(t *testing.T) {
+	c := &Client{closed: true}
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestCloseAfterShutdownFailure(t *testing.T) {
+	shutdowns := 0
+	shutdownErr := errors.New("error shutting down DCGM")
+	shutdown := func() error {
+		shutdowns++
+		return shutdownErr
+	}
+
+	c := &Client{}
+	require.ErrorIs(t, c.closeWithTimeout(0, shutdown), shutdownErr)
+	require.NoError(t, c.closeWithTimeout(0, shutdown))
+	assert.Equal(t, 1, shutdowns)
+	require.NoError(t, c.Close())
+}
+
+func TestCloseTimeoutLeavesClientOpen(t *testing.T) {
+	shutdowns := 0
+	shutdown := func() error {
+		shutdowns++
+		return nil
+	}
+
+	c := &Client{keepalive: &keepaliveState{stop: make(chan struct{})}}
+	g := &ManagedGroup{client: c, name: "testManaged"}
+	c.managedGroups = map[*ManagedGroup]struct{}{g: {}}
+	require.NoError(t, c.beginCall())
+
+	require.ErrorIs(t, c.closeWithTimeout(10*time.Millisecond, shutdown), ErrShutdownTimeout)
+	assert.Equal(t, 0, shutdowns)
+	assert.Len(t, c.managedGroups, 1)
+	assert.NotNil(t, c.keepalive)
+	require.NoError(t, c.beginCall())
+	c.endCall()
+
+	c.endCall()
+	c.managedGroups = nil
+	require.NoError(t, c.closeWithTimeout(0, shutdown))
+	assert.Equal(t, 1, shutdowns)
+	assert.Nil(t, c.keepalive)
+	assert.ErrorIs(t, c.beginCall(), ErrClientClosed)
+}
+
+func TestNewClientInvalidMode(t *testing.T) {
+	client, err := NewClient(mode(42))
+	assert.ErrorIs(t, err, ErrInvalidMode)
+	assert.Nil(t, client)
+}
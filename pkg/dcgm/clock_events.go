@@ -0,0 +1,137 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+#include "dcgm_fields.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// ClockEventReasons is the set of reasons the clocks of a GPU are held below their maximum, as
+// reported by DCGM_FI_DEV_CLOCKS_EVENT_REASONS
+type ClockEventReasons uint64
+
+const (
+	// ClockEventGpuIdle means nothing is running on the GPU and the clocks are dropping to idle
+	ClockEventGpuIdle ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_GPU_IDLE
+	// ClockEventClocksSetting means the clocks are limited by the applications clocks setting
+	ClockEventClocksSetting ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_CLOCKS_SETTING
+	// ClockEventSWPowerCap means the clocks are lowered to stay within the power limit
+	ClockEventSWPowerCap ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_SW_POWER_CAP
+	// ClockEventHWSlowdown means the hardware halved the clocks or more, because of temperature,
+	// an external power brake or a too high power draw
+	ClockEventHWSlowdown ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_HW_SLOWDOWN
+	// ClockEventSyncBoost means the clocks are held at the lowest clocks of the sync boost group
+	ClockEventSyncBoost ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_SYNC_BOOST
+	// ClockEventSWThermalSlowdown means the clocks are lowered to keep the GPU and memory below
+	// their maximum operating temperature
+	ClockEventSWThermalSlowdown ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_SW_THERMAL
+	// ClockEventHWThermalSlowdown means the hardware halved the clocks or more because the
+	// temperature is too high
+	ClockEventHWThermalSlowdown ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_HW_THERMAL
+	// ClockEventHWPowerBrake means the hardware halved the clocks or more because of an external
+	// power brake, e.g. from the power supply
+	ClockEventHWPowerBrake ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_HW_POWER_BRAKE
+	// ClockEventDisplayClocks means the clocks are limited by the display clocks setting
+	ClockEventDisplayClocks ClockEventReasons = C.DCGM_CLOCKS_EVENT_REASON_DISPLAY_CLOCKS
+)
+
+// clockEventNames are the names of the reasons in bit order
+var clockEventNames = []struct {
+	reason ClockEventReasons
+	name   string
+}{
+	{ClockEventGpuIdle, "GPU Idle"},
+	{ClockEventClocksSetting, "Clocks Setting"},
+	{ClockEventSWPowerCap, "SW Power Cap"},
+	{ClockEventHWSlowdown, "HW Slowdown"},
+	{ClockEventSyncBoost, "Sync Boost"},
+	{ClockEventSWThermalSlowdown, "SW Thermal Slowdown"},
+	{ClockEventHWThermalSlowdown, "HW Thermal Slowdown"},
+	{ClockEventHWPowerBrake, "HW Power Brake"},
+	{ClockEventDisplayClocks, "Display Clocks"},
+}
+
+// Contains reports whether all the reasons in r are set
+func (reasons ClockEventReasons) Contains(r ClockEventReasons) bool {
+	return reasons&r == r
+}
+
+// Reasons returns the set reasons one by one, in bit order
+func (reasons ClockEventReasons) Reasons() []ClockEventReasons {
+	list := make([]ClockEventReasons, 0, bits.OnesCount64(uint64(reasons)))
+	for rest := uint64(reasons); rest != 0; rest &= rest - 1 {
+		list = append(list, ClockEventReasons(rest&-rest))
+	}
+	return list
+}
+
+// Throttled reports whether the clocks are lowered by a power or thermal limit, as opposed to
+// being idle or held by a clocks setting
+func (reasons ClockEventReasons) Throttled() bool {
+	const throttling = ClockEventSWPowerCap | ClockEventHWSlowdown | ClockEventSWThermalSlowdown |
+		ClockEventHWThermalSlowdown | ClockEventHWPowerBrake
+	return reasons&throttling != 0
+}
+
+func (reasons ClockEventReasons) String() string {
+	if reasons == 0 {
+		return "None"
+	}
+
+	names := make([]string, 0, bits.OnesCount64(uint64(reasons)))
+	rest := reasons
+	for _, n := range clockEventNames {
+		if reasons.Contains(n.reason) {
+			names = append(names, n.name)
+			rest &^= n.reason
+		}
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("Unknown(%#x)", uint64(rest)))
+	}
+	return strings.Join(names, ", ")
+}
+
+// GetClockEventReasons returns the reasons the clocks of the specified GPU are currently held
+// below their maximum
+func GetClockEventReasons(gpuID uint) (ClockEventReasons, error) {
+	return defaultClient.GetClockEventReasons(gpuID)
+}
+
+// GetClockEventReasons returns the reasons the clocks of the specified GPU are currently held
+// below their maximum
+func (c *Client) GetClockEventReasons(gpuID uint) (ClockEventReasons, error) {
+	if err := c.beginCall(); err != nil {
+		return 0, err
+	}
+	defer c.endCall()
+
+	entities := []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}
+	values, err := c.sampleEntityFields("clockEvents", entities, []Short{DCGM_FI_DEV_CLOCKS_EVENT_REASONS})
+	if err != nil {
+		return 0, fmt.Errorf("error getting clock event reasons: %s", err)
+	}
+	if len(values) == 0 {
+		return 0, fmt.Errorf("error getting clock event reasons: no value for GPU %d", gpuID)
+	}
+
+	reasons, ok := toClockEventReasons(values[0])
+	if !ok {
+		return 0, fmt.Errorf("clock event reasons of GPU %d are not available", gpuID)
+	}
+	return reasons, nil
+}
+
+// toClockEventReasons decodes a DCGM_FI_DEV_CLOCKS_EVENT_REASONS value. Failed and blank values
+// are not decoded.
+func toClockEventReasons(value FieldValue_v2) (ClockEventReasons, bool) {
+	reasons, ok := value.Typed().AsInt64()
+	return ClockEventReasons(reasons), ok
+}
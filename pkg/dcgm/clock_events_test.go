@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockEventReasons(t *testing.T) {
+	reasons := ClockEventSWPowerCap | ClockEventHWThermalSlowdown
+
+	assert.True(t, reasons.Contains(ClockEventSWPowerCap))
+	assert.True(t, reasons.Contains(ClockEventSWPowerCap|ClockEventHWThermalSlowdown))
+	assert.False(t, reasons.Contains(ClockEventSWPowerCap|ClockEventGpuIdle))
+	assert.Equal(t, []ClockEventReasons{ClockEventSWPowerCap, ClockEventHWThermalSlowdown}, reasons.Reasons())
+	assert.True(t, reasons.Throttled())
+	assert.False(t, (ClockEventGpuIdle | ClockEventClocksSetting).Throttled())
+
+	assert.Equal(t, "SW Power Cap, HW Thermal Slowdown", reasons.String())
+	assert.Equal(t, "None", ClockEventReasons(0).String())
+	assert.Equal(t, "GPU Idle, Unknown(0x1000)", (ClockEventGpuIdle | 0x1000).String())
+
+	value := fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_CLOCKS_EVENT_REASONS, int64(ClockEventSyncBoost), 0)
+	decoded, ok := toClockEventReasons(value)
+	require.True(t, ok)
+	assert.Equal(t, ClockEventSyncBoost, decoded)
+
+	value.Status = DCGM_ST_NOT_SUPPORTED
+	_, ok = toClockEventReasons(value)
+	assert.False(t, ok)
+}
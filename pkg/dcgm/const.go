@@ -197,6 +197,17 @@ const (
 	DCGM_FV_FLAG_LIVE_DATA = uint(0x00000001)
 )
 
+// OperationMode is the operation mode of an embedded hostengine.
+type OperationMode int
+
+const (
+	// DCGM_OPERATION_MODE_AUTO makes the hostengine update watched fields in the background
+	DCGM_OPERATION_MODE_AUTO OperationMode = 1
+	// DCGM_OPERATION_MODE_MANUAL makes the hostengine update watched fields only when
+	// UpdateAllFields is called
+	DCGM_OPERATION_MODE_MANUAL OperationMode = 2
+)
+
 // HealthSystem is the system to watch for health checks.
 type HealthSystem uint
 
@@ -14,6 +14,8 @@
  * limitations under the License.
  */
 
+// Code generated by gen_fields.go; DO NOT EDIT.
+
 package dcgm
 
 const (
@@ -873,7 +875,8 @@ const (
 	DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L17 Short = 896
 	// DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_TOTAL represents the total receive bandwidth for all NVLink lanes in KB/s
 	DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_TOTAL Short = 897
-
+	// DCGM_FI_LAST_NVSWITCH_FIELD_ID represents last field ID of the NVSwitch instance
+	DCGM_FI_LAST_NVSWITCH_FIELD_ID Short = 899
 	// DCGM_FI_PROF_GR_ENGINE_ACTIVE represents the percentage of time the graphics engine was active
 	DCGM_FI_PROF_GR_ENGINE_ACTIVE Short = 1001
 	// DCGM_FI_PROF_SM_ACTIVE represents the percentage of time the streaming multiprocessors (SM) were active
@@ -922,7 +925,6 @@ const (
 	DCGM_FI_PROF_NVDEC6_ACTIVE Short = 1023
 	// DCGM_FI_PROF_NVDEC7_ACTIVE represents the ratio of cycles the NVDEC engine 7 is active
 	DCGM_FI_PROF_NVDEC7_ACTIVE Short = 1024
-
 	// DCGM_FI_PROF_NVJPG0_ACTIVE represents the ratio of cycles the NVJPG engine 0 is active
 	DCGM_FI_PROF_NVJPG0_ACTIVE Short = 1025
 	// DCGM_FI_PROF_NVJPG1_ACTIVE represents the ratio of cycles the NVJPG engine 1 is active
@@ -939,12 +941,10 @@ const (
 	DCGM_FI_PROF_NVJPG6_ACTIVE Short = 1031
 	// DCGM_FI_PROF_NVJPG7_ACTIVE represents the ratio of cycles the NVJPG engine 7 is active
 	DCGM_FI_PROF_NVJPG7_ACTIVE Short = 1032
-
 	// DCGM_FI_PROF_NVOFA0_ACTIVE represents the ratio of cycles the NVOFA engine 0 is active
 	DCGM_FI_PROF_NVOFA0_ACTIVE Short = 1033
 	// DCGM_FI_PROF_NVOFA1_ACTIVE represents the ratio of cycles the NVOFA engine 1 is active
 	DCGM_FI_PROF_NVOFA1_ACTIVE Short = 1034
-
 	// DCGM_FI_PROF_NVLINK_L0_TX_BYTES represents the number of bytes transmitted through NVLink lane 0 in KB/s
 	DCGM_FI_PROF_NVLINK_L0_TX_BYTES Short = 1040
 	// DCGM_FI_PROF_NVLINK_L0_RX_BYTES represents the number of bytes received through NVLink lane 0 in KB/s
@@ -1011,6 +1011,16 @@ const (
 	DCGM_FI_PROF_NVLINK_L15_RX_BYTES Short = 1071
 	// DCGM_FI_PROF_NVLINK_L16_TX_BYTES represents the number of bytes transmitted through NVLink lane 16 in KB/s
 	DCGM_FI_PROF_NVLINK_L16_TX_BYTES Short = 1072
+	// DCGM_FI_PROF_NVLINK_L16_RX_BYTES represents the per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload
+	DCGM_FI_PROF_NVLINK_L16_RX_BYTES Short = 1073
+	// DCGM_FI_PROF_NVLINK_L17_TX_BYTES represents the per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload
+	DCGM_FI_PROF_NVLINK_L17_TX_BYTES Short = 1074
+	// DCGM_FI_PROF_NVLINK_L17_RX_BYTES represents the per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload
+	DCGM_FI_PROF_NVLINK_L17_RX_BYTES Short = 1075
+	// DCGM_FI_PROF_NVLINK_THROUGHPUT_FIRST represents NVLink throughput First
+	DCGM_FI_PROF_NVLINK_THROUGHPUT_FIRST Short = DCGM_FI_PROF_NVLINK_L0_TX_BYTES
+	// DCGM_FI_PROF_NVLINK_THROUGHPUT_LAST represents NVLink throughput Last
+	DCGM_FI_PROF_NVLINK_THROUGHPUT_LAST Short = DCGM_FI_PROF_NVLINK_L17_RX_BYTES
 	// DCGM_FI_PROF_C2C_TX_ALL_BYTES represents C2C (Chip-to-Chip) interface metric
 	DCGM_FI_PROF_C2C_TX_ALL_BYTES Short = 1076
 	// DCGM_FI_PROF_C2C_TX_DATA_BYTES represents C2C (Chip-to-Chip) interface metric
@@ -1019,7 +1029,6 @@ const (
 	DCGM_FI_PROF_C2C_RX_ALL_BYTES Short = 1078
 	// DCGM_FI_PROF_C2C_RX_DATA_BYTES represents C2C (Chip-to-Chip) interface metric
 	DCGM_FI_PROF_C2C_RX_DATA_BYTES Short = 1079
-
 	// DCGM_FI_DEV_CPU_UTIL_TOTAL represents the total CPU utilization, total
 	DCGM_FI_DEV_CPU_UTIL_TOTAL Short = 1100
 	// DCGM_FI_DEV_CPU_UTIL_USER represents the CPU utilization, user
@@ -1034,12 +1043,20 @@ const (
 	DCGM_FI_DEV_CPU_TEMP_CURRENT Short = 1110
 	// DCGM_FI_DEV_CPU_TEMP_WARNING represents the CPU temperature warning threshold in degrees Celsius
 	DCGM_FI_DEV_CPU_TEMP_WARNING Short = 1111
+	// DCGM_FI_DEV_CPU_TEMP_CRITICAL represents CPU Critical Temperature
+	DCGM_FI_DEV_CPU_TEMP_CRITICAL Short = 1112
 	// DCGM_FI_DEV_CPU_TEMP_SHUTDOWN represents the CPU temperature shutdown threshold in degrees Celsius
-	DCGM_FI_DEV_CPU_TEMP_SHUTDOWN Short = 1112
+	//
+	// Deprecated: Use DCGM_FI_DEV_CPU_TEMP_CRITICAL instead.
+	DCGM_FI_DEV_CPU_TEMP_SHUTDOWN Short = DCGM_FI_DEV_CPU_TEMP_CRITICAL
 	// DCGM_FI_DEV_CPU_CLOCK_CURRENT represents the current CPU clock frequency in MHz
 	DCGM_FI_DEV_CPU_CLOCK_CURRENT Short = 1120
+	// DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT represents CPU power utilization
+	DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT Short = 1130
 	// DCGM_FI_DEV_CPU_POWER_CURRENT represents the current CPU power usage
-	DCGM_FI_DEV_CPU_POWER_CURRENT Short = 1130
+	//
+	// Deprecated: Use DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT instead.
+	DCGM_FI_DEV_CPU_POWER_CURRENT Short = DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT
 	// DCGM_FI_DEV_CPU_POWER_LIMIT represents the GPU power limit
 	DCGM_FI_DEV_CPU_POWER_LIMIT Short = 1131
 	// DCGM_FI_DEV_SYSIO_POWER_UTIL_CURRENT represents the SoC power utilization
@@ -1082,6 +1099,8 @@ const (
 	DCGM_FI_DEV_NVLINK_COUNT_RX_SYMBOL_ERRORS Short = 1214
 	// DCGM_FI_DEV_NVLINK_COUNT_SYMBOL_BER is the value for ECC DEV NVLink Count Symbol BER
 	DCGM_FI_DEV_NVLINK_COUNT_SYMBOL_BER Short = 1215
+	// DCGM_FI_DEV_FIRST_CONNECTX_FIELD_ID represents first field id of ConnectX
+	DCGM_FI_DEV_FIRST_CONNECTX_FIELD_ID Short = 1300
 	// DCGM_FI_DEV_CONNECTX_HEALTH represents a health state of ConnectX
 	DCGM_FI_DEV_CONNECTX_HEALTH Short = 1300
 	// DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_WIDTH is the value of an active PCIe link width
@@ -1545,6 +1564,7 @@ var dcgmFields = map[string]Short{
 	"DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L16":                      DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L16,                      // 895
 	"DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L17":                      DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L17,                      // 896
 	"DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_TOTAL":                    DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_TOTAL,                    // 897
+	"DCGM_FI_LAST_NVSWITCH_FIELD_ID":                           DCGM_FI_LAST_NVSWITCH_FIELD_ID,                           // 899
 	"DCGM_FI_PROF_GR_ENGINE_ACTIVE":                            DCGM_FI_PROF_GR_ENGINE_ACTIVE,                            // 1001
 	"DCGM_FI_PROF_SM_ACTIVE":                                   DCGM_FI_PROF_SM_ACTIVE,                                   // 1002
 	"DCGM_FI_PROF_SM_OCCUPANCY":                                DCGM_FI_PROF_SM_OCCUPANCY,                                // 1003
@@ -1612,6 +1632,11 @@ var dcgmFields = map[string]Short{
 	"DCGM_FI_PROF_NVLINK_L15_TX_BYTES":                         DCGM_FI_PROF_NVLINK_L15_TX_BYTES,                         // 1070
 	"DCGM_FI_PROF_NVLINK_L15_RX_BYTES":                         DCGM_FI_PROF_NVLINK_L15_RX_BYTES,                         // 1071
 	"DCGM_FI_PROF_NVLINK_L16_TX_BYTES":                         DCGM_FI_PROF_NVLINK_L16_TX_BYTES,                         // 1072
+	"DCGM_FI_PROF_NVLINK_L16_RX_BYTES":                         DCGM_FI_PROF_NVLINK_L16_RX_BYTES,                         // 1073
+	"DCGM_FI_PROF_NVLINK_L17_TX_BYTES":                         DCGM_FI_PROF_NVLINK_L17_TX_BYTES,                         // 1074
+	"DCGM_FI_PROF_NVLINK_L17_RX_BYTES":                         DCGM_FI_PROF_NVLINK_L17_RX_BYTES,                         // 1075
+	"DCGM_FI_PROF_NVLINK_THROUGHPUT_FIRST":                     DCGM_FI_PROF_NVLINK_THROUGHPUT_FIRST,                     // 1040
+	"DCGM_FI_PROF_NVLINK_THROUGHPUT_LAST":                      DCGM_FI_PROF_NVLINK_THROUGHPUT_LAST,                      // 1075
 	"DCGM_FI_PROF_C2C_TX_ALL_BYTES":                            DCGM_FI_PROF_C2C_TX_ALL_BYTES,                            // 1076
 	"DCGM_FI_PROF_C2C_TX_DATA_BYTES":                           DCGM_FI_PROF_C2C_TX_DATA_BYTES,                           // 1077
 	"DCGM_FI_PROF_C2C_RX_ALL_BYTES":                            DCGM_FI_PROF_C2C_RX_ALL_BYTES,                            // 1078
@@ -1623,8 +1648,10 @@ var dcgmFields = map[string]Short{
 	"DCGM_FI_DEV_CPU_UTIL_IRQ":                                 DCGM_FI_DEV_CPU_UTIL_IRQ,                                 // 1104
 	"DCGM_FI_DEV_CPU_TEMP_CURRENT":                             DCGM_FI_DEV_CPU_TEMP_CURRENT,                             // 1110
 	"DCGM_FI_DEV_CPU_TEMP_WARNING":                             DCGM_FI_DEV_CPU_TEMP_WARNING,                             // 1111
+	"DCGM_FI_DEV_CPU_TEMP_CRITICAL":                            DCGM_FI_DEV_CPU_TEMP_CRITICAL,                            // 1112
 	"DCGM_FI_DEV_CPU_TEMP_SHUTDOWN":                            DCGM_FI_DEV_CPU_TEMP_SHUTDOWN,                            // 1112
 	"DCGM_FI_DEV_CPU_CLOCK_CURRENT":                            DCGM_FI_DEV_CPU_CLOCK_CURRENT,                            // 1120
+	"DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT":                       DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT,                       // 1130
 	"DCGM_FI_DEV_CPU_POWER_CURRENT":                            DCGM_FI_DEV_CPU_POWER_CURRENT,                            // 1130
 	"DCGM_FI_DEV_CPU_POWER_LIMIT":                              DCGM_FI_DEV_CPU_POWER_LIMIT,                              // 1131
 	"DCGM_FI_DEV_SYSIO_POWER_UTIL_CURRENT":                     DCGM_FI_DEV_SYSIO_POWER_UTIL_CURRENT,                     // 1132
@@ -1647,6 +1674,7 @@ var dcgmFields = map[string]Short{
 	"DCGM_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS":            DCGM_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS,            // 1213
 	"DCGM_FI_DEV_NVLINK_COUNT_RX_SYMBOL_ERRORS":                DCGM_FI_DEV_NVLINK_COUNT_RX_SYMBOL_ERRORS,                // 1214
 	"DCGM_FI_DEV_NVLINK_COUNT_SYMBOL_BER":                      DCGM_FI_DEV_NVLINK_COUNT_SYMBOL_BER,                      // 1215
+	"DCGM_FI_DEV_FIRST_CONNECTX_FIELD_ID":                      DCGM_FI_DEV_FIRST_CONNECTX_FIELD_ID,                      // 1300
 	"DCGM_FI_DEV_CONNECTX_HEALTH":                              DCGM_FI_DEV_CONNECTX_HEALTH,                              // 1300
 	"DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_WIDTH":              DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_WIDTH,              // 1301
 	"DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_SPEED":              DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_SPEED,              // 1302
@@ -1661,85 +1689,3 @@ var dcgmFields = map[string]Short{
 	"DCGM_FI_DEV_LAST_CONNECTX_FIELD_ID":                       DCGM_FI_DEV_LAST_CONNECTX_FIELD_ID,                       // 1399
 	"DCGM_FI_MAX_FIELDS":                                       DCGM_FI_MAX_FIELDS,                                       // 1311
 }
-
-var legacyDCGMFields = map[string]Short{
-	"dcgm_sm_clock":                          100,
-	"dcgm_memory_clock":                      101,
-	"dcgm_memory_temp":                       140,
-	"dcgm_gpu_temp":                          150,
-	"dcgm_power_usage":                       155,
-	"dcgm_total_energy_consumption":          156,
-	"dcgm_pcie_tx_throughput":                200,
-	"dcgm_pcie_rx_throughput":                201,
-	"dcgm_pcie_replay_counter":               202,
-	"dcgm_gpu_utilization":                   203,
-	"dcgm_mem_copy_utilization":              204,
-	"dcgm_enc_utilization":                   206,
-	"dcgm_dec_utilization":                   207,
-	"dcgm_xid_errors":                        230,
-	"dcgm_power_violation":                   240,
-	"dcgm_thermal_violation":                 241,
-	"dcgm_sync_boost_violation":              242,
-	"dcgm_board_limit_violation":             243,
-	"dcgm_low_util_violation":                244,
-	"dcgm_reliability_violation":             245,
-	"dcgm_fb_free":                           251,
-	"dcgm_fb_used":                           252,
-	"dcgm_ecc_sbe_volatile_total":            310,
-	"dcgm_ecc_dbe_volatile_total":            311,
-	"dcgm_ecc_sbe_aggregate_total":           312,
-	"dcgm_ecc_dbe_aggregate_total":           313,
-	"dcgm_retired_pages_sbe":                 390,
-	"dcgm_retired_pages_dbe":                 391,
-	"dcgm_retired_pages_pending":             392,
-	"dcgm_nvlink_flit_crc_error_count_total": 409,
-	"dcgm_nvlink_data_crc_error_count_total": 419,
-	"dcgm_nvlink_replay_error_count_total":   429,
-	"dcgm_nvlink_recovery_error_count_total": 439,
-	"dcgm_nvlink_bandwidth_total":            449,
-	"dcgm_fi_prof_gr_engine_active":          1001,
-	"dcgm_fi_prof_sm_active":                 1002,
-	"dcgm_fi_prof_sm_occupancy":              1003,
-	"dcgm_fi_prof_pipe_tensor_active":        1004,
-	"dcgm_fi_prof_dram_active":               1005,
-	"dcgm_fi_prof_pcie_tx_bytes":             1009,
-	"dcgm_fi_prof_pcie_rx_bytes":             1010,
-}
-
-// GetFieldID returns the DCGM field ID for a given field name and whether it was found
-// It first checks the current field IDs, then falls back to legacy field IDs if not found
-func GetFieldID(fieldName string) (Short, bool) {
-	// First check current field IDs
-	if fieldID, ok := dcgmFields[fieldName]; ok {
-		return fieldID, true
-	}
-
-	// Then check legacy field IDs
-	if fieldID, ok := legacyDCGMFields[fieldName]; ok {
-		return fieldID, true
-	}
-
-	return 0, false
-}
-
-// GetFieldIDOrPanic returns the DCGM field ID for a given field name
-// It panics if the field name is not found in either current or legacy maps
-func GetFieldIDOrPanic(fieldName string) Short {
-	fieldID, ok := GetFieldID(fieldName)
-	if !ok {
-		panic("field name not found: " + fieldName)
-	}
-	return fieldID
-}
-
-// IsLegacyField returns true if the given field name is a legacy field
-func IsLegacyField(fieldName string) bool {
-	_, ok := legacyDCGMFields[fieldName]
-	return ok
-}
-
-// IsCurrentField returns true if the given field name is a current field
-func IsCurrentField(fieldName string) bool {
-	_, ok := dcgmFields[fieldName]
-	return ok
-}
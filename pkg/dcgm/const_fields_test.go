@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstFieldsMatchHeader(t *testing.T) {
+	header, err := os.Open("dcgm_fields.h")
+	require.NoError(t, err)
+	defer header.Close()
+
+	define := regexp.MustCompile(`^#define\s+(DCGM_FI_\w+)\s+(\d+)\s*$`)
+	var count int
+	scanner := bufio.NewScanner(header)
+	for scanner.Scan() {
+		m := define.FindStringSubmatch(scanner.Text())
+		if m == nil || strings.HasPrefix(m[1], "DCGM_FI_INTERNAL_") {
+			continue
+		}
+		want, err := strconv.Atoi(m[2])
+		require.NoError(t, err)
+
+		fieldID, ok := GetFieldID(m[1])
+		if assert.True(t, ok, "%s is missing, run go generate", m[1]) {
+			assert.Equal(t, Short(want), fieldID, m[1])
+		}
+		count++
+	}
+	require.NoError(t, scanner.Err())
+	assert.Greater(t, count, 500)
+
+	assert.Equal(t, DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT, GetFieldIDOrPanic("DCGM_FI_DEV_CPU_POWER_CURRENT"))
+}
@@ -50,12 +50,115 @@ type CPUHierarchy_v1 struct {
 	CPUs [MAX_NUM_CPUS]CPUHierarchyCPU_v1
 }
 
+// Cores returns the IDs of the cores owned by the CPU, in ascending order
+func (cpu CPUHierarchyCPU_v1) Cores() []uint {
+	var cores []uint
+	for i, word := range cpu.OwnedCores {
+		for bit := uint(0); bit < 64; bit++ {
+			if word&(1<<bit) != 0 {
+				cores = append(cores, uint(i)*64+bit)
+			}
+		}
+	}
+	return cores
+}
+
+// Entities returns the CPUs of the hierarchy followed by all of their cores, as entities that can be
+// added to groups and passed to EntitiesGetLatestValues to read CPU fields
+func (h CPUHierarchy_v1) Entities() []GroupEntityPair {
+	var cpus, cores []GroupEntityPair
+	for i := uint(0); i < h.NumCPUs && i < MAX_NUM_CPUS; i++ {
+		cpus = append(cpus, GroupEntityPair{EntityGroupId: FE_CPU, EntityId: h.CPUs[i].CPUID})
+		for _, core := range h.CPUs[i].Cores() {
+			cores = append(cores, GroupEntityPair{EntityGroupId: FE_CPU_CORE, EntityId: core})
+		}
+	}
+	return append(cpus, cores...)
+}
+
+// NewCPUGroup creates a group with the specified name holding every CPU of the hierarchy and, if
+// withCores is set, all of their cores. CPU fields such as DCGM_FI_DEV_CPU_UTIL_TOTAL,
+// DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT or DCGM_FI_DEV_CPU_TEMP_CURRENT can then be watched on
+// the group with WatchFieldsWithGroup.
+func NewCPUGroup(groupName string, withCores bool) (GroupHandle, error) {
+	return defaultClient.NewCPUGroup(groupName, withCores)
+}
+
+// NewCPUGroup creates a group with the specified name holding every CPU of the hierarchy and, if
+// withCores is set, all of their cores. CPU fields such as DCGM_FI_DEV_CPU_UTIL_TOTAL,
+// DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT or DCGM_FI_DEV_CPU_TEMP_CURRENT can then be watched on
+// the group with WatchFieldsWithGroup.
+func (c *Client) NewCPUGroup(groupName string, withCores bool) (GroupHandle, error) {
+	hierarchy, err := c.GetCPUHierarchy()
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	return c.createGroupWithEntities(GroupEmpty, groupName, cpuGroupEntities(hierarchy, withCores))
+}
+
+// NewSuperchipGroup creates a group with the specified name holding every supported GPU, every
+// CPU of the hierarchy and, if withCores is set, all of their cores, to monitor the GPUs and
+// CPUs of Grace superchips together. Health watches and fields set on the group apply to the
+// entities that support them; GPU fields are blank for CPUs and CPU fields for GPUs.
+func NewSuperchipGroup(groupName string, withCores bool) (GroupHandle, error) {
+	return defaultClient.NewSuperchipGroup(groupName, withCores)
+}
+
+// NewSuperchipGroup creates a group with the specified name holding every supported GPU, every
+// CPU of the hierarchy and, if withCores is set, all of their cores, to monitor the GPUs and
+// CPUs of Grace superchips together. Health watches and fields set on the group apply to the
+// entities that support them; GPU fields are blank for CPUs and CPU fields for GPUs.
+func (c *Client) NewSuperchipGroup(groupName string, withCores bool) (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
+
+	gpus, err := c.getSupportedDevices()
+	if err != nil {
+		return GroupHandle{}, err
+	}
+	hierarchy, err := c.GetCPUHierarchy()
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	entities := make([]GroupEntityPair, 0, len(gpus))
+	for _, gpu := range gpus {
+		entities = append(entities, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu})
+	}
+	return c.createGroupWithEntities(GroupEmpty, groupName, append(entities, cpuGroupEntities(hierarchy, withCores)...))
+}
+
+// cpuGroupEntities returns the CPUs of the hierarchy followed, if withCores is set, by their cores
+func cpuGroupEntities(hierarchy CPUHierarchy_v1, withCores bool) []GroupEntityPair {
+	var entities []GroupEntityPair
+	for _, entity := range hierarchy.Entities() {
+		if entity.EntityGroupId == FE_CPU_CORE && !withCores {
+			continue
+		}
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
 // GetCPUHierarchy retrieves the CPU hierarchy information from DCGM
 func GetCPUHierarchy() (hierarchy CPUHierarchy_v1, err error) {
+	return defaultClient.GetCPUHierarchy()
+}
+
+// GetCPUHierarchy retrieves the CPU hierarchy information from DCGM
+func (c *Client) GetCPUHierarchy() (hierarchy CPUHierarchy_v1, err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
 	var c_hierarchy C.dcgmCpuHierarchy_v1
 	c_hierarchy.version = C.dcgmCpuHierarchy_version1
 	ptr_hierarchy := (*C.dcgmCpuHierarchy_v1)(unsafe.Pointer(&c_hierarchy))
-	result := C.dcgmGetCpuHierarchy(handle.handle, ptr_hierarchy)
+	result := C.dcgmGetCpuHierarchy(c.dcgmHandle(), ptr_hierarchy)
 
 	if err = errorString(result); err != nil {
 		return toCpuHierarchy(c_hierarchy), fmt.Errorf("error retrieving DCGM CPU hierarchy: %s", err)
@@ -0,0 +1,130 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// CPUReading is a sample of the utilization, temperature, clock and power of a Grace CPU or of one
+// of its cores. Cores only report utilization and clock; values that are not reported are NaN.
+type CPUReading struct {
+	// Entity is the FE_CPU or FE_CPU_CORE entity the reading is for
+	Entity Entity
+	Time   time.Time
+	// Utilization is the total utilization, and User, Nice, System and IRQ its parts, as
+	// reported by the hostengine
+	Utilization, User, Nice, System, IRQ float64
+	// Temperature is the CPU temperature in °C
+	Temperature float64
+	// Clock is the current clock frequency in MHz
+	Clock float64
+	// Power is the current power draw of the CPU in W
+	Power float64
+	// PowerLimit is the power limit of the CPU in W
+	PowerLimit float64
+}
+
+var cpuReadingFields = []Short{
+	DCGM_FI_DEV_CPU_UTIL_TOTAL,
+	DCGM_FI_DEV_CPU_UTIL_USER,
+	DCGM_FI_DEV_CPU_UTIL_NICE,
+	DCGM_FI_DEV_CPU_UTIL_SYS,
+	DCGM_FI_DEV_CPU_UTIL_IRQ,
+	DCGM_FI_DEV_CPU_TEMP_CURRENT,
+	DCGM_FI_DEV_CPU_CLOCK_CURRENT,
+	DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT,
+	DCGM_FI_DEV_CPU_POWER_LIMIT,
+}
+
+// GetCPUReadings samples the utilization, temperature, clock and power of every CPU in the CPU
+// hierarchy and, if withCores is set, the utilization and clock of all of their cores. Readings
+// are in the order of CPUHierarchy_v1.Entities.
+func GetCPUReadings(withCores bool) ([]CPUReading, error) {
+	return defaultClient.GetCPUReadings(withCores)
+}
+
+// GetCPUReadings samples the utilization, temperature, clock and power of every CPU in the CPU
+// hierarchy and, if withCores is set, the utilization and clock of all of their cores. Readings
+// are in the order of CPUHierarchy_v1.Entities.
+func (c *Client) GetCPUReadings(withCores bool) ([]CPUReading, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	hierarchy, err := c.GetCPUHierarchy()
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []GroupEntityPair
+	for _, entity := range hierarchy.Entities() {
+		if entity.EntityGroupId == FE_CPU || withCores {
+			entities = append(entities, entity)
+		}
+	}
+	if len(entities) == 0 {
+		return []CPUReading{}, nil
+	}
+
+	values, err := c.sampleEntityFields("cpuReadings", entities, cpuReadingFields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting CPU readings: %s", err)
+	}
+
+	return toCPUReadings(entities, values), nil
+}
+
+func toCPUReadings(entities []GroupEntityPair, values []FieldValue_v2) []CPUReading {
+	nan := math.NaN()
+	readings := make([]CPUReading, len(entities))
+	index := make(map[Entity]*CPUReading, len(entities))
+	for i, entity := range entities {
+		readings[i] = CPUReading{
+			Entity:      entity.Entity(),
+			Utilization: nan, User: nan, Nice: nan, System: nan, IRQ: nan,
+			Temperature: nan, Clock: nan, Power: nan, PowerLimit: nan,
+		}
+		index[readings[i].Entity] = &readings[i]
+	}
+
+	for _, value := range values {
+		reading, ok := index[Entity{Group: value.EntityGroupId, ID: value.EntityID}]
+		if !ok {
+			continue
+		}
+		v, ok := scaledValue(value, 1)
+		if !ok {
+			continue
+		}
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_CPU_UTIL_TOTAL:
+			reading.Utilization = v
+		case DCGM_FI_DEV_CPU_UTIL_USER:
+			reading.User = v
+		case DCGM_FI_DEV_CPU_UTIL_NICE:
+			reading.Nice = v
+		case DCGM_FI_DEV_CPU_UTIL_SYS:
+			reading.System = v
+		case DCGM_FI_DEV_CPU_UTIL_IRQ:
+			reading.IRQ = v
+		case DCGM_FI_DEV_CPU_TEMP_CURRENT:
+			reading.Temperature = v
+		case DCGM_FI_DEV_CPU_CLOCK_CURRENT:
+			reading.Clock = v
+		case DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT:
+			reading.Power = v
+		case DCGM_FI_DEV_CPU_POWER_LIMIT:
+			reading.PowerLimit = v
+		default:
+			continue
+		}
+		if ts := timestampUSECToTime(value.TS); ts.After(reading.Time) {
+			reading.Time = ts
+		}
+	}
+
+	return readings
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUReadings(t *testing.T) {
+	cpu := GroupEntityPair{EntityGroupId: FE_CPU, EntityId: 0}
+	core := GroupEntityPair{EntityGroupId: FE_CPU_CORE, EntityId: 5}
+
+	watts, ok := fakeFloat64FieldValue(cpu.Entity(), DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT, 120, 0).Watts()
+	require.True(t, ok)
+	assert.InDelta(t, 120, watts, 0)
+
+	readings := toCPUReadings([]GroupEntityPair{cpu, core}, []FieldValue_v2{
+		fakeFloat64FieldValue(cpu.Entity(), DCGM_FI_DEV_CPU_UTIL_TOTAL, 0.5, 1_000_000),
+		fakeFloat64FieldValue(cpu.Entity(), DCGM_FI_DEV_CPU_TEMP_CURRENT, 45, 2_000_000),
+		fakeFloat64FieldValue(cpu.Entity(), DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT, 120, 1_000_000),
+		fakeFloat64FieldValue(core.Entity(), DCGM_FI_DEV_CPU_UTIL_TOTAL, 0.9, 1_000_000),
+		fakeFloat64FieldValue(core.Entity(), DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT, DCGM_FT_FP64_NOT_SUPPORTED, 1_000_000),
+	})
+	require.Len(t, readings, 2)
+
+	assert.Equal(t, Entity{Group: FE_CPU, ID: 0}, readings[0].Entity)
+	assert.InDelta(t, 0.5, readings[0].Utilization, 0)
+	assert.InDelta(t, 45, readings[0].Temperature, 0)
+	assert.InDelta(t, 120, readings[0].Power, 0)
+	assert.True(t, math.IsNaN(readings[0].Clock))
+	assert.Equal(t, timestampUSECToTime(2_000_000), readings[0].Time)
+
+	assert.Equal(t, Entity{Group: FE_CPU_CORE, ID: 5}, readings[1].Entity)
+	assert.InDelta(t, 0.9, readings[1].Utilization, 0)
+	assert.True(t, math.IsNaN(readings[1].Power))
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUHierarchyEntities(t *testing.T) {
+	var hierarchy CPUHierarchy_v1
+	hierarchy.NumCPUs = 2
+	hierarchy.CPUs[0] = CPUHierarchyCPU_v1{CPUID: 0, OwnedCores: []uint64{0b101}}
+	hierarchy.CPUs[1] = CPUHierarchyCPU_v1{CPUID: 1, OwnedCores: []uint64{0, 1 << 63, 1}}
+
+	assert.Equal(t, []uint{0, 2}, hierarchy.CPUs[0].Cores())
+	assert.Equal(t, []uint{127, 128}, hierarchy.CPUs[1].Cores())
+
+	assert.Equal(t, []GroupEntityPair{
+		{EntityGroupId: FE_CPU, EntityId: 0},
+		{EntityGroupId: FE_CPU, EntityId: 1},
+		{EntityGroupId: FE_CPU_CORE, EntityId: 0},
+		{EntityGroupId: FE_CPU_CORE, EntityId: 2},
+		{EntityGroupId: FE_CPU_CORE, EntityId: 127},
+		{EntityGroupId: FE_CPU_CORE, EntityId: 128},
+	}, hierarchy.Entities())
+
+	assert.Equal(t, []GroupEntityPair{
+		{EntityGroupId: FE_CPU, EntityId: 0},
+		{EntityGroupId: FE_CPU, EntityId: 1},
+	}, cpuGroupEntities(hierarchy, false))
+	assert.Equal(t, hierarchy.Entities(), cpuGroupEntities(hierarchy, true))
+}
+
+func TestNewSuperchipGroup(t *testing.T) {
+	_, err := (&Client{closing: true}).NewSuperchipGroup("superchip", true)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
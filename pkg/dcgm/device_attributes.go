@@ -0,0 +1,123 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+)
+
+// ClockSet is a supported combination of memory and SM clocks
+type ClockSet struct {
+	MemClock uint // MHz
+	SMClock  uint // MHz
+}
+
+// DeviceThermals contains the temperature thresholds of a GPU
+type DeviceThermals struct {
+	SlowdownTemp uint // °C
+	ShutdownTemp uint // °C
+}
+
+// DevicePowerLimits contains the power management limits of a GPU
+type DevicePowerLimits struct {
+	Current  uint // W
+	Default  uint // W
+	Enforced uint // W
+	Min      uint // W
+	Max      uint // W
+}
+
+// DeviceMemoryUsage contains the BAR1 size and framebuffer usage of a GPU
+type DeviceMemoryUsage struct {
+	BAR1Total uint // MB
+	FBTotal   uint // MB
+	FBUsed    uint // MB
+	FBFree    uint // MB
+}
+
+// DeviceSettings contains the basic mode settings of a GPU
+type DeviceSettings struct {
+	PersistenceMode     bool
+	MIGMode             bool
+	ConfidentialCompute bool
+}
+
+// DeviceAttributes contains all the static attributes DCGM reports for a GPU
+type DeviceAttributes struct {
+	GPU          uint
+	UUID         string
+	PCIBusID     string
+	PCIDeviceID  uint // combined 16-bit device ID and 16-bit vendor ID
+	PCISubSystem uint
+	// VirtualizationMode is one of the DCGM_GPU_VIRTUALIZATION_MODE_* values
+	VirtualizationMode uint
+	Identifiers        DeviceIdentifiers
+	ClockSets          []ClockSet
+	Thermals           DeviceThermals
+	PowerLimits        DevicePowerLimits
+	Memory             DeviceMemoryUsage
+	Settings           DeviceSettings
+}
+
+func (c *Client) getDeviceAttributes(gpuID uint) (DeviceAttributes, error) {
+	var device C.dcgmDeviceAttributes_t
+	device.version = makeVersion3(unsafe.Sizeof(device))
+
+	result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpuID), &device)
+	if err := errorString(result); err != nil {
+		return DeviceAttributes{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	count := min(int(device.clockSets.count), int(C.DCGM_MAX_CLOCKS))
+	clockSets := make([]ClockSet, count)
+	for i := range clockSets {
+		clockSets[i] = ClockSet{
+			MemClock: *uintPtr(device.clockSets.clockSet[i].memClock),
+			SMClock:  *uintPtr(device.clockSets.clockSet[i].smClock),
+		}
+	}
+
+	return DeviceAttributes{
+		GPU:                gpuID,
+		UUID:               *stringPtr(&device.identifiers.uuid[0]),
+		PCIBusID:           *stringPtr(&device.identifiers.pciBusId[0]),
+		PCIDeviceID:        *uintPtr(device.identifiers.pciDeviceId),
+		PCISubSystem:       *uintPtr(device.identifiers.pciSubSystemId),
+		VirtualizationMode: *uintPtr(device.identifiers.virtualizationMode),
+		Identifiers: DeviceIdentifiers{
+			Brand:               *stringPtr(&device.identifiers.brandName[0]),
+			Model:               *stringPtr(&device.identifiers.deviceName[0]),
+			Serial:              *stringPtr(&device.identifiers.serial[0]),
+			Vbios:               *stringPtr(&device.identifiers.vbios[0]),
+			InforomImageVersion: *stringPtr(&device.identifiers.inforomImageVersion[0]),
+			DriverVersion:       *stringPtr(&device.identifiers.driverVersion[0]),
+		},
+		ClockSets: clockSets,
+		Thermals: DeviceThermals{
+			SlowdownTemp: *uintPtr(device.thermalSettings.slowdownTemp),
+			ShutdownTemp: *uintPtr(device.thermalSettings.shutdownTemp),
+		},
+		PowerLimits: DevicePowerLimits{
+			Current:  *uintPtr(device.powerLimits.curPowerLimit),
+			Default:  *uintPtr(device.powerLimits.defaultPowerLimit),
+			Enforced: *uintPtr(device.powerLimits.enforcedPowerLimit),
+			Min:      *uintPtr(device.powerLimits.minPowerLimit),
+			Max:      *uintPtr(device.powerLimits.maxPowerLimit),
+		},
+		Memory: DeviceMemoryUsage{
+			BAR1Total: *uintPtr(device.memoryUsage.bar1Total),
+			FBTotal:   *uintPtr(device.memoryUsage.fbTotal),
+			FBUsed:    *uintPtr(device.memoryUsage.fbUsed),
+			FBFree:    *uintPtr(device.memoryUsage.fbFree),
+		},
+		Settings: DeviceSettings{
+			PersistenceMode:     device.settings.persistenceModeEnabled != 0,
+			MIGMode:             device.settings.migModeEnabled != 0,
+			ConfidentialCompute: device.settings.confidentialComputeMode != 0,
+		},
+	}, nil
+}
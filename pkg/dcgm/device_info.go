@@ -31,6 +31,11 @@ type DeviceIdentifiers struct {
 	Vbios               string
 	InforomImageVersion string
 	DriverVersion       string
+	// InforomOEMVersion, InforomECCVersion and InforomPowerVersion are the versions of the InfoROM
+	// objects, empty if the GPU does not report them
+	InforomOEMVersion   string
+	InforomECCVersion   string
+	InforomPowerVersion string
 }
 
 // Device represents a GPU device and its properties
@@ -43,16 +48,19 @@ type Device struct {
 	Identifiers   DeviceIdentifiers
 	Topology      []P2PLink
 	CPUAffinity   string
+	// ComputeCapability and Architecture are zero if the driver does not report the compute capability
+	ComputeCapability ComputeCapability
+	Architecture      Architecture
 }
 
 // getAllDeviceCount counts all GPUs on the system
-func getAllDeviceCount() (gpuCount uint, err error) {
+func (c *Client) getAllDeviceCount() (gpuCount uint, err error) {
 	var (
 		gpuIDList [C.DCGM_MAX_NUM_DEVICES]C.uint
 		count     C.int
 	)
 
-	result := C.dcgmGetAllDevices(handle.handle, &gpuIDList[0], &count)
+	result := C.dcgmGetAllDevices(c.dcgmHandle(), &gpuIDList[0], &count)
 	if err = errorString(result); err != nil {
 		return gpuCount, fmt.Errorf("error getting devices count: %s", err)
 	}
@@ -61,12 +69,12 @@ func getAllDeviceCount() (gpuCount uint, err error) {
 }
 
 // getAllDeviceCount counts all GPUs on the system
-func getEntityGroupEntities(entityGroup Field_Entity_Group) ([]uint, error) {
+func (c *Client) getEntityGroupEntities(entityGroup Field_Entity_Group) ([]uint, error) {
 	var err error
 	var pEntities [C.DCGM_MAX_NUM_DEVICES]C.uint
 	var count C.int = C.DCGM_MAX_NUM_DEVICES
 
-	result := C.dcgmGetEntityGroupEntities(handle.handle, C.dcgm_field_entity_group_t(entityGroup), &pEntities[0], &count, 0)
+	result := C.dcgmGetEntityGroupEntities(c.dcgmHandle(), C.dcgm_field_entity_group_t(entityGroup), &pEntities[0], &count, 0)
 	if err = errorString(result); err != nil {
 		return nil, fmt.Errorf("error getting entity count: %s", err)
 	}
@@ -80,11 +88,11 @@ func getEntityGroupEntities(entityGroup Field_Entity_Group) ([]uint, error) {
 }
 
 // getSupportedDevices returns DCGM supported GPUs
-func getSupportedDevices() (gpus []uint, err error) {
+func (c *Client) getSupportedDevices() (gpus []uint, err error) {
 	var gpuIDList [C.DCGM_MAX_NUM_DEVICES]C.uint
 	var count C.int
 
-	result := C.dcgmGetAllSupportedDevices(handle.handle, &gpuIDList[0], &count)
+	result := C.dcgmGetAllSupportedDevices(c.dcgmHandle(), &gpuIDList[0], &count)
 	if err = errorString(result); err != nil {
 		return gpus, &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
@@ -97,7 +105,7 @@ func getSupportedDevices() (gpus []uint, err error) {
 	return
 }
 
-func getPciBandwidth(gpuID uint) (int64, error) {
+func (c *Client) getPciBandwidth(gpuID uint) (int64, error) {
 	const (
 		maxLinkGen int = iota
 		maxLinkWidth
@@ -110,31 +118,36 @@ func getPciBandwidth(gpuID uint) (int64, error) {
 
 	fieldsName := fmt.Sprintf("pciBandwidthFields%d", rand.Uint64())
 
-	fieldsID, err := FieldGroupCreate(fieldsName, pciFields)
+	fieldsID, err := c.FieldGroupCreate(fieldsName, pciFields)
 	if err != nil {
 		return 0, err
 	}
 
 	groupName := fmt.Sprintf("pciBandwidth%d", rand.Uint64())
-	groupID, err := WatchFields(gpuID, fieldsID, groupName)
+	groupID, err := c.WatchFields(gpuID, fieldsID, groupName)
 	if err != nil {
-		_ = FieldGroupDestroy(fieldsID)
+		_ = c.FieldGroupDestroy(fieldsID)
 		return 0, err
 	}
 
-	values, err := GetLatestValuesForFields(gpuID, pciFields)
+	values, err := c.GetLatestValuesForFields(gpuID, pciFields)
 	if err != nil {
-		_ = FieldGroupDestroy(fieldsID)
-		_ = DestroyGroup(groupID)
+		_ = c.FieldGroupDestroy(fieldsID)
+		_ = c.DestroyGroup(groupID)
 		return 0, fmt.Errorf("error getting Pcie bandwidth: %s", err)
 	}
 
 	gen := values[maxLinkGen].Int64()
 	width := values[maxLinkWidth].Int64()
 
-	_ = FieldGroupDestroy(fieldsID)
-	_ = DestroyGroup(groupID)
+	_ = c.FieldGroupDestroy(fieldsID)
+	_ = c.DestroyGroup(groupID)
 
+	return pcieBandwidth(gen, width), nil
+}
+
+// pcieBandwidth returns the bandwidth in MB/s of a PCIe link of the given generation and width
+func pcieBandwidth(gen, width int64) int64 {
 	genMap := map[int64]int64{
 		1: 250, // MB/s
 		2: 500,
@@ -142,11 +155,10 @@ func getPciBandwidth(gpuID uint) (int64, error) {
 		4: 1969,
 	}
 
-	bandwidth := genMap[gen] * width
-	return bandwidth, nil
+	return genMap[gen] * width
 }
 
-func getCPUAffinity(gpuID uint) (string, error) {
+func (c *Client) getCPUAffinity(gpuID uint) (string, error) {
 	const (
 		affinity0 int = iota
 		affinity1
@@ -163,12 +175,12 @@ func getCPUAffinity(gpuID uint) (string, error) {
 
 	fieldsName := fmt.Sprintf("cpuAffFields%d", rand.Uint64())
 
-	fieldsId, err := FieldGroupCreate(fieldsName, affFields)
+	fieldsId, err := c.FieldGroupCreate(fieldsName, affFields)
 	if err != nil {
 		return "N/A", err
 	}
 	defer func() {
-		ret := FieldGroupDestroy(fieldsId)
+		ret := c.FieldGroupDestroy(fieldsId)
 
 		if ret != nil {
 			log.Printf("error destroying field group: %v", ret)
@@ -176,19 +188,19 @@ func getCPUAffinity(gpuID uint) (string, error) {
 	}()
 
 	groupName := fmt.Sprintf("cpuAff%d", rand.Uint64())
-	groupID, err := WatchFields(gpuID, fieldsId, groupName)
+	groupID, err := c.WatchFields(gpuID, fieldsId, groupName)
 	if err != nil {
 		return "N/A", err
 	}
 	defer func() {
-		ret := DestroyGroup(groupID)
+		ret := c.DestroyGroup(groupID)
 
 		if ret != nil {
 			log.Printf("error destroying group: %v", ret)
 		}
 	}()
 
-	values, err := GetLatestValuesForFields(gpuID, affFields)
+	values, err := c.GetLatestValuesForFields(gpuID, affFields)
 	if err != nil {
 		return "N/A", fmt.Errorf("error getting cpu affinity: %s", err)
 	}
@@ -199,22 +211,25 @@ func getCPUAffinity(gpuID uint) (string, error) {
 	bits[2] = uint64(values[affinity2].Int64())
 	bits[3] = uint64(values[affinity3].Int64())
 
-	b := bitset.From(bits)
+	return cpuAffinityString(bits), nil
+}
 
-	return b.String(), nil
+// cpuAffinityString formats the CPU affinity bitmask words of a GPU as a CPU set
+func cpuAffinityString(bits []uint64) string {
+	return bitset.From(bits).String()
 }
 
-func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
+func (c *Client) getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
 	var device C.dcgmDeviceAttributes_t
 	device.version = makeVersion3(unsafe.Sizeof(device))
 
-	result := C.dcgmGetDeviceAttributes(handle.handle, C.uint(gpuID), &device)
+	result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpuID), &device)
 	if err = errorString(result); err != nil {
 		return deviceInfo, &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
 
 	// check if the given GPU is DCGM supported
-	gpus, err := getSupportedDevices()
+	gpus, err := c.getSupportedDevices()
 	if err != nil {
 		return
 	}
@@ -228,10 +243,14 @@ func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
 		}
 	}
 
-	busid := *stringPtr(&device.identifiers.pciBusId[0])
+	cpuAffinity, err := c.getCPUAffinity(gpuID)
+	if err != nil {
+		return
+	}
 
-	cpuAffinity, err := getCPUAffinity(gpuID)
+	values, err := c.sampleEntityFields("deviceInfo", []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}, deviceInfoFields)
 	if err != nil {
+		err = fmt.Errorf("error getting device info fields: %s", err)
 		return
 	}
 
@@ -242,24 +261,70 @@ func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
 
 	// get device topology and bandwidth only if its a DCGM supported device
 	if supported == "Yes" {
-		topology, err = getDeviceTopology(gpuID)
+		topology, err = c.getDeviceTopology(gpuID)
 		if err != nil {
 			return
 		}
-		bandwidth, err = getPciBandwidth(gpuID)
+		bandwidth, err = c.getPciBandwidth(gpuID)
 		if err != nil {
 			return
 		}
 	}
 
-	uuid := *stringPtr(&device.identifiers.uuid[0])
-	power := *uintPtr(device.powerLimits.defaultPowerLimit)
+	deviceInfo = toDevice(gpuID, &device, supported)
+	deviceInfo.PCI.Bandwidth = bandwidth
+	deviceInfo.Topology = topology
+	deviceInfo.CPUAffinity = cpuAffinity
+	applyDeviceInfoFields(&deviceInfo, values)
+	return
+}
+
+// deviceInfoFields are the fields sampled for every GPU by getDeviceInfo and getAllDeviceInfo
+var deviceInfoFields = []Short{
+	C.DCGM_FI_DEV_CUDA_COMPUTE_CAPABILITY,
+	C.DCGM_FI_DEV_OEM_INFOROM_VER,
+	C.DCGM_FI_DEV_ECC_INFOROM_VER,
+	C.DCGM_FI_DEV_POWER_INFOROM_VER,
+}
 
+// applyDeviceInfoFields fills in the Device fields that come from deviceInfoFields. Values of
+// other fields and values that are not available are ignored.
+func applyDeviceInfoFields(deviceInfo *Device, values []FieldValue_v2) {
+	inforomVersion := func(value FieldValue_v2) string {
+		if value.FieldType != C.DCGM_FT_STRING {
+			return ""
+		}
+		if version := value.String(); !IsStringBlank(version) {
+			return version
+		}
+		return ""
+	}
+
+	for _, value := range values {
+		if value.Status != C.DCGM_ST_OK {
+			continue
+		}
+
+		switch value.FieldID {
+		case C.DCGM_FI_DEV_CUDA_COMPUTE_CAPABILITY:
+			deviceInfo.ComputeCapability = toComputeCapability(value.Int64())
+			deviceInfo.Architecture = deviceInfo.ComputeCapability.Architecture()
+		case C.DCGM_FI_DEV_OEM_INFOROM_VER:
+			deviceInfo.Identifiers.InforomOEMVersion = inforomVersion(value)
+		case C.DCGM_FI_DEV_ECC_INFOROM_VER:
+			deviceInfo.Identifiers.InforomECCVersion = inforomVersion(value)
+		case C.DCGM_FI_DEV_POWER_INFOROM_VER:
+			deviceInfo.Identifiers.InforomPowerVersion = inforomVersion(value)
+		}
+	}
+}
+
+// toDevice fills in the Device fields that come from the device attributes
+func toDevice(gpuID uint, device *C.dcgmDeviceAttributes_t, supported string) Device {
 	pci := PCIInfo{
-		BusID:     busid,
-		BAR1:      *uintPtr(device.memoryUsage.bar1Total),
-		FBTotal:   *uintPtr(device.memoryUsage.fbTotal),
-		Bandwidth: bandwidth,
+		BusID:   *stringPtr(&device.identifiers.pciBusId[0]),
+		BAR1:    *uintPtr(device.memoryUsage.bar1Total),
+		FBTotal: *uintPtr(device.memoryUsage.fbTotal),
 	}
 
 	identifiers := DeviceIdentifiers{
@@ -271,15 +336,100 @@ func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
 		DriverVersion:       *stringPtr(&device.identifiers.driverVersion[0]),
 	}
 
-	deviceInfo = Device{
+	return Device{
 		GPU:           gpuID,
 		DCGMSupported: supported,
-		UUID:          uuid,
-		Power:         power,
+		UUID:          *stringPtr(&device.identifiers.uuid[0]),
+		Power:         *uintPtr(device.powerLimits.defaultPowerLimit),
 		PCI:           pci,
 		Identifiers:   identifiers,
-		Topology:      topology,
-		CPUAffinity:   cpuAffinity,
 	}
-	return
+}
+
+// getAllDeviceInfo collects the information for all GPUs with a single field watch shared by
+// every GPU, instead of the field group, group and watch per GPU that getDeviceInfo needs
+func (c *Client) getAllDeviceInfo() ([]Device, error) {
+	gpus, err := c.getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return nil, err
+	}
+	if len(gpus) == 0 {
+		return []Device{}, nil
+	}
+
+	supportedGpus, err := c.getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+	supported := make(map[uint]bool, len(supportedGpus))
+	for _, gpu := range supportedGpus {
+		supported[gpu] = true
+	}
+
+	fields := append([]Short{
+		C.DCGM_FI_DEV_PCIE_MAX_LINK_GEN,
+		C.DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH,
+		C.DCGM_FI_DEV_CPU_AFFINITY_0,
+		C.DCGM_FI_DEV_CPU_AFFINITY_1,
+		C.DCGM_FI_DEV_CPU_AFFINITY_2,
+		C.DCGM_FI_DEV_CPU_AFFINITY_3,
+	}, deviceInfoFields...)
+
+	entities := make([]GroupEntityPair, len(gpus))
+	for i, gpu := range gpus {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu}
+	}
+
+	values, err := c.sampleEntityFields("allDeviceInfo", entities, fields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting device info fields: %s", err)
+	}
+
+	gpuValues := make(map[uint]map[Short]int64, len(gpus))
+	gpuFieldValues := make(map[uint][]FieldValue_v2, len(gpus))
+	for _, value := range values {
+		gpuFieldValues[value.EntityID] = append(gpuFieldValues[value.EntityID], value)
+		if gpuValues[value.EntityID] == nil {
+			gpuValues[value.EntityID] = make(map[Short]int64, len(fields))
+		}
+		gpuValues[value.EntityID][value.FieldID] = value.Int64()
+	}
+
+	devices := make([]Device, 0, len(gpus))
+	for _, gpu := range gpus {
+		var device C.dcgmDeviceAttributes_t
+		device.version = makeVersion3(unsafe.Sizeof(device))
+
+		result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpu), &device)
+		if err = errorString(result); err != nil {
+			return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+
+		isSupported := "No"
+		if supported[gpu] {
+			isSupported = "Yes"
+		}
+
+		deviceInfo := toDevice(gpu, &device, isSupported)
+
+		v := gpuValues[gpu]
+		deviceInfo.CPUAffinity = cpuAffinityString([]uint64{
+			uint64(v[C.DCGM_FI_DEV_CPU_AFFINITY_0]),
+			uint64(v[C.DCGM_FI_DEV_CPU_AFFINITY_1]),
+			uint64(v[C.DCGM_FI_DEV_CPU_AFFINITY_2]),
+			uint64(v[C.DCGM_FI_DEV_CPU_AFFINITY_3]),
+		})
+		applyDeviceInfoFields(&deviceInfo, gpuFieldValues[gpu])
+
+		if supported[gpu] {
+			deviceInfo.PCI.Bandwidth = pcieBandwidth(v[C.DCGM_FI_DEV_PCIE_MAX_LINK_GEN], v[C.DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH])
+			if deviceInfo.Topology, err = c.getDeviceTopology(gpu); err != nil {
+				return nil, err
+			}
+		}
+
+		devices = append(devices, deviceInfo)
+	}
+
+	return devices, nil
 }
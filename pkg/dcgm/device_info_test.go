@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPCIeBandwidth(t *testing.T) {
+	assert.Equal(t, int64(985*16), pcieBandwidth(3, 16))
+	assert.Equal(t, int64(1969*8), pcieBandwidth(4, 8))
+	assert.Equal(t, int64(0), pcieBandwidth(6, 16))
+}
+
+func TestApplyDeviceInfoFields(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+	notSupported := fakeStringFieldValue(gpu, DCGM_FI_DEV_POWER_INFOROM_VER, "G503.0203.00.04", 0)
+	notSupported.Status = DCGM_ST_NOT_SUPPORTED
+
+	var device Device
+	applyDeviceInfoFields(&device, []FieldValue_v2{
+		fakeFieldValue(gpu, DCGM_FI_DEV_CUDA_COMPUTE_CAPABILITY, 9<<16, 0),
+		fakeStringFieldValue(gpu, DCGM_FI_DEV_OEM_INFOROM_VER, "1.1", 0),
+		fakeStringFieldValue(gpu, DCGM_FI_DEV_ECC_INFOROM_VER, DCGM_FT_STR_NOT_SUPPORTED, 0),
+		notSupported,
+	})
+
+	assert.Equal(t, ComputeCapability{Major: 9}, device.ComputeCapability)
+	assert.Equal(t, ArchitectureHopper, device.Architecture)
+	assert.Equal(t, "1.1", device.Identifiers.InforomOEMVersion)
+	assert.Empty(t, device.Identifiers.InforomECCVersion)
+	assert.Empty(t, device.Identifiers.InforomPowerVersion)
+}
@@ -0,0 +1,125 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// findDevice returns the ID of the first GPU whose attributes satisfy match. GPUs that have
+// fallen off the bus are skipped.
+func (c *Client) findDevice(match func(*C.dcgmDeviceAttributes_t) bool) (uint, error) {
+	gpus, err := c.getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, gpu := range gpus {
+		var device C.dcgmDeviceAttributes_t
+		device.version = makeVersion3(unsafe.Sizeof(device))
+
+		result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpu), &device)
+		if result == C.DCGM_ST_GPU_IS_LOST {
+			continue
+		}
+		if err = errorString(result); err != nil {
+			return 0, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+
+		if match(&device) {
+			return gpu, nil
+		}
+	}
+
+	return 0, ErrDeviceNotFound
+}
+
+func (c *Client) deviceByUUID(uuid string) (uint, error) {
+	want := strings.TrimPrefix(strings.ToLower(uuid), "gpu-")
+	gpu, err := c.findDevice(func(device *C.dcgmDeviceAttributes_t) bool {
+		return strings.TrimPrefix(strings.ToLower(*stringPtr(&device.identifiers.uuid[0])), "gpu-") == want
+	})
+	if errors.Is(err, ErrDeviceNotFound) {
+		return 0, fmt.Errorf("%w: UUID %s", err, uuid)
+	}
+	return gpu, err
+}
+
+func (c *Client) deviceBySerial(serial string) (uint, error) {
+	// GPUs without a board serial report an empty one
+	if serial == "" {
+		return 0, errors.New("empty serial")
+	}
+
+	gpu, err := c.findDevice(func(device *C.dcgmDeviceAttributes_t) bool {
+		return *stringPtr(&device.identifiers.serial[0]) == serial
+	})
+	if errors.Is(err, ErrDeviceNotFound) {
+		return 0, fmt.Errorf("%w: serial %s", err, serial)
+	}
+	return gpu, err
+}
+
+func (c *Client) deviceByPCIBusID(busID string) (uint, error) {
+	want, ok := normalizePCIBusID(busID)
+	if !ok {
+		return 0, fmt.Errorf("invalid PCI bus ID %q", busID)
+	}
+
+	gpu, err := c.findDevice(func(device *C.dcgmDeviceAttributes_t) bool {
+		got, ok := normalizePCIBusID(*stringPtr(&device.identifiers.pciBusId[0]))
+		return ok && got == want
+	})
+	if errors.Is(err, ErrDeviceNotFound) {
+		return 0, fmt.Errorf("%w: PCI bus ID %s", err, busID)
+	}
+	return gpu, err
+}
+
+// normalizePCIBusID converts a PCI address of the form [domain:]bus:device.function to the
+// 8 digit domain, lower case form, so that "0000:3B:00.0" and "00000000:3b:00.0" compare equal
+func normalizePCIBusID(busID string) (string, bool) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(busID)), ":")
+
+	var domain, bus, devfn string
+	switch len(parts) {
+	case 2:
+		domain, bus, devfn = "0", parts[0], parts[1]
+	case 3:
+		domain, bus, devfn = parts[0], parts[1], parts[2]
+	default:
+		return "", false
+	}
+
+	dev, fn, found := strings.Cut(devfn, ".")
+	if !found {
+		return "", false
+	}
+
+	d, err := strconv.ParseUint(domain, 16, 32)
+	if err != nil {
+		return "", false
+	}
+	b, err := strconv.ParseUint(bus, 16, 8)
+	if err != nil {
+		return "", false
+	}
+	v, err := strconv.ParseUint(dev, 16, 5)
+	if err != nil {
+		return "", false
+	}
+	f, err := strconv.ParseUint(fn, 16, 3)
+	if err != nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("%08x:%02x:%02x.%x", d, b, v, f), true
+}
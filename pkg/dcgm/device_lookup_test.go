@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePCIBusID(t *testing.T) {
+	tests := []struct {
+		busID    string
+		expected string
+		valid    bool
+	}{
+		{busID: "00000000:3B:00.0", expected: "00000000:3b:00.0", valid: true},
+		{busID: "0000:3b:00.0", expected: "00000000:3b:00.0", valid: true},
+		{busID: "3b:00.0", expected: "00000000:3b:00.0", valid: true},
+		{busID: "0001:af:1f.7", expected: "00000001:af:1f.7", valid: true},
+		{busID: "0000:3b:00", valid: false},
+		{busID: "0000:3b:20.0", valid: false},
+		{busID: "0000:3b:00.8", valid: false},
+		{busID: "0000:xyz:00.0", valid: false},
+		{busID: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.busID, func(t *testing.T) {
+			got, ok := normalizePCIBusID(tt.busID)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestDeviceBySerialEmpty(t *testing.T) {
+	_, err := (&Client{}).deviceBySerial("")
+	require.ErrorContains(t, err, "empty serial")
+	assert.NotErrorIs(t, err, ErrDeviceNotFound)
+}
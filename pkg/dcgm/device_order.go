@@ -0,0 +1,168 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// DeviceMapping relates the DCGM ID of a GPU to the other ways the GPU is numbered
+type DeviceMapping struct {
+	// GPU is the DCGM GPU ID
+	GPU uint
+	// NVMLIndex is the NVML index of the GPU, or -1 if it is unknown
+	NVMLIndex int
+	// PCIOrder is the position of the GPU when all GPUs are ordered by PCI bus ID, which is the
+	// CUDA device index when CUDA_DEVICE_ORDER=PCI_BUS_ID and CUDA_VISIBLE_DEVICES is unset
+	PCIOrder int
+	UUID     string
+	// PCIBusID is the normalized PCI address, e.g. "00000000:3b:00.0"
+	PCIBusID string
+}
+
+// GetDeviceMappings returns the mapping of every GPU, ordered by PCI bus ID
+func GetDeviceMappings() ([]DeviceMapping, error) {
+	return defaultClient.GetDeviceMappings()
+}
+
+// GetDeviceMappings returns the mapping of every GPU, ordered by PCI bus ID
+func (c *Client) GetDeviceMappings() ([]DeviceMapping, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	gpus, err := c.getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make([]DeviceMapping, 0, len(gpus))
+	entities := make([]GroupEntityPair, 0, len(gpus))
+	for _, gpu := range gpus {
+		var device C.dcgmDeviceAttributes_t
+		device.version = makeVersion3(unsafe.Sizeof(device))
+
+		result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpu), &device)
+		if err = errorString(result); err != nil {
+			return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+
+		busID := *stringPtr(&device.identifiers.pciBusId[0])
+		if normalized, ok := normalizePCIBusID(busID); ok {
+			busID = normalized
+		}
+
+		mappings = append(mappings, DeviceMapping{
+			GPU:       gpu,
+			NVMLIndex: -1,
+			UUID:      *stringPtr(&device.identifiers.uuid[0]),
+			PCIBusID:  busID,
+		})
+		entities = append(entities, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu})
+	}
+
+	if len(entities) > 0 {
+		values, err := c.sampleEntityFields("deviceMappings", entities, []Short{DCGM_FI_DEV_NVML_INDEX})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range values {
+			if value.Status != C.DCGM_ST_OK || value.FieldID != DCGM_FI_DEV_NVML_INDEX {
+				continue
+			}
+			index := value.Int64()
+			if index < 0 || index >= DCGM_FT_INT64_BLANK {
+				continue
+			}
+			for i := range mappings {
+				if mappings[i].GPU == value.EntityID {
+					mappings[i].NVMLIndex = int(index)
+				}
+			}
+		}
+	}
+
+	sortByPCIBusID(mappings)
+
+	return mappings, nil
+}
+
+// sortByPCIBusID sorts mappings by PCI bus ID and numbers them accordingly. Normalized bus IDs
+// are fixed width, so they sort correctly as strings.
+func sortByPCIBusID(mappings []DeviceMapping) {
+	slices.SortFunc(mappings, func(a, b DeviceMapping) int {
+		return strings.Compare(a.PCIBusID, b.PCIBusID)
+	})
+	for i := range mappings {
+		mappings[i].PCIOrder = i
+	}
+}
+
+// CUDADeviceOrder returns the GPUs of mappings that a CUDA application sees with the given
+// CUDA_VISIBLE_DEVICES value, in CUDA device order: the GPU at index i of the result is CUDA
+// device i. An empty value makes all GPUs visible. Entries may be PCI order indexes, full GPU
+// UUIDs or unique UUID prefixes, with or without the "GPU-" prefix. As in CUDA, the list ends at
+// the first entry that does not match a GPU or repeats an earlier one.
+//
+// CUDA enumerates devices fastest first unless CUDA_DEVICE_ORDER=PCI_BUS_ID is set, and only the
+// latter ordering can be reproduced here.
+func CUDADeviceOrder(mappings []DeviceMapping, visibleDevices string) []DeviceMapping {
+	byPCI := slices.Clone(mappings)
+	sortByPCIBusID(byPCI)
+
+	if strings.TrimSpace(visibleDevices) == "" {
+		return byPCI
+	}
+
+	var (
+		visible []DeviceMapping
+		seen    = make(map[uint]bool)
+	)
+	for _, entry := range strings.Split(visibleDevices, ",") {
+		mapping, ok := findVisibleDevice(byPCI, strings.TrimSpace(entry))
+		if !ok || seen[mapping.GPU] {
+			break
+		}
+		seen[mapping.GPU] = true
+		visible = append(visible, mapping)
+	}
+
+	return visible
+}
+
+// findVisibleDevice returns the GPU referred to by a single CUDA_VISIBLE_DEVICES entry
+func findVisibleDevice(byPCI []DeviceMapping, entry string) (DeviceMapping, bool) {
+	if index, err := strconv.Atoi(entry); err == nil {
+		if index < 0 || index >= len(byPCI) {
+			return DeviceMapping{}, false
+		}
+		return byPCI[index], true
+	}
+
+	prefix := strings.TrimPrefix(strings.ToLower(entry), "gpu-")
+	if prefix == "" {
+		return DeviceMapping{}, false
+	}
+
+	var (
+		found   DeviceMapping
+		matches int
+	)
+	for _, mapping := range byPCI {
+		if strings.HasPrefix(strings.TrimPrefix(strings.ToLower(mapping.UUID), "gpu-"), prefix) {
+			found = mapping
+			matches++
+		}
+	}
+
+	return found, matches == 1
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCUDADeviceOrder(t *testing.T) {
+	mappings := []DeviceMapping{
+		{GPU: 0, NVMLIndex: 1, UUID: "GPU-bbbb1111", PCIBusID: "00000000:81:00.0"},
+		{GPU: 1, NVMLIndex: 0, UUID: "GPU-aaaa2222", PCIBusID: "00000000:3b:00.0"},
+		{GPU: 2, NVMLIndex: 2, UUID: "GPU-aaaa3333", PCIBusID: "00000000:c1:00.0"},
+	}
+
+	gpus := func(mappings []DeviceMapping) []uint {
+		ids := make([]uint, len(mappings))
+		for i, m := range mappings {
+			ids[i] = m.GPU
+		}
+		return ids
+	}
+
+	all := CUDADeviceOrder(mappings, "")
+	assert.Equal(t, []uint{1, 0, 2}, gpus(all))
+	assert.Equal(t, []int{0, 1, 2}, []int{all[0].PCIOrder, all[1].PCIOrder, all[2].PCIOrder})
+	assert.Equal(t, uint(0), mappings[0].GPU, "input must not be reordered")
+
+	assert.Equal(t, []uint{2, 1}, gpus(CUDADeviceOrder(mappings, "2,0")))
+	assert.Equal(t, []uint{0, 2}, gpus(CUDADeviceOrder(mappings, "GPU-bbbb1111, aaaa3")))
+	assert.Equal(t, []uint{2}, gpus(CUDADeviceOrder(mappings, "2,7,0")))
+	assert.Equal(t, []uint{1}, gpus(CUDADeviceOrder(mappings, "0,0,1")))
+	assert.Empty(t, CUDADeviceOrder(mappings, "GPU-aaaa"))
+	assert.Empty(t, CUDADeviceOrder(mappings, "-1"))
+}
@@ -85,7 +85,7 @@ type DeviceStatus struct {
 	FanSpeed    int64 // %
 }
 
-func latestValuesForDevice(gpuId uint) (status DeviceStatus, err error) {
+func (c *Client) latestValuesForDevice(gpuId uint) (status DeviceStatus, err error) {
 	const (
 		pwr int = iota
 		temp
@@ -127,22 +127,22 @@ func latestValuesForDevice(gpuId uint) (status DeviceStatus, err error) {
 	deviceFields[fanSpeed] = C.DCGM_FI_DEV_FAN_SPEED
 
 	fieldsName := fmt.Sprintf("devStatusFields%d", rand.Uint64())
-	fieldsId, err := FieldGroupCreate(fieldsName, deviceFields)
+	fieldsId, err := c.FieldGroupCreate(fieldsName, deviceFields)
 	if err != nil {
 		return
 	}
 
 	groupName := fmt.Sprintf("devStatus%d", rand.Uint64())
-	groupId, err := WatchFields(gpuId, fieldsId, groupName)
+	groupId, err := c.WatchFields(gpuId, fieldsId, groupName)
 	if err != nil {
-		_ = FieldGroupDestroy(fieldsId)
+		_ = c.FieldGroupDestroy(fieldsId)
 		return
 	}
 
-	values, err := GetLatestValuesForFields(gpuId, deviceFields)
+	values, err := c.GetLatestValuesForFields(gpuId, deviceFields)
 	if err != nil {
-		_ = FieldGroupDestroy(fieldsId)
-		_ = DestroyGroup(groupId)
+		_ = c.FieldGroupDestroy(fieldsId)
+		_ = c.DestroyGroup(groupId)
 		return status, err
 	}
 
@@ -188,7 +188,7 @@ func latestValuesForDevice(gpuId uint) (status DeviceStatus, err error) {
 		FanSpeed:    values[fanSpeed].Int64(),
 	}
 
-	_ = FieldGroupDestroy(fieldsId)
-	_ = DestroyGroup(groupId)
+	_ = c.FieldGroupDestroy(fieldsId)
+	_ = c.DestroyGroup(groupId)
 	return
 }
@@ -192,10 +192,27 @@ func diagLevel(diagType DiagType) C.dcgmDiagnosticLevel_t {
 //   - DiagResults containing the results of all diagnostic tests
 //   - error if the diagnostics failed to run
 func RunDiag(diagType DiagType, groupID GroupHandle) (DiagResults, error) {
+	return defaultClient.RunDiag(diagType, groupID)
+}
+
+// RunDiag runs diagnostic tests on a group of GPUs with the specified diagnostic level.
+// Parameters:
+//   - diagType: The type/level of diagnostic test to run (Quick, Medium, Long, or Extended)
+//   - groupId: The group of GPUs to run diagnostics on
+//
+// Returns:
+//   - DiagResults containing the results of all diagnostic tests
+//   - error if the diagnostics failed to run
+func (c *Client) RunDiag(diagType DiagType, groupID GroupHandle) (DiagResults, error) {
+	if err := c.beginCall(); err != nil {
+		return DiagResults{}, err
+	}
+	defer c.endCall()
+
 	var diagResults C.dcgmDiagResponse_v11
 	diagResults.version = makeVersion11(unsafe.Sizeof(diagResults))
 
-	result := C.dcgmRunDiagnostic(handle.handle, groupID.handle, diagLevel(diagType), (*C.dcgmDiagResponse_v11)(unsafe.Pointer(&diagResults)))
+	result := C.dcgmRunDiagnostic(c.dcgmHandle(), c.groupHandle(groupID), diagLevel(diagType), (*C.dcgmDiagResponse_v11)(unsafe.Pointer(&diagResults)))
 	if err := errorString(result); err != nil {
 		return DiagResults{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
@@ -0,0 +1,217 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+#include "field_values_cb.h"
+*/
+import "C"
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"runtime/cgo"
+	"slices"
+	"time"
+)
+
+// Reducer selects how the samples of an interval are reduced to one value
+type Reducer int
+
+const (
+	// ReduceAvg averages the samples. The reduced values are doubles, also for integer fields.
+	ReduceAvg Reducer = iota
+	// ReduceMin keeps the smallest sample
+	ReduceMin
+	// ReduceMax keeps the largest sample
+	ReduceMax
+	// ReduceLast keeps the latest sample
+	ReduceLast
+)
+
+func (r Reducer) String() string {
+	switch r {
+	case ReduceAvg:
+		return "avg"
+	case ReduceMin:
+		return "min"
+	case ReduceMax:
+		return "max"
+	case ReduceLast:
+		return "last"
+	}
+	return fmt.Sprintf("Unknown(%d)", int(r))
+}
+
+// DownsampleOptions reduces samples to one value per Resolution for every field of every entity
+type DownsampleOptions struct {
+	// Resolution is the length of the intervals, aligned to the Unix epoch, whose samples are
+	// reduced to one value. The timestamp of a reduced value is the start of its interval.
+	Resolution time.Duration
+	Reducer    Reducer
+}
+
+func (o DownsampleOptions) validate() error {
+	if o.Resolution < time.Microsecond {
+		return fmt.Errorf("invalid downsample resolution %s", o.Resolution)
+	}
+	if o.Reducer < ReduceAvg || o.Reducer > ReduceLast {
+		return fmt.Errorf("invalid reducer %d", o.Reducer)
+	}
+	return nil
+}
+
+// downsampleKey identifies an interval of a series
+type downsampleKey struct {
+	series streamKey
+	start  int64
+}
+
+// downsampleBucket accumulates the samples of an interval
+type downsampleBucket struct {
+	// last is the latest sample of the interval
+	last        FieldValue_v2
+	sum, lo, hi float64
+	count       int
+}
+
+// downsampler reduces samples as they are read, so that only one value per interval is held
+type downsampler struct {
+	opts    DownsampleOptions
+	buckets map[downsampleKey]*downsampleBucket
+}
+
+func newDownsampler(opts DownsampleOptions) *downsampler {
+	return &downsampler{opts: opts, buckets: make(map[downsampleKey]*downsampleBucket)}
+}
+
+// add adds samples. Samples that are not valid numbers, such as blank values or values of string
+// fields, are dropped.
+func (d *downsampler) add(values ...FieldValue_v2) {
+	resolution := d.opts.Resolution.Microseconds()
+	for _, value := range values {
+		if !value.IsValid() {
+			continue
+		}
+		v, ok := scaledValue(value, 1)
+		if !ok {
+			continue
+		}
+
+		start := value.TS - value.TS%resolution
+		if value.TS < 0 && value.TS%resolution != 0 {
+			start -= resolution
+		}
+		key := downsampleKey{series: streamKey{entity: Entity{Group: value.EntityGroupId, ID: value.EntityID}, field: value.FieldID}, start: start}
+
+		bucket, ok := d.buckets[key]
+		if !ok {
+			bucket = &downsampleBucket{last: value, lo: v, hi: v}
+			d.buckets[key] = bucket
+		}
+		if value.TS >= bucket.last.TS {
+			bucket.last = value
+		}
+		bucket.sum += v
+		bucket.lo = min(bucket.lo, v)
+		bucket.hi = max(bucket.hi, v)
+		bucket.count++
+	}
+}
+
+// values returns the reduced values, ordered by entity, field and time
+func (d *downsampler) values() []FieldValue_v2 {
+	keys := make([]downsampleKey, 0, len(d.buckets))
+	for key := range d.buckets {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b downsampleKey) int {
+		return cmp.Or(
+			cmp.Compare(a.series.entity.Group, b.series.entity.Group),
+			cmp.Compare(a.series.entity.ID, b.series.entity.ID),
+			cmp.Compare(a.series.field, b.series.field),
+			cmp.Compare(a.start, b.start),
+		)
+	})
+
+	values := make([]FieldValue_v2, len(keys))
+	for i, key := range keys {
+		bucket := d.buckets[key]
+		value := bucket.last
+		value.TS = key.start
+
+		switch d.opts.Reducer {
+		case ReduceAvg:
+			value.FieldType = DCGM_FT_DOUBLE
+			binary.NativeEndian.PutUint64(value.Value[:], math.Float64bits(bucket.sum/float64(bucket.count)))
+		case ReduceMin:
+			setNumber(&value, bucket.lo)
+		case ReduceMax:
+			setNumber(&value, bucket.hi)
+		}
+		values[i] = value
+	}
+	return values
+}
+
+// setNumber stores v in the value, as an integer for integer fields
+func setNumber(value *FieldValue_v2, v float64) {
+	if value.FieldType == DCGM_FT_DOUBLE {
+		binary.NativeEndian.PutUint64(value.Value[:], math.Float64bits(v))
+	} else {
+		binary.NativeEndian.PutUint64(value.Value[:], uint64(int64(v)))
+	}
+}
+
+// Downsample reduces values to one value per opts.Resolution for every field of every entity.
+// Values that are not valid numbers, such as blank values or values of string fields, are dropped.
+// The reduced values are ordered by entity, field and time.
+func Downsample(values []FieldValue_v2, opts DownsampleOptions) ([]FieldValue_v2, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	d := newDownsampler(opts)
+	d.add(values...)
+	return d.values(), nil
+}
+
+// GetValuesSinceDownsampled is like GetValuesSince, but reduces the values to one value per
+// opts.Resolution for every field of every entity, like Downsample. The values are reduced while
+// they are read, so only the reduced values are held in memory.
+func GetValuesSinceDownsampled(gpuGroup GroupHandle, fieldGroup FieldHandle, sinceTime time.Time, opts DownsampleOptions) ([]FieldValue_v2, time.Time, error) {
+	return defaultClient.GetValuesSinceDownsampled(gpuGroup, fieldGroup, sinceTime, opts)
+}
+
+// GetValuesSinceDownsampled is like GetValuesSince, but reduces the values to one value per
+// opts.Resolution for every field of every entity, like Downsample. The values are reduced while
+// they are read, so only the reduced values are held in memory.
+func (c *Client) GetValuesSinceDownsampled(gpuGroup GroupHandle, fieldGroup FieldHandle, sinceTime time.Time, opts DownsampleOptions) ([]FieldValue_v2, time.Time, error) {
+	if err := opts.validate(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if err := c.beginCall(); err != nil {
+		return nil, time.Time{}, err
+	}
+	defer c.endCall()
+
+	var nextSinceTimestamp C.longlong
+	cbResult := &callback{reduce: newDownsampler(opts)}
+	handle := cgo.NewHandle(cbResult)
+	defer handle.Delete()
+	result := C.dcgmGetValuesSince_v2(c.dcgmHandle(),
+		c.groupHandle(gpuGroup),
+		c.fieldGroupHandle(fieldGroup),
+		C.longlong(sinceTime.UnixMicro()),
+		&nextSinceTimestamp,
+		C.dcgmFieldValueEnumeration_f(C.fieldValueEntityCallback),
+		C.callbackUserData(C.uintptr_t(handle)))
+	if err := errorString(result); err != nil {
+		return nil, time.Time{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return cbResult.reduce.values(), timestampUSECToTime(int64(nextSinceTimestamp)), nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownsample(t *testing.T) {
+	values := []FieldValue_v2{
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 1}, DCGM_FI_DEV_GPU_TEMP, 60, 10_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, 40, 11_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, 50, 12_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, 45, 10_500_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_BLANK, 13_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, 70, 21_000_000),
+	}
+
+	_, err := Downsample(values, DownsampleOptions{})
+	require.Error(t, err)
+	_, err = Downsample(values, DownsampleOptions{Resolution: time.Second, Reducer: 7})
+	require.Error(t, err)
+
+	avg, err := Downsample(values, DownsampleOptions{Resolution: 10 * time.Second})
+	require.NoError(t, err)
+	require.Len(t, avg, 3)
+	assert.Equal(t, uint(0), avg[0].EntityID)
+	assert.Equal(t, int64(10_000_000), avg[0].TS)
+	assert.Equal(t, uint(DCGM_FT_DOUBLE), avg[0].FieldType)
+	assert.InDelta(t, 45, avg[0].Float64(), 1e-9)
+	assert.Equal(t, int64(20_000_000), avg[1].TS)
+	assert.InDelta(t, 70, avg[1].Float64(), 0)
+	assert.Equal(t, uint(1), avg[2].EntityID)
+
+	maxValues, err := Downsample(values, DownsampleOptions{Resolution: 10 * time.Second, Reducer: ReduceMax})
+	require.NoError(t, err)
+	assert.Equal(t, uint(DCGM_FT_INT64), maxValues[0].FieldType)
+	assert.Equal(t, int64(50), maxValues[0].Int64())
+
+	last, err := Downsample(values, DownsampleOptions{Resolution: 10 * time.Second, Reducer: ReduceLast})
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), last[0].Int64())
+
+	minValues, err := Downsample(values, DownsampleOptions{Resolution: 10 * time.Second, Reducer: ReduceMin})
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), minValues[0].Int64())
+}
+
+func TestGetValuesSinceDownsampled(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+	runOnlyWithLiveGPUs(t)
+
+	const gpu uint = 0
+
+	fieldsGroup, err := FieldGroupCreate("downsampledValuesTest", []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsGroup) }()
+
+	require.NoError(t, WatchFieldsWithOptions(fieldsGroup, GroupAllGPUs(), WatchOptions{
+		UpdateFreq:     time.Second,
+		MaxKeepAge:     10 * time.Minute,
+		MaxKeepSamples: 100,
+	}))
+	defer func() { _ = UnwatchFields(GroupAllGPUs(), fieldsGroup) }()
+
+	// samples of one interval, which is reduced to the largest of them
+	start := time.Now().Truncate(time.Minute).Add(-time.Minute)
+	for i, temp := range []int64{40, 70, 50} {
+		ts := start.Add(time.Duration(i) * time.Second).UnixMicro()
+		require.NoError(t, InjectFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64, 0, ts, temp))
+	}
+	require.NoError(t, UpdateAllFields())
+
+	values, next, err := GetValuesSinceDownsampled(GroupAllGPUs(), fieldsGroup, start.Add(-time.Second),
+		DownsampleOptions{Resolution: time.Minute, Reducer: ReduceMax})
+	require.NoError(t, err)
+	assert.False(t, next.IsZero())
+
+	var reduced []FieldValue_v2
+	for _, value := range values {
+		if value.EntityID == gpu && value.Time().Equal(start) {
+			reduced = append(reduced, value)
+		}
+	}
+	require.Len(t, reduced, 1)
+	assert.Equal(t, int64(70), reduced[0].Int64())
+}
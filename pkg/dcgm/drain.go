@@ -0,0 +1,133 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DrainedGPU records why and since when a GPU is drained
+type DrainedGPU struct {
+	GPU    uint
+	Reason string
+	Since  time.Time
+}
+
+// DrainTracker keeps track of the GPUs that remediation automation has taken out of service.
+// The bookkeeping is local to the process: DCGM has no notion of drained GPUs, and draining a
+// GPU does not stop anything from using it.
+type DrainTracker struct {
+	client  *Client
+	mu      sync.Mutex
+	drained map[uint]DrainedGPU
+}
+
+// NewDrainTracker returns an empty DrainTracker that checks GPU activity on the default client
+func NewDrainTracker() *DrainTracker {
+	return defaultClient.NewDrainTracker()
+}
+
+// NewDrainTracker returns an empty DrainTracker that checks GPU activity on this client
+func (c *Client) NewDrainTracker() *DrainTracker {
+	return &DrainTracker{client: c, drained: make(map[uint]DrainedGPU)}
+}
+
+// Drain marks the GPU as drained. As a safety check the GPU must be idle: DCGM cannot list the
+// processes running on a GPU, so a GPU that reports any compute, memory copy, encoder or decoder
+// utilization is considered in use and ErrGpuInUse is returned. Draining a drained GPU keeps the
+// original reason and time.
+func (t *DrainTracker) Drain(gpuID uint, reason string) error {
+	if t.IsDrained(gpuID) {
+		return nil
+	}
+
+	inUse, err := t.client.GpuInUse(gpuID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return fmt.Errorf("%w: GPU %d", ErrGpuInUse, gpuID)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.drained[gpuID]; !ok {
+		t.drained[gpuID] = DrainedGPU{GPU: gpuID, Reason: reason, Since: time.Now()}
+	}
+	return nil
+}
+
+// Undrain returns the GPU to service
+func (t *DrainTracker) Undrain(gpuID uint) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.drained, gpuID)
+}
+
+// IsDrained reports whether the GPU is drained
+func (t *DrainTracker) IsDrained(gpuID uint) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, ok := t.drained[gpuID]
+	return ok
+}
+
+// Drained returns the drained GPUs, in GPU ID order
+func (t *DrainTracker) Drained() []DrainedGPU {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	drained := make([]DrainedGPU, 0, len(t.drained))
+	for _, gpu := range t.drained {
+		drained = append(drained, gpu)
+	}
+	slices.SortFunc(drained, func(a, b DrainedGPU) int { return int(a.GPU) - int(b.GPU) })
+	return drained
+}
+
+// GpuInUse reports whether the GPU currently shows any compute, memory copy, encoder or decoder
+// utilization
+func GpuInUse(gpuID uint) (bool, error) {
+	return defaultClient.GpuInUse(gpuID)
+}
+
+// GpuInUse reports whether the GPU currently shows any compute, memory copy, encoder or decoder
+// utilization
+func (c *Client) GpuInUse(gpuID uint) (bool, error) {
+	if err := c.beginCall(); err != nil {
+		return false, err
+	}
+	defer c.endCall()
+
+	fields := []Short{DCGM_FI_DEV_GPU_UTIL, DCGM_FI_DEV_MEM_COPY_UTIL, DCGM_FI_DEV_ENC_UTIL, DCGM_FI_DEV_DEC_UTIL}
+
+	values, err := c.sampleEntityFields("gpuInUse", []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}, fields)
+	if err != nil {
+		return false, fmt.Errorf("error getting GPU utilization: %s", err)
+	}
+
+	return utilizationInUse(values), nil
+}
+
+// utilizationInUse reports whether any of the utilization values is above zero
+func utilizationInUse(values []FieldValue_v2) bool {
+	for _, value := range values {
+		if value.Status != C.DCGM_ST_OK {
+			continue
+		}
+		if util := value.Int64(); util > 0 && !IsInt64Blank(util) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainTracker(t *testing.T) {
+	tracker := (&Client{closing: true}).NewDrainTracker()
+	require.ErrorIs(t, tracker.Drain(0, "xid 48"), ErrClientClosed)
+	assert.False(t, tracker.IsDrained(0))
+
+	tracker.drained[3] = DrainedGPU{GPU: 3, Reason: "xid 79"}
+	tracker.drained[1] = DrainedGPU{GPU: 1, Reason: "xid 48"}
+
+	// already drained GPUs are not checked again
+	require.NoError(t, tracker.Drain(1, "other"))
+	assert.Equal(t, []DrainedGPU{{GPU: 1, Reason: "xid 48"}, {GPU: 3, Reason: "xid 79"}}, tracker.Drained())
+
+	tracker.Undrain(1)
+	assert.False(t, tracker.IsDrained(1))
+	assert.True(t, tracker.IsDrained(3))
+}
+
+func TestUtilizationInUse(t *testing.T) {
+	util := func(v int64, status int) FieldValue_v2 {
+		fv := fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_UTIL, v, 0)
+		fv.Status = status
+		return fv
+	}
+
+	assert.False(t, utilizationInUse(nil))
+	assert.False(t, utilizationInUse([]FieldValue_v2{util(0, DCGM_ST_OK), util(DCGM_FT_INT64_BLANK, DCGM_ST_OK)}))
+	assert.False(t, utilizationInUse([]FieldValue_v2{util(50, DCGM_ST_NOT_SUPPORTED)}))
+	assert.True(t, utilizationInUse([]FieldValue_v2{util(0, DCGM_ST_OK), util(3, DCGM_ST_OK)}))
+}
@@ -0,0 +1,122 @@
+package dcgm
+
+import (
+	"fmt"
+)
+
+// ECCLocationCounts contains ECC error counts broken down by memory location. Locations the GPU
+// does not report have zero counts.
+type ECCLocationCounts struct {
+	Total        ECCErrorsInfo
+	L1           ECCErrorsInfo
+	L2           ECCErrorsInfo
+	Device       ECCErrorsInfo
+	RegisterFile ECCErrorsInfo
+	Texture      ECCErrorsInfo
+	Shared       ECCErrorsInfo
+	CBU          ECCErrorsInfo
+	SRAM         ECCErrorsInfo
+}
+
+// ECCErrorCounts contains the ECC mode and error counts of a GPU
+type ECCErrorCounts struct {
+	GPU     uint
+	Enabled bool
+	// Pending is the ECC mode that takes effect after the next reboot
+	Pending bool
+	// Volatile counts the errors since the last driver load
+	Volatile ECCLocationCounts
+	// Aggregate counts the errors over the lifetime of the GPU
+	Aggregate ECCLocationCounts
+}
+
+// eccCounterFields maps the ECC counter fields to the count they hold
+var eccCounterFields = map[Short]func(*ECCErrorCounts) *int64{
+	DCGM_FI_DEV_ECC_SBE_VOL_TOTAL: func(c *ECCErrorCounts) *int64 { return &c.Volatile.Total.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_TOTAL: func(c *ECCErrorCounts) *int64 { return &c.Volatile.Total.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_L1:    func(c *ECCErrorCounts) *int64 { return &c.Volatile.L1.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_L1:    func(c *ECCErrorCounts) *int64 { return &c.Volatile.L1.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_L2:    func(c *ECCErrorCounts) *int64 { return &c.Volatile.L2.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_L2:    func(c *ECCErrorCounts) *int64 { return &c.Volatile.L2.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_DEV:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.Device.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_DEV:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.Device.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_REG:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.RegisterFile.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_REG:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.RegisterFile.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_TEX:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.Texture.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_TEX:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.Texture.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_SHM:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.Shared.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_SHM:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.Shared.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_CBU:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.CBU.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_CBU:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.CBU.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_VOL_SRM:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.SRAM.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_VOL_SRM:   func(c *ECCErrorCounts) *int64 { return &c.Volatile.SRAM.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_TOTAL: func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Total.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_TOTAL: func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Total.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_L1:    func(c *ECCErrorCounts) *int64 { return &c.Aggregate.L1.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_L1:    func(c *ECCErrorCounts) *int64 { return &c.Aggregate.L1.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_L2:    func(c *ECCErrorCounts) *int64 { return &c.Aggregate.L2.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_L2:    func(c *ECCErrorCounts) *int64 { return &c.Aggregate.L2.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_DEV:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Device.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_DEV:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Device.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_REG:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.RegisterFile.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_REG:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.RegisterFile.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_TEX:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Texture.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_TEX:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Texture.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_SHM:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Shared.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_SHM:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.Shared.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_CBU:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.CBU.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_CBU:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.CBU.DoubleBit },
+	DCGM_FI_DEV_ECC_SBE_AGG_SRM:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.SRAM.SingleBit },
+	DCGM_FI_DEV_ECC_DBE_AGG_SRM:   func(c *ECCErrorCounts) *int64 { return &c.Aggregate.SRAM.DoubleBit },
+}
+
+// GetECCErrorCounts returns the ECC mode and the volatile and aggregate ECC error counts of the
+// specified GPU, broken down by memory location
+func GetECCErrorCounts(gpuID uint) (ECCErrorCounts, error) {
+	return defaultClient.GetECCErrorCounts(gpuID)
+}
+
+// GetECCErrorCounts returns the ECC mode and the volatile and aggregate ECC error counts of the
+// specified GPU, broken down by memory location
+func (c *Client) GetECCErrorCounts(gpuID uint) (ECCErrorCounts, error) {
+	if err := c.beginCall(); err != nil {
+		return ECCErrorCounts{}, err
+	}
+	defer c.endCall()
+
+	fields := make([]Short, 0, len(eccCounterFields)+2)
+	fields = append(fields, DCGM_FI_DEV_ECC_CURRENT, DCGM_FI_DEV_ECC_PENDING)
+	for field := range eccCounterFields {
+		fields = append(fields, field)
+	}
+
+	entities := []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}
+	values, err := c.sampleEntityFields("eccErrors", entities, fields)
+	if err != nil {
+		return ECCErrorCounts{}, fmt.Errorf("error getting ECC error counts: %s", err)
+	}
+
+	return toECCErrorCounts(gpuID, values), nil
+}
+
+func toECCErrorCounts(gpuID uint, values []FieldValue_v2) ECCErrorCounts {
+	counts := ECCErrorCounts{GPU: gpuID}
+	for _, value := range values {
+		v, ok := value.Typed().AsInt64()
+		if !ok {
+			continue
+		}
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_ECC_CURRENT:
+			counts.Enabled = v != 0
+		case DCGM_FI_DEV_ECC_PENDING:
+			counts.Pending = v != 0
+		default:
+			if count, ok := eccCounterFields[value.FieldID]; ok {
+				*count(&counts) = v
+			}
+		}
+	}
+	return counts
+}
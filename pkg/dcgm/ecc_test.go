@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToECCErrorCounts(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+
+	notSupported := fakeFieldValue(gpu, DCGM_FI_DEV_ECC_SBE_VOL_TEX, 3, 0)
+	notSupported.Status = DCGM_ST_NOT_SUPPORTED
+
+	counts := toECCErrorCounts(1, []FieldValue_v2{
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_CURRENT, 1, 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_PENDING, 0, 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_SBE_VOL_TOTAL, 7, 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_SBE_VOL_L2, 5, 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_SBE_VOL_DEV, 2, 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_DBE_AGG_REG, 1, 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ECC_DBE_AGG_L1, DCGM_FT_INT64_NOT_SUPPORTED, 0),
+		notSupported,
+	})
+
+	assert.Equal(t, ECCErrorCounts{
+		GPU:     1,
+		Enabled: true,
+		Volatile: ECCLocationCounts{
+			Total:  ECCErrorsInfo{SingleBit: 7},
+			L2:     ECCErrorsInfo{SingleBit: 5},
+			Device: ECCErrorsInfo{SingleBit: 2},
+		},
+		Aggregate: ECCLocationCounts{
+			RegisterFile: ECCErrorsInfo{DoubleBit: 1},
+		},
+	}, counts)
+}
@@ -0,0 +1,153 @@
+package dcgm
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Entity identifies any DCGM entity: a GPU, vGPU, NvSwitch, NvLink, GPU or compute instance,
+// CPU or CPU core
+type Entity struct {
+	Group Field_Entity_Group
+	ID    uint
+}
+
+func (e Entity) String() string {
+	return fmt.Sprintf("%s %d", e.Group, e.ID)
+}
+
+func (e Entity) pair() GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: e.Group, EntityId: e.ID}
+}
+
+// Entity returns the pair as an Entity
+func (p GroupEntityPair) Entity() Entity {
+	return Entity{Group: p.EntityGroupId, ID: p.EntityId}
+}
+
+func entityPairs(entities []Entity) []GroupEntityPair {
+	pairs := make([]GroupEntityPair, len(entities))
+	for i, entity := range entities {
+		pairs[i] = entity.pair()
+	}
+	return pairs
+}
+
+// AddEntitiesToGroup adds entities of any type to an existing group
+func AddEntitiesToGroup(groupID GroupHandle, entities ...Entity) error {
+	return defaultClient.AddEntitiesToGroup(groupID, entities...)
+}
+
+// AddEntitiesToGroup adds entities of any type to an existing group
+func (c *Client) AddEntitiesToGroup(groupID GroupHandle, entities ...Entity) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	for _, entity := range entities {
+		if err := c.AddEntityToGroup(groupID, entity.Group, entity.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchEntityFields starts monitoring the specified fields for entities of any type, in a new
+// group with the specified name. Returns the handle of the new group.
+func WatchEntityFields(entities []Entity, fieldsGroup FieldHandle, groupName string) (GroupHandle, error) {
+	return defaultClient.WatchEntityFields(entities, fieldsGroup, groupName)
+}
+
+// WatchEntityFields starts monitoring the specified fields for entities of any type, in a new
+// group with the specified name. Returns the handle of the new group.
+func (c *Client) WatchEntityFields(entities []Entity, fieldsGroup FieldHandle, groupName string) (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
+
+	group, err := c.CreateGroup(groupName)
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	if err = c.AddEntitiesToGroup(group, entities...); err != nil {
+		_ = c.DestroyGroup(group)
+		return GroupHandle{}, err
+	}
+
+	if err = c.WatchFieldsWithGroup(fieldsGroup, group); err != nil {
+		_ = c.DestroyGroup(group)
+		return GroupHandle{}, err
+	}
+
+	_ = c.UpdateAllFields()
+	return group, nil
+}
+
+// GetEntitiesLatestValues returns the latest values of fields for entities of any type
+func GetEntitiesLatestValues(entities []Entity, fields []Short) ([]FieldValue_v2, error) {
+	return defaultClient.GetEntitiesLatestValues(entities, fields)
+}
+
+// GetEntitiesLatestValues returns the latest values of fields for entities of any type
+func (c *Client) GetEntitiesLatestValues(entities []Entity, fields []Short) ([]FieldValue_v2, error) {
+	return c.EntitiesGetLatestValues(entityPairs(entities), fields, 0)
+}
+
+// HealthCheckEntities enables all health watches on entities of any type and returns the
+// result of a health check on them. Like HealthCheck, incidents are only reported for
+// errors that occur while the watches are enabled.
+func HealthCheckEntities(entities ...Entity) (HealthResponse, error) {
+	return defaultClient.HealthCheckEntities(entities...)
+}
+
+// HealthCheckEntities enables all health watches on entities of any type and returns the
+// result of a health check on them. Like HealthCheck, incidents are only reported for
+// errors that occur while the watches are enabled.
+func (c *Client) HealthCheckEntities(entities ...Entity) (HealthResponse, error) {
+	if err := c.beginCall(); err != nil {
+		return HealthResponse{}, err
+	}
+	defer c.endCall()
+
+	return c.healthCheckEntities(DCGM_HEALTH_WATCH_ALL, entities...)
+}
+
+// HealthCheckEntitiesWithSystems is like HealthCheckEntities but only enables the watches of the
+// systems, e.g. DCGM_HEALTH_WATCH_PCIE|DCGM_HEALTH_WATCH_NVLINK, so that incidents of the other
+// systems are not reported.
+func HealthCheckEntitiesWithSystems(systems HealthSystem, entities ...Entity) (HealthResponse, error) {
+	return defaultClient.HealthCheckEntitiesWithSystems(systems, entities...)
+}
+
+// HealthCheckEntitiesWithSystems is like HealthCheckEntities but only enables the watches of the
+// systems, e.g. DCGM_HEALTH_WATCH_PCIE|DCGM_HEALTH_WATCH_NVLINK, so that incidents of the other
+// systems are not reported.
+func (c *Client) HealthCheckEntitiesWithSystems(systems HealthSystem, entities ...Entity) (HealthResponse, error) {
+	if err := c.beginCall(); err != nil {
+		return HealthResponse{}, err
+	}
+	defer c.endCall()
+
+	return c.healthCheckEntities(systems, entities...)
+}
+
+func (c *Client) healthCheckEntities(systems HealthSystem, entities ...Entity) (HealthResponse, error) {
+	groupID, err := c.CreateGroup(fmt.Sprintf("health%d", rand.Uint64()))
+	if err != nil {
+		return HealthResponse{}, err
+	}
+	defer func() { _ = c.DestroyGroup(groupID) }()
+
+	if err = c.AddEntitiesToGroup(groupID, entities...); err != nil {
+		return HealthResponse{}, err
+	}
+
+	if err = c.HealthSet(groupID, systems); err != nil {
+		return HealthResponse{}, err
+	}
+
+	return c.HealthCheck(groupID)
+}
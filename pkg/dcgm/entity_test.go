@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntity(t *testing.T) {
+	entity := Entity{Group: FE_SWITCH, ID: 2}
+	assert.Equal(t, "NvSwitch 2", entity.String())
+	assert.Equal(t, entity, entity.pair().Entity())
+
+	assert.Equal(t, []GroupEntityPair{
+		{EntityGroupId: FE_GPU, EntityId: 0},
+		{EntityGroupId: FE_CPU_CORE, EntityId: 5},
+	}, entityPairs([]Entity{{Group: FE_GPU, ID: 0}, {Group: FE_CPU_CORE, ID: 5}}))
+}
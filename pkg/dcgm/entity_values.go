@@ -0,0 +1,130 @@
+package dcgm
+
+import (
+	"reflect"
+	"time"
+)
+
+// TypedValue is a field value decoded according to its field type
+type TypedValue struct {
+	FieldType uint
+	Status    int
+	Timestamp time.Time
+	// State tells whether the value held data and, if not, why
+	State ValueState
+	// Value is an int64 for integer and timestamp fields, a float64 for double fields and a string
+	// for string fields. Binary fields with a known layout are decoded into their Go type, e.g. a
+	// PidAccountingStats for DCGM_FI_DEV_ACCOUNTING_DATA or a []ClockSet for
+	// DCGM_FI_DEV_SUPPORTED_CLOCKS. It is nil if Status is not DCGM_ST_OK, if the value is blank,
+	// not found, not supported or not permissioned, and for other binary fields.
+	Value any
+}
+
+// AsInt64 returns the value of an integer or timestamp field
+func (v TypedValue) AsInt64() (int64, bool) {
+	i, ok := v.Value.(int64)
+	return i, ok
+}
+
+// AsFloat64 returns the value of a double field
+func (v TypedValue) AsFloat64() (float64, bool) {
+	f, ok := v.Value.(float64)
+	return f, ok
+}
+
+// AsString returns the value of a string field
+func (v TypedValue) AsString() (string, bool) {
+	s, ok := v.Value.(string)
+	return s, ok
+}
+
+// AsStruct stores the decoded value of a binary field in the variable target points to, e.g. a
+// *PidAccountingStats, and reports whether the value has that type
+func (v TypedValue) AsStruct(target any) bool {
+	ptr := reflect.ValueOf(target)
+	if v.Value == nil || ptr.Kind() != reflect.Pointer || ptr.IsNil() {
+		return false
+	}
+
+	value := reflect.ValueOf(v.Value)
+	if !value.Type().AssignableTo(ptr.Elem().Type()) {
+		return false
+	}
+	ptr.Elem().Set(value)
+	return true
+}
+
+// Typed returns the value decoded according to its field type
+func (fv FieldValue_v2) Typed() TypedValue {
+	return toTypedValue(fv)
+}
+
+// EntityValues holds field values keyed by entity and field ID
+type EntityValues map[Entity]map[Short]TypedValue
+
+// Get returns the value of field for entity
+func (ev EntityValues) Get(entity Entity, field Short) (TypedValue, bool) {
+	v, ok := ev[entity][field]
+	return v, ok
+}
+
+// GetEntityValues returns the latest values of fields for entities of any type, keyed by entity
+// and field. The fields must be watched unless flags contains DCGM_FV_FLAG_LIVE_DATA, which reads
+// the values from the driver instead of the cache, at a much higher cost.
+func GetEntityValues(entities []Entity, fields []Short, flags uint) (EntityValues, error) {
+	return defaultClient.GetEntityValues(entities, fields, flags)
+}
+
+// GetEntityValues returns the latest values of fields for entities of any type, keyed by entity
+// and field. The fields must be watched unless flags contains DCGM_FV_FLAG_LIVE_DATA, which reads
+// the values from the driver instead of the cache, at a much higher cost.
+func (c *Client) GetEntityValues(entities []Entity, fields []Short, flags uint) (EntityValues, error) {
+	values, err := c.EntitiesGetLatestValues(entityPairs(entities), fields, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityValues(values), nil
+}
+
+func toEntityValues(values []FieldValue_v2) EntityValues {
+	ev := make(EntityValues)
+	for _, value := range values {
+		entity := Entity{Group: value.EntityGroupId, ID: value.EntityID}
+		if ev[entity] == nil {
+			ev[entity] = make(map[Short]TypedValue)
+		}
+		ev[entity][value.FieldID] = toTypedValue(value)
+	}
+	return ev
+}
+
+func toTypedValue(fv FieldValue_v2) TypedValue {
+	v := TypedValue{
+		FieldType: fv.FieldType,
+		Status:    fv.Status,
+		Timestamp: timestampUSECToTime(fv.TS),
+		State:     fv.State(),
+	}
+	if v.State != ValueOK {
+		return v
+	}
+
+	switch fv.FieldType {
+	case DCGM_FT_INT64, DCGM_FT_TIMESTAMP:
+		if i := fv.Int64(); i < DCGM_FT_INT64_BLANK {
+			v.Value = i
+		}
+	case DCGM_FT_DOUBLE:
+		if f := fv.Float64(); f < DCGM_FT_FP64_BLANK {
+			v.Value = f
+		}
+	case DCGM_FT_STRING:
+		v.Value = fv.String()
+	case DCGM_FT_BINARY:
+		if decode, ok := blobDecoders[fv.FieldID]; ok {
+			v.Value = decode(fv.Value)
+		}
+	}
+	return v
+}
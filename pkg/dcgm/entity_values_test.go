@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToEntityValues(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 1}
+	link := Entity{Group: FE_LINK, ID: 3}
+
+	notSupported := fakeFieldValue(gpu, DCGM_FI_DEV_MEM_COPY_UTIL, 10, 1_000_000)
+	notSupported.Status = DCGM_ST_NOT_SUPPORTED
+
+	ev := toEntityValues([]FieldValue_v2{
+		fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 42, 1_000_000),
+		fakeFloat64FieldValue(gpu, DCGM_FI_DEV_POWER_USAGE, 123.5, 0),
+		fakeStringFieldValue(gpu, DCGM_FI_DEV_NAME, "NVIDIA H100", 0),
+		fakeFieldValue(gpu, DCGM_FI_DEV_GPU_UTIL, DCGM_FT_INT64_NOT_SUPPORTED, 1_000_000),
+		fakeStringFieldValue(gpu, DCGM_FI_DEV_SERIAL, DCGM_FT_STR_BLANK, 0),
+		notSupported,
+		fakeFieldValue(link, DCGM_FI_DEV_GPU_TEMP, 7, 1_000_000),
+	})
+	require.Len(t, ev, 2)
+
+	temp, ok := ev.Get(gpu, DCGM_FI_DEV_GPU_TEMP)
+	require.True(t, ok)
+	i, ok := temp.AsInt64()
+	require.True(t, ok)
+	assert.Equal(t, int64(42), i)
+	assert.Equal(t, int64(1_000_000), temp.Timestamp.UnixMicro())
+
+	power, _ := ev.Get(gpu, DCGM_FI_DEV_POWER_USAGE)
+	f, ok := power.AsFloat64()
+	require.True(t, ok)
+	assert.InDelta(t, 123.5, f, 0)
+
+	name, _ := ev.Get(gpu, DCGM_FI_DEV_NAME)
+	s, ok := name.AsString()
+	require.True(t, ok)
+	assert.Equal(t, "NVIDIA H100", s)
+
+	for _, field := range []Short{DCGM_FI_DEV_GPU_UTIL, DCGM_FI_DEV_SERIAL, DCGM_FI_DEV_MEM_COPY_UTIL} {
+		v, ok := ev.Get(gpu, field)
+		require.True(t, ok)
+		assert.Nil(t, v.Value)
+	}
+
+	linkTemp, _ := ev.Get(link, DCGM_FI_DEV_GPU_TEMP)
+	assert.Equal(t, int64(7), linkTemp.Value)
+
+	_, ok = ev.Get(Entity{Group: FE_SWITCH}, DCGM_FI_DEV_GPU_TEMP)
+	assert.False(t, ok)
+}
+
+func TestTypedBlobValues(t *testing.T) {
+	accounting := FieldValue_v2{FieldID: DCGM_FI_DEV_ACCOUNTING_DATA, FieldType: DCGM_FT_BINARY}
+	binary.LittleEndian.PutUint32(accounting.Value[4:], 1234)
+	binary.LittleEndian.PutUint32(accounting.Value[8:], 75)
+	binary.LittleEndian.PutUint32(accounting.Value[12:], 20)
+	binary.LittleEndian.PutUint64(accounting.Value[16:], 1<<30)
+	binary.LittleEndian.PutUint64(accounting.Value[24:], 5_000_000)
+	binary.LittleEndian.PutUint64(accounting.Value[32:], 2_500_000)
+
+	var stats PidAccountingStats
+	require.True(t, accounting.Typed().AsStruct(&stats))
+	assert.Equal(t, PidAccountingStats{
+		PID:               1234,
+		GPUUtilization:    75,
+		MemoryUtilization: 20,
+		MaxMemoryUsage:    1 << 30,
+		StartTime:         timestampUSECToTime(5_000_000),
+		ActiveTime:        2500 * time.Millisecond,
+	}, stats)
+
+	var clockSets []ClockSet
+	assert.False(t, accounting.Typed().AsStruct(&clockSets))
+	assert.False(t, accounting.Typed().AsStruct(stats))
+
+	clocks := FieldValue_v2{FieldID: DCGM_FI_DEV_SUPPORTED_CLOCKS, FieldType: DCGM_FT_BINARY}
+	binary.LittleEndian.PutUint32(clocks.Value[4:], 2)
+	binary.LittleEndian.PutUint32(clocks.Value[12:], 1215)
+	binary.LittleEndian.PutUint32(clocks.Value[16:], 1410)
+	binary.LittleEndian.PutUint32(clocks.Value[24:], 1215)
+	binary.LittleEndian.PutUint32(clocks.Value[28:], 210)
+
+	require.True(t, clocks.Typed().AsStruct(&clockSets))
+	assert.Equal(t, []ClockSet{{MemClock: 1215, SMClock: 1410}, {MemClock: 1215, SMClock: 210}}, clockSets)
+
+	unknown := FieldValue_v2{FieldID: DCGM_FI_DEV_VGPU_UTILIZATIONS, FieldType: DCGM_FT_BINARY}
+	assert.Nil(t, unknown.Typed().Value)
+}
@@ -0,0 +1,79 @@
+package dcgm
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// Environment variables read by InitFromEnv
+const (
+	// HostEnv is the nv-hostengine host name or IP address to connect to
+	HostEnv = "DCGM_HOST"
+	// PortEnv is the nv-hostengine TCP port to connect to
+	PortEnv = "DCGM_PORT"
+	// SocketEnv is the path of the nv-hostengine Unix domain socket to connect to
+	SocketEnv = "DCGM_SOCKET"
+	// ModeEnv selects the running mode: "embedded", "standalone" or "start-hostengine"
+	ModeEnv = "DCGM_MODE"
+)
+
+// InitFromEnv starts DCGM as described by the DCGM_MODE, DCGM_HOST, DCGM_PORT and DCGM_SOCKET
+// environment variables. If DCGM_MODE is unset, Standalone mode is used when DCGM_HOST,
+// DCGM_PORT or DCGM_SOCKET is set and Embedded mode otherwise. In Standalone mode DCGM_SOCKET
+// takes precedence over DCGM_HOST and DCGM_PORT, which default to localhost and 5555.
+// Returns a cleanup function and any error encountered, like Init.
+func InitFromEnv() (cleanup func(), err error) {
+	m, args, err := initArgsFromEnv(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+	return Init(m, args...)
+}
+
+// NewClientFromEnv is like InitFromEnv but returns a new Client instead of initializing the
+// package-level API. The returned client must be released with Close.
+func NewClientFromEnv() (*Client, error) {
+	m, args, err := initArgsFromEnv(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(m, args...)
+}
+
+// initArgsFromEnv converts the environment variables into Init arguments
+func initArgsFromEnv(getenv func(string) string) (mode, []string, error) {
+	host, port, socket := getenv(HostEnv), getenv(PortEnv), getenv(SocketEnv)
+
+	m := Embedded
+	switch strings.ToLower(getenv(ModeEnv)) {
+	case "":
+		if host != "" || port != "" || socket != "" {
+			m = Standalone
+		}
+	case "embedded":
+	case "standalone":
+		m = Standalone
+	case "start-hostengine", "starthostengine":
+		m = StartHostengine
+	default:
+		return 0, nil, fmt.Errorf("invalid %s %q, expected \"embedded\", \"standalone\" or \"start-hostengine\"", ModeEnv, getenv(ModeEnv))
+	}
+
+	if m != Standalone {
+		return m, nil, nil
+	}
+
+	if socket != "" {
+		return m, []string{socket, "1"}, nil
+	}
+
+	if host == "" {
+		host = "localhost"
+	}
+	if port == "" {
+		return m, []string{host, "0"}, nil
+	}
+	return m, []string{net.JoinHostPort(host, port), "0"}, nil
+}
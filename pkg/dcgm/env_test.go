@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitArgsFromEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		expectedMode mode
+		expectedArgs []string
+	}{
+		{
+			name:         "empty",
+			env:          map[string]string{},
+			expectedMode: Embedded,
+		},
+		{
+			name:         "host and port",
+			env:          map[string]string{HostEnv: "10.0.0.1", PortEnv: "5556"},
+			expectedMode: Standalone,
+			expectedArgs: []string{"10.0.0.1:5556", "0"},
+		},
+		{
+			name:         "ipv6 host",
+			env:          map[string]string{HostEnv: "fd00::1", PortEnv: "5555"},
+			expectedMode: Standalone,
+			expectedArgs: []string{"[fd00::1]:5555", "0"},
+		},
+		{
+			name:         "socket takes precedence",
+			env:          map[string]string{HostEnv: "10.0.0.1", SocketEnv: "/run/dcgm.sock"},
+			expectedMode: Standalone,
+			expectedArgs: []string{"/run/dcgm.sock", "1"},
+		},
+		{
+			name:         "explicit standalone",
+			env:          map[string]string{ModeEnv: "Standalone"},
+			expectedMode: Standalone,
+			expectedArgs: []string{"localhost", "0"},
+		},
+		{
+			name:         "explicit embedded ignores host",
+			env:          map[string]string{ModeEnv: "embedded", HostEnv: "10.0.0.1"},
+			expectedMode: Embedded,
+		},
+		{
+			name:         "start hostengine",
+			env:          map[string]string{ModeEnv: "start-hostengine"},
+			expectedMode: StartHostengine,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, args, err := initArgsFromEnv(func(key string) string { return tt.env[key] })
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMode, m)
+			assert.Equal(t, tt.expectedArgs, args)
+		})
+	}
+
+	_, _, err := initArgsFromEnv(func(key string) string {
+		if key == ModeEnv {
+			return "remote"
+		}
+		return ""
+	})
+	require.Error(t, err)
+}
@@ -1,6 +1,50 @@
 package dcgm
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrInvalidMode represents an error indicating that an invalid mode was used
 var ErrInvalidMode = errors.New("invalid mode")
+
+// ErrClientClosed is returned by calls made on a Client that is closed or being closed
+var ErrClientClosed = errors.New("dcgm client is closed")
+
+// ErrShutdownTimeout is returned when in-flight calls do not finish within the shutdown timeout
+var ErrShutdownTimeout = errors.New("timed out waiting for in-flight DCGM calls")
+
+// ErrLibraryNotFound is returned when none of the libdcgm candidates can be loaded
+var ErrLibraryNotFound = errors.New("libdcgm not found")
+
+// ErrHostengineNotFound is returned when the nv-hostengine binary cannot be found in StartHostengine mode
+var ErrHostengineNotFound = errors.New("nv-hostengine not found")
+
+// ErrInvalidAddress is returned when a standalone connection address is malformed
+var ErrInvalidAddress = errors.New("invalid nv-hostengine address")
+
+// ErrConnectFailed is returned when connecting to nv-hostengine fails
+type ErrConnectFailed struct {
+	// Addr is the address the connection was attempted to
+	Addr string
+	// Code is the DCGM return code, one of the DCGM_ST_* constants
+	Code int
+	msg  string
+}
+
+func (e *ErrConnectFailed) Error() string {
+	return fmt.Sprintf("error connecting to nv-hostengine at %s: %s", e.Addr, e.msg)
+}
+
+// ErrDeviceNotFound is returned when no GPU matches a UUID, serial number or PCI bus ID
+var ErrDeviceNotFound = errors.New("no GPU matches the given identifier")
+
+// ErrGpuInUse is returned when an operation that requires an idle GPU finds it in use
+var ErrGpuInUse = errors.New("GPU is in use")
+
+// ErrUnknownField is returned when a field ID or name is not known to DCGM
+var ErrUnknownField = errors.New("unknown DCGM field")
+
+// ErrProfilingUnavailable is returned when the profiling module of the hostengine is denylisted
+// or failed to load
+var ErrProfilingUnavailable = errors.New("DCGM profiling module is unavailable")
@@ -0,0 +1,147 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+#include "dcgm_test_apis.h"
+#include "dcgm_structs_internal.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+)
+
+// EntityStatus is the state DCGM keeps for a GPU
+type EntityStatus uint
+
+const (
+	// EntityStatusUnknown means the GPU has not been referenced yet
+	EntityStatusUnknown EntityStatus = iota
+	// EntityStatusOk means the GPU is known and usable
+	EntityStatusOk
+	// EntityStatusUnsupported means the GPU is not supported by DCGM
+	EntityStatusUnsupported
+	// EntityStatusInaccessible means the GPU cannot be accessed, usually because of cgroups
+	EntityStatusInaccessible
+	// EntityStatusLost means the GPU has fallen off the bus
+	EntityStatusLost
+	// EntityStatusFake means the GPU is a fake GPU created for injection testing
+	EntityStatusFake
+	// EntityStatusDisabled means DCGM does not collect values from the GPU
+	EntityStatusDisabled
+	// EntityStatusDetached means the GPU is detached and cannot be used
+	EntityStatusDetached
+)
+
+func (s EntityStatus) String() string {
+	switch s {
+	case EntityStatusUnknown:
+		return "Unknown"
+	case EntityStatusOk:
+		return "OK"
+	case EntityStatusUnsupported:
+		return "Unsupported"
+	case EntityStatusInaccessible:
+		return "Inaccessible"
+	case EntityStatusLost:
+		return "Lost"
+	case EntityStatusFake:
+		return "Fake"
+	case EntityStatusDisabled:
+		return "Disabled"
+	case EntityStatusDetached:
+		return "Detached"
+	}
+	return "N/A"
+}
+
+// excluded reports whether a GPU in this state is left out of monitoring
+func (s EntityStatus) excluded() bool {
+	switch s {
+	case EntityStatusUnsupported, EntityStatusInaccessible, EntityStatusLost, EntityStatusDisabled, EntityStatusDetached:
+		return true
+	}
+	return false
+}
+
+// ExcludedDevice is a GPU present on the node that DCGM does not monitor
+type ExcludedDevice struct {
+	GPU    uint
+	Status EntityStatus
+	// UUID and PCIBusID are empty if the GPU is too far gone to report its attributes
+	UUID     string
+	PCIBusID string
+}
+
+// GetGpuStatus returns the state DCGM keeps for the specified GPU
+func GetGpuStatus(gpuID uint) (EntityStatus, error) {
+	return defaultClient.GetGpuStatus(gpuID)
+}
+
+// GetGpuStatus returns the state DCGM keeps for the specified GPU
+func (c *Client) GetGpuStatus(gpuID uint) (EntityStatus, error) {
+	if err := c.beginCall(); err != nil {
+		return EntityStatusUnknown, err
+	}
+	defer c.endCall()
+
+	return c.getGpuStatus(gpuID)
+}
+
+// GetExcludedDevices returns the GPUs DCGM knows about but does not monitor because they are
+// unsupported, inaccessible, lost, disabled or detached. GPUs the driver itself excludes are not
+// visible to DCGM and are not reported.
+func GetExcludedDevices() ([]ExcludedDevice, error) {
+	return defaultClient.GetExcludedDevices()
+}
+
+// GetExcludedDevices returns the GPUs DCGM knows about but does not monitor because they are
+// unsupported, inaccessible, lost, disabled or detached. GPUs the driver itself excludes are not
+// visible to DCGM and are not reported.
+func (c *Client) GetExcludedDevices() ([]ExcludedDevice, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	gpus, err := c.getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := []ExcludedDevice{}
+	for _, gpu := range gpus {
+		status, err := c.getGpuStatus(gpu)
+		if err != nil {
+			return nil, err
+		}
+		if !status.excluded() {
+			continue
+		}
+
+		device := ExcludedDevice{GPU: gpu, Status: status}
+
+		var attrs C.dcgmDeviceAttributes_t
+		attrs.version = makeVersion3(unsafe.Sizeof(attrs))
+		if C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpu), &attrs) == C.DCGM_ST_OK {
+			device.UUID = *stringPtr(&attrs.identifiers.uuid[0])
+			device.PCIBusID = *stringPtr(&attrs.identifiers.pciBusId[0])
+		}
+
+		excluded = append(excluded, device)
+	}
+
+	return excluded, nil
+}
+
+func (c *Client) getGpuStatus(gpuID uint) (EntityStatus, error) {
+	var status C.DcgmEntityStatus_t
+
+	result := C.dcgmGetGpuStatus(c.dcgmHandle(), C.uint(gpuID), &status)
+	if err := errorString(result); err != nil {
+		return EntityStatusUnknown, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return EntityStatus(status), nil
+}
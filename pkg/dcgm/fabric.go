@@ -0,0 +1,362 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"time"
+)
+
+// FabricManagerStatus is the state of the Fabric Manager for a GPU, as reported by
+// DCGM_FI_DEV_FABRIC_MANAGER_STATUS
+type FabricManagerStatus int
+
+const (
+	// FabricManagerNotSupported means the GPU is not managed by the Fabric Manager
+	FabricManagerNotSupported FabricManagerStatus = C.DcgmFMStatusNotSupported
+	// FabricManagerNotStarted means the Fabric Manager has not started for the GPU yet
+	FabricManagerNotStarted FabricManagerStatus = C.DcgmFMStatusNotStarted
+	// FabricManagerInProgress means the Fabric Manager is still starting up
+	FabricManagerInProgress FabricManagerStatus = C.DcgmFMStatusInProgress
+	// FabricManagerSuccess means the Fabric Manager has started successfully
+	FabricManagerSuccess FabricManagerStatus = C.DcgmFMStatusSuccess
+	// FabricManagerFailure means the Fabric Manager finished training, but failed
+	FabricManagerFailure FabricManagerStatus = C.DcgmFMStatusFailure
+	// FabricManagerUnrecognized means the driver reported a status DCGM does not know
+	FabricManagerUnrecognized FabricManagerStatus = C.DcgmFMStatusUnrecognized
+	// FabricManagerNvmlTooOld means the driver is too old to report the status
+	FabricManagerNvmlTooOld FabricManagerStatus = C.DcgmFMStatusNvmlTooOld
+)
+
+func (s FabricManagerStatus) String() string {
+	switch s {
+	case FabricManagerNotSupported:
+		return "Not Supported"
+	case FabricManagerNotStarted:
+		return "Not Started"
+	case FabricManagerInProgress:
+		return "In Progress"
+	case FabricManagerSuccess:
+		return "Success"
+	case FabricManagerFailure:
+		return "Failure"
+	case FabricManagerUnrecognized:
+		return "Unrecognized"
+	case FabricManagerNvmlTooOld:
+		return "NVML Too Old"
+	}
+	return fmt.Sprintf("Unknown(%d)", int(s))
+}
+
+// GPUFabricInfo is the Fabric Manager state and NVLink fabric membership of a GPU
+type GPUFabricInfo struct {
+	GPU    uint
+	Time   time.Time
+	Status FabricManagerStatus
+	// ErrorCode is the error the Fabric Manager failed with, -1 if not reported. It is only
+	// reported once the Fabric Manager completed startup.
+	ErrorCode int64
+	// ClusterUUID is the UUID of the cluster the GPU belongs to, empty if not reported
+	ClusterUUID string
+	// CliqueID is the ID of the fabric clique the GPU belongs to, -1 if not reported
+	CliqueID int64
+}
+
+var gpuFabricFields = []Short{
+	DCGM_FI_DEV_FABRIC_MANAGER_STATUS,
+	DCGM_FI_DEV_FABRIC_MANAGER_ERROR_CODE,
+	DCGM_FI_DEV_FABRIC_CLUSTER_UUID,
+	DCGM_FI_DEV_FABRIC_CLIQUE_ID,
+}
+
+// GetGPUFabricInfo returns the Fabric Manager state and fabric membership of the specified GPUs,
+// or of all supported GPUs if none are specified, in the order of the GPUs
+func GetGPUFabricInfo(gpuIDs ...uint) ([]GPUFabricInfo, error) {
+	return defaultClient.GetGPUFabricInfo(gpuIDs...)
+}
+
+// GetGPUFabricInfo returns the Fabric Manager state and fabric membership of the specified GPUs,
+// or of all supported GPUs if none are specified, in the order of the GPUs
+func (c *Client) GetGPUFabricInfo(gpuIDs ...uint) ([]GPUFabricInfo, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	if len(gpuIDs) == 0 {
+		gpus, err := c.getSupportedDevices()
+		if err != nil {
+			return nil, err
+		}
+		gpuIDs = gpus
+	}
+	if len(gpuIDs) == 0 {
+		return []GPUFabricInfo{}, nil
+	}
+
+	entities := make([]GroupEntityPair, len(gpuIDs))
+	for i, gpuID := range gpuIDs {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID}
+	}
+	values, err := c.sampleEntityFields("gpuFabric", entities, gpuFabricFields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting GPU fabric info: %s", err)
+	}
+
+	return toGPUFabricInfo(gpuIDs, values), nil
+}
+
+func toGPUFabricInfo(gpuIDs []uint, values []FieldValue_v2) []GPUFabricInfo {
+	infos := make([]GPUFabricInfo, len(gpuIDs))
+	index := make(map[uint]*GPUFabricInfo, len(gpuIDs))
+	for i, gpuID := range gpuIDs {
+		infos[i] = GPUFabricInfo{GPU: gpuID, ErrorCode: -1, CliqueID: -1}
+		index[gpuID] = &infos[i]
+	}
+
+	for _, value := range values {
+		if value.EntityGroupId != FE_GPU {
+			continue
+		}
+		info, ok := index[value.EntityID]
+		if !ok {
+			continue
+		}
+		v := value.Typed()
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_FABRIC_MANAGER_STATUS:
+			status, ok := v.AsInt64()
+			if !ok {
+				continue
+			}
+			info.Status = FabricManagerStatus(status)
+		case DCGM_FI_DEV_FABRIC_MANAGER_ERROR_CODE:
+			code, ok := v.AsInt64()
+			if !ok {
+				continue
+			}
+			info.ErrorCode = code
+		case DCGM_FI_DEV_FABRIC_CLUSTER_UUID:
+			uuid, ok := v.AsString()
+			if !ok {
+				continue
+			}
+			info.ClusterUUID = uuid
+		case DCGM_FI_DEV_FABRIC_CLIQUE_ID:
+			clique, ok := v.AsInt64()
+			if !ok {
+				continue
+			}
+			info.CliqueID = clique
+		default:
+			continue
+		}
+		if v.Timestamp.After(info.Time) {
+			info.Time = v.Timestamp
+		}
+	}
+
+	return infos
+}
+
+// NvSwitchErrors is the error state of an NvSwitch and of its ports
+type NvSwitchErrors struct {
+	Switch uint
+	Time   time.Time
+	// FatalSXid and NonFatalSXid are the SXids of the latest fatal and non-fatal errors of the
+	// switch, 0 if there were none and -1 if not reported
+	FatalSXid    int64
+	NonFatalSXid int64
+	// ResetRequired is true if the switch must be reset to recover from an error
+	ResetRequired bool
+	// Links are the error counters of the supported ports of the switch
+	Links []NvSwitchLinkErrors
+}
+
+// NvSwitchLinkErrors are the error counters of a port of an NvSwitch. Counters that are not
+// reported are -1.
+type NvSwitchLinkErrors struct {
+	// Index is the port index on the switch
+	Index    uint
+	Fatal    int64
+	NonFatal int64
+	Replay   int64
+	Recovery int64
+	Flit     int64
+	CRC      int64
+	ECC      int64
+}
+
+// HasErrors reports whether the switch reported a fatal or non-fatal error, needs a reset, or one
+// of its ports counted errors
+func (e NvSwitchErrors) HasErrors() bool {
+	if e.FatalSXid > 0 || e.NonFatalSXid > 0 || e.ResetRequired {
+		return true
+	}
+	for _, link := range e.Links {
+		for _, count := range []int64{link.Fatal, link.NonFatal, link.Replay, link.Recovery, link.Flit, link.CRC, link.ECC} {
+			if count > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var nvSwitchErrorFields = []Short{
+	DCGM_FI_DEV_NVSWITCH_FATAL_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_NON_FATAL_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED,
+}
+
+var nvSwitchLinkErrorFields = []Short{
+	DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_LINK_NON_FATAL_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_LINK_REPLAY_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_LINK_RECOVERY_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_LINK_FLIT_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS,
+	DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS,
+}
+
+// GetNvSwitchErrors returns the fatal and non-fatal errors of every NvSwitch in the system and
+// the error counters of their supported ports
+func GetNvSwitchErrors() ([]NvSwitchErrors, error) {
+	return defaultClient.GetNvSwitchErrors()
+}
+
+// GetNvSwitchErrors returns the fatal and non-fatal errors of every NvSwitch in the system and
+// the error counters of their supported ports
+func (c *Client) GetNvSwitchErrors() ([]NvSwitchErrors, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	ids, err := c.getEntityGroupEntities(FE_SWITCH)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []NvSwitchErrors{}, nil
+	}
+
+	links, err := c.getNvLinkLinkStatus()
+	if err != nil {
+		return nil, err
+	}
+	ports := switchLinkEntities(links)
+
+	switches := make([]GroupEntityPair, len(ids))
+	for i, id := range ids {
+		switches[i] = GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: id}
+	}
+	values, err := c.sampleEntityFields("nvSwitchErrors", switches, nvSwitchErrorFields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting NvSwitch errors: %s", err)
+	}
+
+	if len(ports) > 0 {
+		portEntities := make([]GroupEntityPair, len(ports))
+		for i, port := range ports {
+			portEntities[i] = GroupEntityPair{EntityGroupId: port.Group, EntityId: port.ID}
+		}
+		portValues, err := c.sampleEntityFields("nvSwitchLinkErrors", portEntities, nvSwitchLinkErrorFields)
+		if err != nil {
+			return nil, fmt.Errorf("error getting NvSwitch link errors: %s", err)
+		}
+		values = append(values, portValues...)
+	}
+
+	return toNvSwitchErrors(ids, ports, values), nil
+}
+
+func toNvSwitchErrors(ids []uint, ports []Entity, values []FieldValue_v2) []NvSwitchErrors {
+	switches := make([]NvSwitchErrors, len(ids))
+	index := make(map[uint]*NvSwitchErrors, len(ids))
+	for i, id := range ids {
+		switches[i] = NvSwitchErrors{Switch: id, FatalSXid: -1, NonFatalSXid: -1}
+		index[id] = &switches[i]
+	}
+
+	type portKey struct{ switchID, index uint }
+	portIndex := make(map[portKey]int)
+	for _, port := range ports {
+		_, switchID, i := SplitLinkEntityID(port.ID)
+		sw, ok := index[switchID]
+		if !ok {
+			continue
+		}
+		portIndex[portKey{switchID, i}] = len(sw.Links)
+		sw.Links = append(sw.Links, NvSwitchLinkErrors{
+			Index: i, Fatal: -1, NonFatal: -1, Replay: -1, Recovery: -1, Flit: -1, CRC: -1, ECC: -1,
+		})
+	}
+
+	for _, value := range values {
+		v, ok := value.Typed().AsInt64()
+		if !ok {
+			continue
+		}
+
+		switch value.EntityGroupId {
+		case FE_SWITCH:
+			sw, ok := index[value.EntityID]
+			if !ok {
+				continue
+			}
+			switch value.FieldID {
+			case DCGM_FI_DEV_NVSWITCH_FATAL_ERRORS:
+				sw.FatalSXid = v
+			case DCGM_FI_DEV_NVSWITCH_NON_FATAL_ERRORS:
+				sw.NonFatalSXid = v
+			case DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED:
+				sw.ResetRequired = v != 0
+			default:
+				continue
+			}
+			if ts := timestampUSECToTime(value.TS); ts.After(sw.Time) {
+				sw.Time = ts
+			}
+		case FE_LINK:
+			_, switchID, i := SplitLinkEntityID(value.EntityID)
+			sw, ok := index[switchID]
+			if !ok {
+				continue
+			}
+			p, ok := portIndex[portKey{switchID, i}]
+			if !ok {
+				continue
+			}
+			link := &sw.Links[p]
+			switch value.FieldID {
+			case DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS:
+				link.Fatal = v
+			case DCGM_FI_DEV_NVSWITCH_LINK_NON_FATAL_ERRORS:
+				link.NonFatal = v
+			case DCGM_FI_DEV_NVSWITCH_LINK_REPLAY_ERRORS:
+				link.Replay = v
+			case DCGM_FI_DEV_NVSWITCH_LINK_RECOVERY_ERRORS:
+				link.Recovery = v
+			case DCGM_FI_DEV_NVSWITCH_LINK_FLIT_ERRORS:
+				link.Flit = v
+			case DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS:
+				link.CRC = v
+			case DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS:
+				link.ECC = v
+			default:
+				continue
+			}
+			if ts := timestampUSECToTime(value.TS); ts.After(sw.Time) {
+				sw.Time = ts
+			}
+		}
+	}
+
+	return switches
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFabricManagerStatus(t *testing.T) {
+	assert.Equal(t, "Success", FabricManagerSuccess.String())
+	assert.Equal(t, "Unknown(42)", FabricManagerStatus(42).String())
+}
+
+func TestNvSwitchErrors(t *testing.T) {
+	sw := Entity{Group: FE_SWITCH, ID: 3}
+	ports := []Entity{NvSwitchLinkEntity(3, 0), NvSwitchLinkEntity(3, 5)}
+	switches := toNvSwitchErrors([]uint{3, 4}, ports, []FieldValue_v2{
+		fakeFieldValue(sw, DCGM_FI_DEV_NVSWITCH_FATAL_ERRORS, 0, 1_000_000),
+		fakeFieldValue(sw, DCGM_FI_DEV_NVSWITCH_NON_FATAL_ERRORS, 12028, 1_000_000),
+		fakeFieldValue(sw, DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED, 0, 1_000_000),
+		fakeFieldValue(ports[1], DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS, 7, 1_000_000),
+		fakeFieldValue(ports[1], DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS, DCGM_FT_INT64_NOT_SUPPORTED, 1_000_000),
+		fakeFieldValue(NvSwitchLinkEntity(9, 0), DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS, 1, 1_000_000),
+	})
+	require.Len(t, switches, 2)
+
+	assert.Equal(t, uint(3), switches[0].Switch)
+	assert.Equal(t, int64(0), switches[0].FatalSXid)
+	assert.Equal(t, int64(12028), switches[0].NonFatalSXid)
+	assert.False(t, switches[0].ResetRequired)
+	require.Len(t, switches[0].Links, 2)
+	assert.Equal(t, uint(5), switches[0].Links[1].Index)
+	assert.Equal(t, int64(7), switches[0].Links[1].CRC)
+	assert.Equal(t, int64(-1), switches[0].Links[1].ECC)
+	assert.Equal(t, int64(-1), switches[0].Links[0].CRC)
+	assert.True(t, switches[0].HasErrors())
+
+	assert.Equal(t, int64(-1), switches[1].FatalSXid)
+	assert.Empty(t, switches[1].Links)
+	assert.False(t, switches[1].HasErrors())
+}
+
+func TestGPUFabricInfo(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+	status := fakeFieldValue(gpu, DCGM_FI_DEV_FABRIC_MANAGER_STATUS, int64(FabricManagerSuccess), 1_000_000)
+	uuid := fakeStringFieldValue(gpu, DCGM_FI_DEV_FABRIC_CLUSTER_UUID, "4f0b8b1a-0000-0000-0000-000000000001", 1_000_000)
+
+	infos := toGPUFabricInfo([]uint{0}, []FieldValue_v2{status, uuid})
+	require.Len(t, infos, 1)
+	assert.Equal(t, FabricManagerSuccess, infos[0].Status)
+	assert.Equal(t, "4f0b8b1a-0000-0000-0000-000000000001", infos[0].ClusterUUID)
+	assert.Equal(t, int64(-1), infos[0].ErrorCode)
+	assert.Equal(t, int64(-1), infos[0].CliqueID)
+}
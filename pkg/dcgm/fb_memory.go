@@ -0,0 +1,133 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// FBMemory is the framebuffer memory usage of a GPU or of a MIG GPU instance. Values the entity
+// does not report are -1.
+type FBMemory struct {
+	// Entity is the GPU (FE_GPU) or the GPU instance (FE_GPU_I)
+	Entity Entity
+	// GPU is the GPU the memory belongs to
+	GPU      uint
+	Total    int64 // MB
+	Used     int64 // MB
+	Free     int64 // MB
+	Reserved int64 // MB
+}
+
+// UsedFraction returns the fraction of the usable memory, the total minus the reserved memory,
+// that is used. It is NaN if the usage is not reported.
+func (m FBMemory) UsedFraction() float64 {
+	usable := m.Total - max(m.Reserved, 0)
+	if m.Used < 0 || m.Total < 0 || usable <= 0 {
+		return math.NaN()
+	}
+	return float64(m.Used) / float64(usable)
+}
+
+var fbMemoryFields = []Short{DCGM_FI_DEV_FB_TOTAL, DCGM_FI_DEV_FB_USED, DCGM_FI_DEV_FB_FREE, DCGM_FI_DEV_FB_RESERVED}
+
+// GetFBMemory returns the framebuffer memory usage of every supported GPU and of every MIG GPU
+// instance, with each GPU followed by its instances, ordered by ID
+func GetFBMemory() ([]FBMemory, error) {
+	return defaultClient.GetFBMemory()
+}
+
+// GetFBMemory returns the framebuffer memory usage of every supported GPU and of every MIG GPU
+// instance, with each GPU followed by its instances, ordered by ID
+func (c *Client) GetFBMemory() ([]FBMemory, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	gpus, err := c.getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+	if len(gpus) == 0 {
+		return []FBMemory{}, nil
+	}
+
+	hierarchy, err := c.GetGPUInstanceHierarchy()
+	if err != nil {
+		return nil, err
+	}
+
+	memory := fbMemoryEntities(gpus, hierarchy)
+	entities := make([]GroupEntityPair, len(memory))
+	for i, m := range memory {
+		entities[i] = GroupEntityPair{EntityGroupId: m.Entity.Group, EntityId: m.Entity.ID}
+	}
+
+	values, err := c.sampleEntityFields("fbMemory", entities, fbMemoryFields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting framebuffer memory: %s", err)
+	}
+
+	applyFBMemoryValues(memory, values)
+	return memory, nil
+}
+
+// fbMemoryEntities lists gpus and the GPU instances of hierarchy that belong to them, each GPU
+// followed by its instances, with all values unknown
+func fbMemoryEntities(gpus []uint, hierarchy MigHierarchy_v2) []FBMemory {
+	instances := make(map[uint][]uint)
+	for _, info := range hierarchy.EntityList[:hierarchy.Count] {
+		if info.Entity.EntityGroupId == FE_GPU_I && info.Parent.EntityGroupId == FE_GPU {
+			instances[info.Parent.EntityId] = append(instances[info.Parent.EntityId], info.Entity.EntityId)
+		}
+	}
+
+	gpus = slices.Sorted(slices.Values(gpus))
+	unknown := FBMemory{Total: -1, Used: -1, Free: -1, Reserved: -1}
+
+	var memory []FBMemory
+	for _, gpu := range gpus {
+		m := unknown
+		m.Entity, m.GPU = Entity{Group: FE_GPU, ID: gpu}, gpu
+		memory = append(memory, m)
+
+		ids := instances[gpu]
+		slices.Sort(ids)
+		for _, id := range ids {
+			m.Entity = Entity{Group: FE_GPU_I, ID: id}
+			memory = append(memory, m)
+		}
+	}
+	return memory
+}
+
+// applyFBMemoryValues stores the framebuffer field values in the entry of their entity
+func applyFBMemoryValues(memory []FBMemory, values []FieldValue_v2) {
+	index := make(map[Entity]int, len(memory))
+	for i, m := range memory {
+		index[m.Entity] = i
+	}
+
+	for _, value := range values {
+		i, ok := index[Entity{Group: value.EntityGroupId, ID: value.EntityID}]
+		if !ok {
+			continue
+		}
+		v, ok := value.Typed().AsInt64()
+		if !ok {
+			continue
+		}
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_FB_TOTAL:
+			memory[i].Total = v
+		case DCGM_FI_DEV_FB_USED:
+			memory[i].Used = v
+		case DCGM_FI_DEV_FB_FREE:
+			memory[i].Free = v
+		case DCGM_FI_DEV_FB_RESERVED:
+			memory[i].Reserved = v
+		}
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFBMemory(t *testing.T) {
+	var hierarchy MigHierarchy_v2
+	hierarchy.EntityList[0] = MigHierarchyInfo_v2{
+		Entity: GroupEntityPair{EntityGroupId: FE_GPU_I, EntityId: 9},
+		Parent: GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 1},
+	}
+	hierarchy.EntityList[1] = MigHierarchyInfo_v2{
+		Entity: GroupEntityPair{EntityGroupId: FE_GPU_CI, EntityId: 4},
+		Parent: GroupEntityPair{EntityGroupId: FE_GPU_I, EntityId: 9},
+	}
+	hierarchy.EntityList[2] = MigHierarchyInfo_v2{
+		Entity: GroupEntityPair{EntityGroupId: FE_GPU_I, EntityId: 2},
+		Parent: GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 1},
+	}
+	hierarchy.Count = 3
+
+	memory := fbMemoryEntities([]uint{1, 0}, hierarchy)
+	entities := make([]Entity, len(memory))
+	for i, m := range memory {
+		entities[i] = m.Entity
+	}
+	assert.Equal(t, []Entity{{FE_GPU, 0}, {FE_GPU, 1}, {FE_GPU_I, 2}, {FE_GPU_I, 9}}, entities)
+	assert.Equal(t, uint(1), memory[3].GPU)
+
+	applyFBMemoryValues(memory, []FieldValue_v2{
+		fakeFieldValue(Entity{FE_GPU, 1}, DCGM_FI_DEV_FB_TOTAL, 81920, 0),
+		fakeFieldValue(Entity{FE_GPU, 1}, DCGM_FI_DEV_FB_USED, 40500, 0),
+		fakeFieldValue(Entity{FE_GPU, 1}, DCGM_FI_DEV_FB_FREE, 40500, 0),
+		fakeFieldValue(Entity{FE_GPU, 1}, DCGM_FI_DEV_FB_RESERVED, 920, 0),
+		fakeFieldValue(Entity{FE_GPU_I, 9}, DCGM_FI_DEV_FB_USED, 100, 0),
+		fakeFieldValue(Entity{FE_GPU, 0}, DCGM_FI_DEV_FB_USED, DCGM_FT_INT64_NOT_SUPPORTED, 0),
+	})
+
+	assert.Equal(t, FBMemory{Entity: Entity{FE_GPU, 1}, GPU: 1, Total: 81920, Used: 40500, Free: 40500, Reserved: 920}, memory[1])
+	assert.InDelta(t, 0.5, memory[1].UsedFraction(), 1e-9)
+	assert.Equal(t, int64(100), memory[3].Used)
+	assert.True(t, math.IsNaN(memory[3].UsedFraction()))
+	assert.Equal(t, int64(-1), memory[0].Used)
+}
@@ -0,0 +1,69 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"time"
+	"unsafe"
+)
+
+// PidAccountingStats is the decoded DCGM_FI_DEV_ACCOUNTING_DATA blob, the accounting data of one
+// process
+type PidAccountingStats struct {
+	PID uint
+	// GPUUtilization is the percent of the process lifetime during which a kernel was executing
+	GPUUtilization uint
+	// MemoryUtilization is the percent of the process lifetime during which device memory was
+	// being read or written
+	MemoryUtilization uint
+	MaxMemoryUsage    uint64 // bytes
+	StartTime         time.Time
+	// ActiveTime is how long the compute context was active
+	ActiveTime time.Duration
+}
+
+// blobDecoders decode the binary fields whose layout is known, keyed by field ID
+var blobDecoders = map[Short]func(blob [4096]byte) any{
+	DCGM_FI_DEV_ACCOUNTING_DATA:   func(blob [4096]byte) any { return parsePidAccountingStats(blob) },
+	DCGM_FI_DEV_SUPPORTED_CLOCKS:  func(blob [4096]byte) any { return parseSupportedClockSets(blob) },
+	DCGM_FI_DEV_VGPU_INSTANCE_IDS: func(blob [4096]byte) any { return parseVGPUInstanceIDs(blob) },
+}
+
+// parsePidAccountingStats decodes the DCGM_FI_DEV_ACCOUNTING_DATA blob, a
+// dcgmDevicePidAccountingStats_t
+func parsePidAccountingStats(blob [4096]byte) PidAccountingStats {
+	var stats C.dcgmDevicePidAccountingStats_t
+	return PidAccountingStats{
+		PID:               uint(binary.LittleEndian.Uint32(blob[unsafe.Offsetof(stats.pid):])),
+		GPUUtilization:    uint(binary.LittleEndian.Uint32(blob[unsafe.Offsetof(stats.gpuUtilization):])),
+		MemoryUtilization: uint(binary.LittleEndian.Uint32(blob[unsafe.Offsetof(stats.memoryUtilization):])),
+		MaxMemoryUsage:    binary.LittleEndian.Uint64(blob[unsafe.Offsetof(stats.maxMemoryUsage):]),
+		StartTime:         timestampUSECToTime(int64(binary.LittleEndian.Uint64(blob[unsafe.Offsetof(stats.startTimestamp):]))),
+		ActiveTime:        time.Duration(binary.LittleEndian.Uint64(blob[unsafe.Offsetof(stats.activeTimeUsec):])) * time.Microsecond,
+	}
+}
+
+// parseSupportedClockSets decodes the DCGM_FI_DEV_SUPPORTED_CLOCKS blob, a
+// dcgmDeviceSupportedClockSets_t
+func parseSupportedClockSets(blob [4096]byte) []ClockSet {
+	var (
+		sets C.dcgmDeviceSupportedClockSets_t
+		set  C.dcgmClockSet_t
+	)
+	count := min(int(binary.LittleEndian.Uint32(blob[unsafe.Offsetof(sets.count):])), int(C.DCGM_MAX_CLOCKS))
+
+	clockSets := make([]ClockSet, count)
+	for i := range clockSets {
+		entry := blob[int(unsafe.Offsetof(sets.clockSet))+i*int(unsafe.Sizeof(set)):]
+		clockSets[i] = ClockSet{
+			MemClock: uint(binary.LittleEndian.Uint32(entry[unsafe.Offsetof(set.memClock):])),
+			SMClock:  uint(binary.LittleEndian.Uint32(entry[unsafe.Offsetof(set.smClock):])),
+		}
+	}
+	return clockSets
+}
@@ -0,0 +1,102 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+#include "dcgm_test_apis.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Order is the order in which samples are returned
+type Order int
+
+const (
+	// OrderDescending returns the latest samples first
+	OrderDescending Order = C.DCGM_ORDER_DESCENDING
+	// OrderAscending returns the earliest samples first
+	OrderAscending Order = C.DCGM_ORDER_ASCENDING
+)
+
+// HistoryOptions selects the samples returned by GetMultipleValuesForField
+type HistoryOptions struct {
+	// Count is the maximum number of samples returned
+	Count int
+	// Start and End limit the samples to the ones taken in [Start, End]. The zero time does not
+	// limit the samples.
+	Start, End time.Time
+	// Order is the order of the samples. The zero value returns the latest samples first, so that
+	// Count limits the samples to the most recent ones.
+	Order Order
+}
+
+func (o HistoryOptions) validate() error {
+	if o.Count <= 0 {
+		return errors.New("sample count must be positive")
+	}
+	if !o.Start.IsZero() && !o.End.IsZero() && o.End.Before(o.Start) {
+		return errors.New("end time is before start time")
+	}
+	switch o.Order {
+	case 0, OrderDescending, OrderAscending:
+		return nil
+	}
+	return fmt.Errorf("invalid order %d", o.Order)
+}
+
+// timestamps returns the start and end of the options in microseconds, 0 if not set
+func (o HistoryOptions) timestamps() (start, end int64) {
+	if !o.Start.IsZero() {
+		start = o.Start.UnixMicro()
+	}
+	if !o.End.IsZero() {
+		end = o.End.UnixMicro()
+	}
+	return start, end
+}
+
+// GetMultipleValuesForField returns the cached samples of a field on a GPU, without creating a
+// group or field group. The field must be watched, and only samples kept by the watch are
+// returned, so watch the field with a MaxKeepAge or MaxKeepSamples that covers the samples needed.
+func GetMultipleValuesForField(gpuID uint, field Short, opts HistoryOptions) ([]FieldValue_v1, error) {
+	return defaultClient.GetMultipleValuesForField(gpuID, field, opts)
+}
+
+// GetMultipleValuesForField returns the cached samples of a field on a GPU, without creating a
+// group or field group. The field must be watched, and only samples kept by the watch are
+// returned, so watch the field with a MaxKeepAge or MaxKeepSamples that covers the samples needed.
+func (c *Client) GetMultipleValuesForField(gpuID uint, field Short, opts HistoryOptions) ([]FieldValue_v1, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.Order == 0 {
+		opts.Order = OrderDescending
+	}
+
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	values := acquireFieldValueSlice(opts.Count)
+	defer releaseFieldValueSlice(values)
+
+	start, end := opts.timestamps()
+	count := C.int(opts.Count)
+
+	result := C.dcgmGetMultipleValuesForField(c.dcgmHandle(), C.int(gpuID), C.ushort(field), &count,
+		C.longlong(start), C.longlong(end), C.dcgmOrder_t(opts.Order), &values[0])
+	if result == C.DCGM_ST_NO_DATA {
+		return []FieldValue_v1{}, nil
+	}
+	if err := errorString(result); err != nil {
+		return nil, fmt.Errorf("error getting values of field %d: %s", field, err)
+	}
+
+	return toFieldValue(values[:min(int(count), opts.Count)]), nil
+}
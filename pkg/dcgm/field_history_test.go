@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryOptions(t *testing.T) {
+	now := time.Now()
+
+	require.Error(t, HistoryOptions{}.validate())
+	require.Error(t, HistoryOptions{Count: 1, Start: now, End: now.Add(-time.Second)}.validate())
+	require.Error(t, HistoryOptions{Count: 1, Order: 3}.validate())
+	require.NoError(t, HistoryOptions{Count: 1, Start: now}.validate())
+
+	start, end := HistoryOptions{Count: 1, End: now}.timestamps()
+	assert.Zero(t, start)
+	assert.Equal(t, now.UnixMicro(), end)
+
+	_, err := (&Client{closing: true}).GetMultipleValuesForField(0, DCGM_FI_DEV_POWER_USAGE, HistoryOptions{Count: 10})
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,97 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+	"unsafe"
+)
+
+// FieldSummary summarizes the samples of a field over a time window. Values of integer fields are
+// converted to float64. Summaries that have no value, e.g. because the window holds no samples,
+// are NaN.
+type FieldSummary struct {
+	Min   float64
+	Max   float64
+	Avg   float64
+	Sum   float64
+	Count int64
+}
+
+// GetFieldSummary returns the minimum, maximum, average and sum of the values the hostengine kept
+// for a field of an entity between start and end, and the number of samples they are based on.
+// A zero start or end leaves that end of the window open. The field must be watched with enough
+// history for the window, and must be an integer or double field.
+func GetFieldSummary(entity Entity, fieldID Short, start, end time.Time) (FieldSummary, error) {
+	return defaultClient.GetFieldSummary(entity, fieldID, start, end)
+}
+
+// GetFieldSummary returns the minimum, maximum, average and sum of the values the hostengine kept
+// for a field of an entity between start and end, and the number of samples they are based on.
+// A zero start or end leaves that end of the window open. The field must be watched with enough
+// history for the window, and must be an integer or double field.
+func (c *Client) GetFieldSummary(entity Entity, fieldID Short, start, end time.Time) (FieldSummary, error) {
+	if err := c.beginCall(); err != nil {
+		return FieldSummary{}, err
+	}
+	defer c.endCall()
+
+	var request C.dcgmFieldSummaryRequest_t
+	request.version = makeVersion1(unsafe.Sizeof(request))
+	request.fieldId = C.ushort(fieldID)
+	request.entityGroupId = C.dcgm_field_entity_group_t(entity.Group)
+	request.entityId = C.dcgm_field_eid_t(entity.ID)
+	request.summaryTypeMask = C.DCGM_SUMMARY_MIN | C.DCGM_SUMMARY_MAX | C.DCGM_SUMMARY_AVG | C.DCGM_SUMMARY_SUM | C.DCGM_SUMMARY_COUNT
+	if !start.IsZero() {
+		request.startTime = C.uint64_t(start.UnixMicro())
+	}
+	if !end.IsZero() {
+		request.endTime = C.uint64_t(end.UnixMicro())
+	}
+
+	result := C.dcgmGetFieldSummary(c.dcgmHandle(), &request)
+	if err := errorString(result); err != nil {
+		return FieldSummary{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	count := min(int(request.response.summaryCount), int(C.DCGM_SUMMARY_SIZE))
+	raw := make([]uint64, count)
+	for i := range raw {
+		raw[i] = binary.NativeEndian.Uint64(request.response.values[i][:])
+	}
+
+	return toFieldSummary(uint(request.response.fieldType), raw), nil
+}
+
+// toFieldSummary decodes the min, max, avg, sum and count summaries, in that order, from their raw
+// 64 bit values
+func toFieldSummary(fieldType uint, raw []uint64) FieldSummary {
+	value := func(i int) float64 {
+		if i >= len(raw) {
+			return math.NaN()
+		}
+		if fieldType == DCGM_FT_DOUBLE {
+			if v := math.Float64frombits(raw[i]); !IsFloat64Blank(v) {
+				return v
+			}
+			return math.NaN()
+		}
+		if v := int64(raw[i]); !IsInt64Blank(v) {
+			return float64(v)
+		}
+		return math.NaN()
+	}
+
+	summary := FieldSummary{Min: value(0), Max: value(1), Avg: value(2), Sum: value(3)}
+	// like the other summaries, the count has the type of the field
+	if count := value(4); !math.IsNaN(count) {
+		summary.Count = int64(count)
+	}
+	return summary
+}
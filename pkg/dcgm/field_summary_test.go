@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToFieldSummary(t *testing.T) {
+	ints := func(values ...int64) []uint64 {
+		raw := make([]uint64, len(values))
+		for i, v := range values {
+			raw[i] = uint64(v)
+		}
+		return raw
+	}
+
+	summary := toFieldSummary(DCGM_FT_INT64, ints(30, 70, 50, 200, 4))
+	assert.Equal(t, FieldSummary{Min: 30, Max: 70, Avg: 50, Sum: 200, Count: 4}, summary)
+
+	doubles := []uint64{
+		math.Float64bits(1.5), math.Float64bits(2.5), math.Float64bits(2), math.Float64bits(6), math.Float64bits(3),
+	}
+	summary = toFieldSummary(DCGM_FT_DOUBLE, doubles)
+	assert.Equal(t, FieldSummary{Min: 1.5, Max: 2.5, Avg: 2, Sum: 6, Count: 3}, summary)
+
+	summary = toFieldSummary(DCGM_FT_INT64, ints(DCGM_FT_INT64_BLANK, DCGM_FT_INT64_BLANK, DCGM_FT_INT64_BLANK, DCGM_FT_INT64_BLANK, 0))
+	assert.True(t, math.IsNaN(summary.Min))
+	assert.True(t, math.IsNaN(summary.Sum))
+	assert.Zero(t, summary.Count)
+
+	summary = toFieldSummary(DCGM_FT_INT64, nil)
+	assert.True(t, math.IsNaN(summary.Avg))
+}
+
+func TestGetFieldSummary(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	gpus, err := withInjectionGPUs(t, 1)
+	require.NoError(t, err)
+	gpu := Entity{Group: FE_GPU, ID: gpus[0]}
+
+	fieldsGroup, err := FieldGroupCreate("fieldSummaryTest", []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsGroup) }()
+
+	group, err := WatchEntityFields([]Entity{gpu}, fieldsGroup, "fieldSummaryTest")
+	require.NoError(t, err)
+	defer func() { _ = DestroyGroup(group) }()
+
+	start := time.Now().Add(-time.Minute)
+	for i, temp := range []int64{40, 50, 60} {
+		ts := start.Add(time.Duration(i+1) * time.Second).UnixMicro()
+		require.NoError(t, InjectEntityFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64, 0, ts, temp))
+	}
+
+	summary, err := GetFieldSummary(gpu, DCGM_FI_DEV_GPU_TEMP, start, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, FieldSummary{Min: 40, Max: 60, Avg: 50, Sum: 150, Count: 3}, summary)
+}
@@ -19,32 +19,47 @@ package dcgm
 /*
 #include "dcgm_agent.h"
 #include "dcgm_structs.h"
+#include "dcgm_test_apis.h"
 #include "field_values_cb.h"
 extern int go_dcgmFieldValueEntityEnumeration(dcgm_field_entity_group_t entityGroupId,
             dcgm_field_eid_t entityId,
             dcgmFieldValue_v1 *values,
             int numValues,
             void *userData);
+extern int go_dcgmFieldValueEnumeration(unsigned int gpuId,
+            dcgmFieldValue_v1 *values,
+            int numValues,
+            void *userData);
 */
 import "C"
 
 import (
+	"errors"
 	"fmt"
+	"runtime/cgo"
 	"sync"
 	"time"
 	"unsafe"
 )
 
+// callback collects the values of a field value enumeration. C code may not keep Go pointers, so
+// it is passed to the enumeration as a cgo.Handle, converted with C.callbackUserData.
 type callback struct {
 	mu     sync.Mutex
 	Values []FieldValue_v2
+	// reduce, if set, receives the values instead of Values
+	reduce *downsampler
 }
 
 func (cb *callback) processValues(entityGroup Field_Entity_Group, entityID uint, cvalues []C.dcgmFieldValue_v1) {
 	values := dcgmFieldValue_v1ToFieldValue_v2(entityGroup, entityID, cvalues)
 
 	cb.mu.Lock()
-	cb.Values = append(cb.Values, values...)
+	if cb.reduce != nil {
+		cb.reduce.add(values...)
+	} else {
+		cb.Values = append(cb.Values, values...)
+	}
 	cb.mu.Unlock()
 }
 
@@ -61,13 +76,23 @@ func go_dcgmFieldValueEntityEnumeration(
 		valuesSlice := (*[1 << 30]C.dcgmFieldValue_v1)(ptrValues)[0:numValues]
 
 		if userData != nil {
-			processor := (*callback)(userData)
+			processor := cgo.Handle(uintptr(userData)).Value().(*callback)
 			processor.processValues(Field_Entity_Group(entityGroup), uint(entityID), valuesSlice)
 		}
 	}
 	return 0
 }
 
+//export go_dcgmFieldValueEnumeration
+func go_dcgmFieldValueEnumeration(
+	gpuID C.uint,
+	values *C.dcgmFieldValue_v1,
+	numValues C.int,
+	userData unsafe.Pointer,
+) C.int {
+	return go_dcgmFieldValueEntityEnumeration(C.DCGM_FE_GPU, C.dcgm_field_eid_t(gpuID), values, numValues, userData)
+}
+
 // GetValuesSince reads and returns field values for a specified group of entities, such as GPUs,
 // that have been updated since a given timestamp. It allows for targeted data retrieval based on time criteria.
 //
@@ -82,15 +107,39 @@ func go_dcgmFieldValueEntityEnumeration(
 // Returns []FieldValue_v2 slice containing the requested field values, a time.Time indicating the time
 // of the latest data retrieval, and an error if there is any issue during the operation.
 func GetValuesSince(gpuGroup GroupHandle, fieldGroup FieldHandle, sinceTime time.Time) ([]FieldValue_v2, time.Time, error) {
+	return defaultClient.GetValuesSince(gpuGroup, fieldGroup, sinceTime)
+}
+
+// GetValuesSince reads and returns field values for a specified group of entities, such as GPUs,
+// that have been updated since a given timestamp. It allows for targeted data retrieval based on time criteria.
+//
+// GPUGroup is a GroupHandle that identifies the group of entities to operate on. It can be obtained from CreateGroup
+// for a specific group of GPUs or use GroupAllGPUs() to target all GPUs.
+//
+// fieldGroup is a FieldHandle representing the group of fields for which data is requested.
+//
+// sinceTime is a time.Time value representing the timestamp from which to request updated values.
+// A zero value (time.Time{}) requests all available data.
+//
+// Returns []FieldValue_v2 slice containing the requested field values, a time.Time indicating the time
+// of the latest data retrieval, and an error if there is any issue during the operation.
+func (c *Client) GetValuesSince(gpuGroup GroupHandle, fieldGroup FieldHandle, sinceTime time.Time) ([]FieldValue_v2, time.Time, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, time.Time{}, err
+	}
+	defer c.endCall()
+
 	var nextSinceTimestamp C.longlong
 	cbResult := &callback{}
-	result := C.dcgmGetValuesSince_v2(handle.handle,
-		gpuGroup.handle,
-		fieldGroup.handle,
+	handle := cgo.NewHandle(cbResult)
+	defer handle.Delete()
+	result := C.dcgmGetValuesSince_v2(c.dcgmHandle(),
+		c.groupHandle(gpuGroup),
+		c.fieldGroupHandle(fieldGroup),
 		C.longlong(sinceTime.UnixMicro()),
 		&nextSinceTimestamp,
 		C.dcgmFieldValueEnumeration_f(C.fieldValueEntityCallback),
-		unsafe.Pointer(cbResult))
+		C.callbackUserData(C.uintptr_t(handle)))
 	if result != C.DCGM_ST_OK {
 		return nil, time.Time{}, fmt.Errorf("dcgmGetValuesSince_v2 failed with error code %d", int(result))
 	}
@@ -98,6 +147,89 @@ func GetValuesSince(gpuGroup GroupHandle, fieldGroup FieldHandle, sinceTime time
 	return cbResult.Values, timestampUSECToTime(int64(nextSinceTimestamp)), nil
 }
 
+// GetFieldValuesSince is like GetValuesSince, but takes the field IDs directly instead of a field
+// group. It only works with groups of GPUs.
+func GetFieldValuesSince(gpuGroup GroupHandle, fields []Short, sinceTime time.Time) ([]FieldValue_v2, time.Time, error) {
+	return defaultClient.GetFieldValuesSince(gpuGroup, fields, sinceTime)
+}
+
+// GetFieldValuesSince is like GetValuesSince, but takes the field IDs directly instead of a field
+// group. It only works with groups of GPUs.
+func (c *Client) GetFieldValuesSince(gpuGroup GroupHandle, fields []Short, sinceTime time.Time) ([]FieldValue_v2, time.Time, error) {
+	if len(fields) == 0 {
+		return nil, time.Time{}, errors.New("no fields given")
+	}
+
+	if err := c.beginCall(); err != nil {
+		return nil, time.Time{}, err
+	}
+	defer c.endCall()
+
+	cfields := make([]C.ushort, len(fields))
+	for i, f := range fields {
+		cfields[i] = C.ushort(f)
+	}
+
+	var nextSinceTimestamp C.longlong
+	cbResult := &callback{}
+	handle := cgo.NewHandle(cbResult)
+	defer handle.Delete()
+	result := C.dcgmGetFieldValuesSince(c.dcgmHandle(),
+		c.groupHandle(gpuGroup),
+		C.longlong(sinceTime.UnixMicro()),
+		&cfields[0],
+		C.int(len(cfields)),
+		&nextSinceTimestamp,
+		C.dcgmFieldValueEnumeration_f(C.fieldValueGpuCallback),
+		C.callbackUserData(C.uintptr_t(handle)))
+	if err := errorString(result); err != nil {
+		return nil, time.Time{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return cbResult.Values, timestampUSECToTime(int64(nextSinceTimestamp)), nil
+}
+
+// ValuesCursor reads the values that the hostengine accumulates for a watched field group, picking
+// up where the previous read stopped. The values kept are limited by the watch's max keep age
+// and max keep samples, so reads must be frequent enough for no values to be dropped in between.
+// A ValuesCursor is not safe for concurrent use.
+type ValuesCursor struct {
+	client     *Client
+	group      GroupHandle
+	fieldGroup FieldHandle
+	since      time.Time
+}
+
+// NewValuesCursor returns a cursor over the values of fieldGroup for the entities of group that
+// are newer than since. A zero since starts at the oldest value kept.
+func NewValuesCursor(group GroupHandle, fieldGroup FieldHandle, since time.Time) *ValuesCursor {
+	return defaultClient.NewValuesCursor(group, fieldGroup, since)
+}
+
+// NewValuesCursor returns a cursor over the values of fieldGroup for the entities of group that
+// are newer than since. A zero since starts at the oldest value kept.
+func (c *Client) NewValuesCursor(group GroupHandle, fieldGroup FieldHandle, since time.Time) *ValuesCursor {
+	return &ValuesCursor{client: c, group: group, fieldGroup: fieldGroup, since: since}
+}
+
+// Next returns the values recorded since the previous call, or since the cursor was created, and
+// advances the cursor past them. The cursor does not move if an error is returned.
+func (vc *ValuesCursor) Next() ([]FieldValue_v2, error) {
+	values, next, err := vc.client.GetValuesSince(vc.group, vc.fieldGroup, vc.since)
+	if err != nil {
+		return nil, err
+	}
+
+	vc.since = next
+	return values, nil
+}
+
+// Position returns the timestamp the next read starts at. It can be stored to resume reading with
+// a new cursor, e.g. after a restart of the caller.
+func (vc *ValuesCursor) Position() time.Time {
+	return vc.since
+}
+
 func timestampUSECToTime(timestampUSEC int64) time.Time {
 	// Convert microseconds to seconds and nanoseconds
 	sec := timestampUSEC / 1000000           // Convert microseconds to seconds
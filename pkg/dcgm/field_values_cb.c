@@ -25,3 +25,14 @@ int fieldValueEntityCallback(dcgm_field_entity_group_t entityGroupId,
                                     void *userData) {
  return go_dcgmFieldValueEntityEnumeration(entityGroupId, entityId, values, numValues, userData);
 }
+
+int fieldValueGpuCallback(unsigned int gpuId,
+                          dcgmFieldValue_v1 *values,
+                          int numValues,
+                          void *userData) {
+ return go_dcgmFieldValueEnumeration(gpuId, values, numValues, userData);
+}
+
+void *callbackUserData(uintptr_t handle) {
+ return (void *)handle;
+}
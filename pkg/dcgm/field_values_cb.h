@@ -17,6 +17,8 @@
 #ifndef FIELD_VALUES
 #define FIELD_VALUES
 
+#include <stdint.h>
+
 #include "dcgm_agent.h"
 #include "dcgm_structs.h"
 
@@ -26,4 +28,11 @@ int fieldValueEntityCallback(dcgm_field_entity_group_t entityGroupId,
                                       int numValues,
                                       void *userData);
 
+int fieldValueGpuCallback(unsigned int gpuId,
+                          dcgmFieldValue_v1 *values,
+                          int numValues,
+                          void *userData);
+
+void *callbackUserData(uintptr_t handle);
+
 #endif
\ No newline at end of file
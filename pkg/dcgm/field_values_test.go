@@ -122,3 +122,47 @@ func TestGetValuesSince(t *testing.T) {
 		}
 	})
 }
+
+func TestValuesCursor(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+	runOnlyWithLiveGPUs(t)
+
+	const gpu uint = 0
+
+	fieldsGroup, err := FieldGroupCreate("valuesCursorTest", []Short{DCGM_FI_DEV_XID_ERRORS})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsGroup) }()
+
+	require.NoError(t, WatchFieldsWithOptions(fieldsGroup, GroupAllGPUs(), WatchOptions{
+		UpdateFreq:     time.Second,
+		MaxKeepAge:     time.Minute,
+		MaxKeepSamples: 100,
+	}))
+	defer func() { _ = UnwatchFields(GroupAllGPUs(), fieldsGroup) }()
+
+	inject := func(xid int64) {
+		err := InjectFieldValue(gpu, DCGM_FI_DEV_XID_ERRORS, DCGM_FT_INT64, 0, time.Now().UnixMicro(), xid)
+		require.NoError(t, err)
+		require.NoError(t, UpdateAllFields())
+	}
+
+	cursor := NewValuesCursor(GroupAllGPUs(), fieldsGroup, time.Now())
+
+	inject(31)
+	values, err := cursor.Next()
+	require.NoError(t, err)
+	require.NotEmpty(t, values)
+	assert.Equal(t, int64(31), values[len(values)-1].Int64())
+	assert.False(t, cursor.Position().IsZero())
+
+	inject(43)
+	values, err = cursor.Next()
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	assert.Equal(t, int64(43), values[0].Int64())
+
+	values, _, err = GetFieldValuesSince(GroupAllGPUs(), []Short{DCGM_FI_DEV_XID_ERRORS}, time.Time{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, values)
+}
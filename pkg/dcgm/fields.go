@@ -7,9 +7,13 @@ package dcgm
 import "C"
 
 import (
-	"encoding/binary"
 	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"strings"
 	"sync"
+	"time"
 	"unicode"
 	"unsafe"
 )
@@ -41,6 +45,9 @@ type FieldMeta struct {
 	Scope       int                // Scope of the field
 	NvmlFieldID int                // Corresponding NVML field identifier
 	EntityLevel Field_Entity_Group // Entity level/group this field belongs to
+	ShortName   string             // Short column name used by dcgmi dmon, e.g. "TMPTR"
+	Unit        string             // Unit of the value, e.g. "C", "W" or "MB/s"; empty if unitless
+	Width       int                // Maximum number of characters a formatted value takes
 }
 
 // FieldHandle represents a handle to a DCGM field group
@@ -61,6 +68,19 @@ func (f *FieldHandle) GetHandle() uintptr {
 // fields is a slice of field IDs to include in the group.
 // Returns the field group handle and any error encountered.
 func FieldGroupCreate(fieldsGroupName string, fields []Short) (fieldsId FieldHandle, err error) {
+	return defaultClient.FieldGroupCreate(fieldsGroupName, fields)
+}
+
+// FieldGroupCreate creates a new field group with the specified fields.
+// fieldsGroupName is the name for the new group.
+// fields is a slice of field IDs to include in the group.
+// Returns the field group handle and any error encountered.
+func (c *Client) FieldGroupCreate(fieldsGroupName string, fields []Short) (fieldsId FieldHandle, err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
 	var fieldsGroup C.dcgmFieldGrp_t
 	cfields := make([]C.ushort, len(fields))
 	for i, f := range fields {
@@ -70,49 +90,118 @@ func FieldGroupCreate(fieldsGroupName string, fields []Short) (fieldsId FieldHan
 	groupName := C.CString(fieldsGroupName)
 	defer freeCString(groupName)
 
-	result := C.dcgmFieldGroupCreate(handle.handle, C.int(len(fields)), &cfields[0], groupName, &fieldsGroup)
+	result := C.dcgmFieldGroupCreate(c.dcgmHandle(), C.int(len(fields)), &cfields[0], groupName, &fieldsGroup)
 	if err = errorString(result); err != nil {
 		return fieldsId, fmt.Errorf("error creating DCGM fields group: %s", err)
 	}
 
-	fieldsId = FieldHandle{fieldsGroup}
+	fieldsId = c.trackFieldGroup(fieldsGroup, fieldsGroupName, fields)
 	return
 }
 
 // FieldGroupDestroy destroys a previously created field group.
 // Returns an error if the group cannot be destroyed.
 func FieldGroupDestroy(fieldsGroup FieldHandle) (err error) {
-	result := C.dcgmFieldGroupDestroy(handle.handle, fieldsGroup.handle)
+	return defaultClient.FieldGroupDestroy(fieldsGroup)
+}
+
+// FieldGroupDestroy destroys a previously created field group.
+// Returns an error if the group cannot be destroyed.
+func (c *Client) FieldGroupDestroy(fieldsGroup FieldHandle) (err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
+	result := C.dcgmFieldGroupDestroy(c.dcgmHandle(), c.fieldGroupHandle(fieldsGroup))
 	if err = errorString(result); err != nil {
-		err = fmt.Errorf("error destroying DCGM fields group: %s", err)
+		return fmt.Errorf("error destroying DCGM fields group: %s", err)
 	}
 
+	c.untrackFieldGroup(fieldsGroup)
 	return
 }
 
+// FieldGroupInfo contains information about a DCGM field group
+type FieldGroupInfo struct {
+	Handle FieldHandle
+	Name   string
+	Fields []Short
+}
+
+// FieldGroupGetInfo retrieves the name and fields of a field group
+func FieldGroupGetInfo(fieldsGroup FieldHandle) (*FieldGroupInfo, error) {
+	return defaultClient.FieldGroupGetInfo(fieldsGroup)
+}
+
+// FieldGroupGetInfo retrieves the name and fields of a field group
+func (c *Client) FieldGroupGetInfo(fieldsGroup FieldHandle) (*FieldGroupInfo, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	response := C.dcgmFieldGroupInfo_v1{
+		version:      makeVersion1(unsafe.Sizeof(C.dcgmFieldGroupInfo_v1{})),
+		fieldGroupId: c.fieldGroupHandle(fieldsGroup),
+	}
+
+	result := C.dcgmFieldGroupGetInfo(c.dcgmHandle(), &response)
+	if err := errorString(result); err != nil {
+		return nil, fmt.Errorf("error getting DCGM fields group info: %s", err)
+	}
+
+	count := min(int(response.numFieldIds), int(C.DCGM_MAX_FIELD_IDS_PER_FIELD_GROUP))
+	info := FieldGroupInfo{
+		Handle: fieldsGroup,
+		Name:   C.GoString(&response.fieldGroupName[0]),
+		Fields: make([]Short, count),
+	}
+	for i := range info.Fields {
+		info.Fields[i] = Short(response.fieldIds[i])
+	}
+
+	return &info, nil
+}
+
 // WatchFields starts monitoring the specified fields for a GPU.
 // gpuId is the ID of the GPU to monitor.
 // fieldsGroup is the handle of the field group to watch.
 // groupName is a name for the watch group.
 // Returns a group handle and any error encountered.
 func WatchFields(gpuID uint, fieldsGroup FieldHandle, groupName string) (groupId GroupHandle, err error) {
-	group, err := CreateGroup(groupName)
+	return defaultClient.WatchFields(gpuID, fieldsGroup, groupName)
+}
+
+// WatchFields starts monitoring the specified fields for a GPU.
+// gpuId is the ID of the GPU to monitor.
+// fieldsGroup is the handle of the field group to watch.
+// groupName is a name for the watch group.
+// Returns a group handle and any error encountered.
+func (c *Client) WatchFields(gpuID uint, fieldsGroup FieldHandle, groupName string) (groupId GroupHandle, err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
+	group, err := c.CreateGroup(groupName)
 	if err != nil {
 		return
 	}
 
-	err = AddToGroup(group, gpuID)
+	err = c.AddToGroup(group, gpuID)
 	if err != nil {
 		return
 	}
 
-	result := C.dcgmWatchFields(handle.handle, group.handle, fieldsGroup.handle, C.longlong(defaultUpdateFreq),
+	result := C.dcgmWatchFields(c.dcgmHandle(), c.groupHandle(group), c.fieldGroupHandle(fieldsGroup), C.longlong(defaultUpdateFreq),
 		C.double(defaultMaxKeepAge), C.int(defaultMaxKeepSamples))
 	if err = errorString(result); err != nil {
 		return groupId, fmt.Errorf("error watching fields: %s", err)
 	}
 
-	_ = UpdateAllFields()
+	c.trackWatch(group, fieldsGroup, defaultUpdateFreq, defaultMaxKeepAge, defaultMaxKeepSamples)
+	_ = c.UpdateAllFields()
 	return group, nil
 }
 
@@ -126,26 +215,137 @@ func WatchFields(gpuID uint, fieldsGroup FieldHandle, groupName string) (groupId
 func WatchFieldsWithGroupEx(
 	fieldsGroup FieldHandle, group GroupHandle, updateFreq int64, maxKeepAge float64, maxKeepSamples int32,
 ) error {
-	result := C.dcgmWatchFields(handle.handle, group.handle, fieldsGroup.handle,
+	return defaultClient.WatchFieldsWithGroupEx(fieldsGroup, group, updateFreq, maxKeepAge, maxKeepSamples)
+}
+
+// WatchFieldsWithGroupEx starts monitoring fields with custom parameters.
+// fieldsGroup is the handle of the field group to watch.
+// group is the group handle to associate with the watch.
+// updateFreq is the update frequency in microseconds.
+// maxKeepAge is the maximum age of samples to keep in seconds.
+// maxKeepSamples is the maximum number of samples to keep.
+// Returns an error if the watch operation fails.
+func (c *Client) WatchFieldsWithGroupEx(
+	fieldsGroup FieldHandle, group GroupHandle, updateFreq int64, maxKeepAge float64, maxKeepSamples int32,
+) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	result := C.dcgmWatchFields(c.dcgmHandle(), c.groupHandle(group), c.fieldGroupHandle(fieldsGroup),
 		C.longlong(updateFreq), C.double(maxKeepAge), C.int(maxKeepSamples))
 
 	if err := errorString(result); err != nil {
 		return fmt.Errorf("error watching fields: %s", err)
 	}
 
-	if err := UpdateAllFields(); err != nil {
+	c.trackWatch(group, fieldsGroup, updateFreq, maxKeepAge, maxKeepSamples)
+
+	if err := c.UpdateAllFields(); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// UnwatchFields stops monitoring the fields of fieldsGroup on the entities of group, so that the
+// hostengine stops sampling them and releases their cached values. Fields that are also watched
+// through another group or field group remain watched by that watch.
+func UnwatchFields(group GroupHandle, fieldsGroup FieldHandle) error {
+	return defaultClient.UnwatchFields(group, fieldsGroup)
+}
+
+// UnwatchFields stops monitoring the fields of fieldsGroup on the entities of group, so that the
+// hostengine stops sampling them and releases their cached values. Fields that are also watched
+// through another group or field group remain watched by that watch.
+func (c *Client) UnwatchFields(group GroupHandle, fieldsGroup FieldHandle) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	result := C.dcgmUnwatchFields(c.dcgmHandle(), c.groupHandle(group), c.fieldGroupHandle(fieldsGroup))
+	if err := errorString(result); err != nil {
+		return fmt.Errorf("error unwatching fields: %s", err)
+	}
+
+	c.untrackWatch(group, fieldsGroup)
+	return nil
+}
+
+// WatchOptions controls how often watched fields are sampled and how much history the
+// hostengine keeps for them
+type WatchOptions struct {
+	// UpdateFreq is how often the fields are sampled
+	UpdateFreq time.Duration
+	// MaxKeepAge is how long samples are kept. Zero keeps samples regardless of age.
+	MaxKeepAge time.Duration
+	// MaxKeepSamples is how many samples are kept per field and entity. Zero keeps all samples
+	// that are not older than MaxKeepAge.
+	MaxKeepSamples int
+}
+
+// DefaultWatchOptions returns the options used by WatchFields and WatchFieldsWithGroup: a sample
+// every 30 seconds, of which only the latest is kept
+func DefaultWatchOptions() WatchOptions {
+	return WatchOptions{
+		UpdateFreq:     defaultUpdateFreq * time.Microsecond,
+		MaxKeepAge:     defaultMaxKeepAge * time.Second,
+		MaxKeepSamples: defaultMaxKeepSamples,
+	}
+}
+
+// WatchFieldsWithOptions starts monitoring the fields of fieldsGroup on the entities of group,
+// sampled and kept as set by opts. Watching the same fields again with other options replaces
+// the previous options.
+func WatchFieldsWithOptions(fieldsGroup FieldHandle, group GroupHandle, opts WatchOptions) error {
+	return defaultClient.WatchFieldsWithOptions(fieldsGroup, group, opts)
+}
+
+// WatchFieldsWithOptions starts monitoring the fields of fieldsGroup on the entities of group,
+// sampled and kept as set by opts. Watching the same fields again with other options replaces
+// the previous options.
+func (c *Client) WatchFieldsWithOptions(fieldsGroup FieldHandle, group GroupHandle, opts WatchOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	return c.WatchFieldsWithGroupEx(fieldsGroup, group, opts.UpdateFreq.Microseconds(), opts.MaxKeepAge.Seconds(),
+		int32(opts.MaxKeepSamples))
+}
+
+func (opts WatchOptions) validate() error {
+	switch {
+	case opts.UpdateFreq < time.Microsecond:
+		return fmt.Errorf("invalid update frequency %s", opts.UpdateFreq)
+	case opts.MaxKeepAge < 0:
+		return fmt.Errorf("invalid max keep age %s", opts.MaxKeepAge)
+	case opts.MaxKeepSamples < 0 || opts.MaxKeepSamples > math.MaxInt32:
+		return fmt.Errorf("invalid max keep samples %d", opts.MaxKeepSamples)
+	}
+	return nil
+}
+
 // WatchFieldsWithGroup starts monitoring fields using default parameters.
 // fieldsGroup is the handle of the field group to watch.
 // group is the group handle to associate with the watch.
 // Returns an error if the watch operation fails.
 func WatchFieldsWithGroup(fieldsGroup FieldHandle, group GroupHandle) error {
-	return WatchFieldsWithGroupEx(fieldsGroup, group, defaultUpdateFreq, defaultMaxKeepAge, defaultMaxKeepSamples)
+	return defaultClient.WatchFieldsWithGroup(fieldsGroup, group)
+}
+
+// WatchFieldsWithGroup starts monitoring fields using default parameters.
+// fieldsGroup is the handle of the field group to watch.
+// group is the group handle to associate with the watch.
+// Returns an error if the watch operation fails.
+func (c *Client) WatchFieldsWithGroup(fieldsGroup FieldHandle, group GroupHandle) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	return c.WatchFieldsWithGroupEx(fieldsGroup, group, defaultUpdateFreq, defaultMaxKeepAge, defaultMaxKeepSamples)
 }
 
 var fieldValuePool = sync.Pool{
@@ -196,6 +396,19 @@ func releaseFieldValueV2Slice(slice []C.dcgmFieldValue_v2) {
 // fields is a slice of field IDs to retrieve.
 // Returns a slice of field values and any error encountered.
 func GetLatestValuesForFields(gpu uint, fields []Short) ([]FieldValue_v1, error) {
+	return defaultClient.GetLatestValuesForFields(gpu, fields)
+}
+
+// GetLatestValuesForFields retrieves the most recent values for the specified fields.
+// gpu is the ID of the GPU to query.
+// fields is a slice of field IDs to retrieve.
+// Returns a slice of field values and any error encountered.
+func (c *Client) GetLatestValuesForFields(gpu uint, fields []Short) ([]FieldValue_v1, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
 	values := acquireFieldValueSlice(len(fields))
 	defer releaseFieldValueSlice(values)
 
@@ -204,7 +417,7 @@ func GetLatestValuesForFields(gpu uint, fields []Short) ([]FieldValue_v1, error)
 		cfields[i] = C.ushort(f)
 	}
 
-	result := C.dcgmGetLatestValuesForFields(handle.handle, C.int(gpu), &cfields[0], C.uint(len(fields)), &values[0])
+	result := C.dcgmGetLatestValuesForFields(c.dcgmHandle(), C.int(gpu), &cfields[0], C.uint(len(fields)), &values[0])
 	if err := errorString(result); err != nil {
 		return nil, fmt.Errorf("error watching fields: %s", err)
 	}
@@ -219,9 +432,21 @@ func GetLatestValuesForFields(gpu uint, fields []Short) ([]FieldValue_v1, error)
 // fields is a slice of field IDs to retrieve.
 // Returns a slice of field values and any error encountered.
 func LinkGetLatestValues(index, parentId uint, fields []Short) ([]FieldValue_v1, error) {
-	slice := []byte{uint8(FE_SWITCH), uint8(index), uint8(parentId), 0}
-	entityId := binary.LittleEndian.Uint32(slice)
-	return EntityGetLatestValues(FE_LINK, uint(entityId), fields)
+	return defaultClient.LinkGetLatestValues(index, parentId, fields)
+}
+
+// LinkGetLatestValues retrieves the latest values for specified fields of a link entity.
+// index is the link index.
+// parentId is the ID of the parent entity.
+// fields is a slice of field IDs to retrieve.
+// Returns a slice of field values and any error encountered.
+func (c *Client) LinkGetLatestValues(index, parentId uint, fields []Short) ([]FieldValue_v1, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.EntityGetLatestValues(FE_LINK, linkEntityID(FE_SWITCH, parentId, index), fields)
 }
 
 // EntityGetLatestValues retrieves the latest values for specified fields of any entity.
@@ -230,6 +455,20 @@ func LinkGetLatestValues(index, parentId uint, fields []Short) ([]FieldValue_v1,
 // fields is a slice of field IDs to retrieve.
 // Returns a slice of field values and any error encountered.
 func EntityGetLatestValues(entityGroup Field_Entity_Group, entityId uint, fields []Short) ([]FieldValue_v1, error) {
+	return defaultClient.EntityGetLatestValues(entityGroup, entityId, fields)
+}
+
+// EntityGetLatestValues retrieves the latest values for specified fields of any entity.
+// entityGroup specifies the type of entity to query.
+// entityId is the ID of the entity.
+// fields is a slice of field IDs to retrieve.
+// Returns a slice of field values and any error encountered.
+func (c *Client) EntityGetLatestValues(entityGroup Field_Entity_Group, entityId uint, fields []Short) ([]FieldValue_v1, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
 	values := acquireFieldValueSlice(len(fields))
 	defer releaseFieldValueSlice(values)
 
@@ -238,7 +477,7 @@ func EntityGetLatestValues(entityGroup Field_Entity_Group, entityId uint, fields
 		cfields[i] = C.ushort(f)
 	}
 
-	result := C.dcgmEntityGetLatestValues(handle.handle, C.dcgm_field_entity_group_t(entityGroup), C.int(entityId),
+	result := C.dcgmEntityGetLatestValues(c.dcgmHandle(), C.dcgm_field_entity_group_t(entityGroup), C.int(entityId),
 		&cfields[0], C.uint(len(fields)), &values[0])
 	if result != C.DCGM_ST_OK {
 		return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -252,16 +491,47 @@ func EntityGetLatestValues(entityGroup Field_Entity_Group, entityId uint, fields
 // fields is a slice of field IDs to retrieve.
 // flags specify additional options for the query.
 // Returns a slice of field values and any error encountered.
+// All the values are fetched with a single call into DCGM, which is much cheaper than one
+// GetLatestValuesForFields call per GPU when reading many fields from many GPUs.
 func EntitiesGetLatestValues(entities []GroupEntityPair, fields []Short, flags uint) ([]FieldValue_v2, error) {
+	return defaultClient.EntitiesGetLatestValues(entities, fields, flags)
+}
+
+// EntitiesGetLatestValues retrieves the latest values for specified fields across multiple entities.
+// entities is a slice of entity pairs to query.
+// fields is a slice of field IDs to retrieve.
+// flags specify additional options for the query.
+// Returns a slice of field values and any error encountered.
+// All the values are fetched with a single call into DCGM, which is much cheaper than one
+// GetLatestValuesForFields call per GPU when reading many fields from many GPUs.
+func (c *Client) EntitiesGetLatestValues(entities []GroupEntityPair, fields []Short, flags uint) ([]FieldValue_v2, error) {
 	values := acquireFieldValueV2Slice(len(fields) * len(entities))
 	defer releaseFieldValueV2Slice(values)
 
+	if err := c.entitiesGetLatestValues(entities, fields, flags, values); err != nil {
+		return nil, err
+	}
+
+	return toFieldValue_v2(values), nil
+}
+
+// entitiesGetLatestValues reads the latest values of fields for entities into values, which must
+// hold len(entities)*len(fields) elements
+func (c *Client) entitiesGetLatestValues(entities []GroupEntityPair, fields []Short, flags uint, values []C.dcgmFieldValue_v2) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	if len(entities) == 0 || len(fields) == 0 {
+		return nil
+	}
+
 	cfields := make([]C.ushort, len(fields))
 	for i, f := range fields {
 		cfields[i] = C.ushort(f)
 	}
 	cEntities := make([]C.dcgmGroupEntityPair_t, len(entities))
-	cPtrEntities := *(*[]C.dcgmGroupEntityPair_t)(unsafe.Pointer(&cEntities))
 	for i, entity := range entities {
 		cEntities[i] = C.dcgmGroupEntityPair_t{
 			C.dcgm_field_entity_group_t(entity.EntityGroupId),
@@ -269,24 +539,95 @@ func EntitiesGetLatestValues(entities []GroupEntityPair, fields []Short, flags u
 		}
 	}
 
-	result := C.dcgmEntitiesGetLatestValues(handle.handle, &cPtrEntities[0], C.uint(len(entities)), &cfields[0],
+	result := C.dcgmEntitiesGetLatestValues(c.dcgmHandle(), &cEntities[0], C.uint(len(entities)), &cfields[0],
 		C.uint(len(fields)), C.uint(flags), &values[0])
 	if err := errorString(result); err != nil {
-		return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
-
-	return toFieldValue_v2(values), nil
+	return nil
 }
 
 // UpdateAllFields forces an update of all field values.
 // Returns an error if the update fails.
 func UpdateAllFields() error {
-	waitForUpdate := C.int(1)
-	result := C.dcgmUpdateAllFields(handle.handle, waitForUpdate)
+	return defaultClient.UpdateAllFields()
+}
+
+// UpdateAllFields forces an update of all field values.
+// Returns an error if the update fails.
+func (c *Client) UpdateAllFields() error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	return c.updateAllFields(true)
+}
+
+// UpdateAllFieldsEx triggers an update of all watched field values. If waitForUpdate is
+// true it returns once the update is complete, otherwise it returns immediately.
+// This is how watched fields are sampled when running in DCGM_OPERATION_MODE_MANUAL.
+func UpdateAllFieldsEx(waitForUpdate bool) error {
+	return defaultClient.UpdateAllFieldsEx(waitForUpdate)
+}
+
+// UpdateAllFieldsEx triggers an update of all watched field values. If waitForUpdate is
+// true it returns once the update is complete, otherwise it returns immediately.
+// This is how watched fields are sampled when running in DCGM_OPERATION_MODE_MANUAL.
+func (c *Client) UpdateAllFieldsEx(waitForUpdate bool) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	return c.updateAllFields(waitForUpdate)
+}
+
+func (c *Client) updateAllFields(waitForUpdate bool) error {
+	result := C.dcgmUpdateAllFields(c.dcgmHandle(), C.int(boolToCUint(waitForUpdate)))
 
 	return errorString(result)
 }
 
+// sampleEntityFields watches fields on entities just long enough to read their current values. The
+// temporary field group and group are destroyed before returning.
+func (c *Client) sampleEntityFields(name string, entities []GroupEntityPair, fields []Short) ([]FieldValue_v2, error) {
+	fieldsID, err := c.FieldGroupCreate(fmt.Sprintf("%sFields%d", name, rand.Uint64()), fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := c.FieldGroupDestroy(fieldsID); ret != nil {
+			log.Printf("error destroying field group: %v", ret)
+		}
+	}()
+
+	groupID, err := c.CreateGroup(fmt.Sprintf("%s%d", name, rand.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := c.DestroyGroup(groupID); ret != nil {
+			log.Printf("error destroying group: %v", ret)
+		}
+	}()
+
+	for _, entity := range entities {
+		if err = c.AddEntityToGroup(groupID, entity.EntityGroupId, entity.EntityId); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = c.WatchFieldsWithGroup(fieldsID, groupID); err != nil {
+		return nil, err
+	}
+	if err = c.updateAllFields(true); err != nil {
+		return nil, err
+	}
+
+	return c.EntitiesGetLatestValues(entities, fields, 0)
+}
+
 func toFieldValue(cfields []C.dcgmFieldValue_v1) []FieldValue_v1 {
 	fields := make([]FieldValue_v1, len(cfields))
 	for i := range cfields {
@@ -326,36 +667,30 @@ func (fv FieldValue_v1) Blob() [4096]byte {
 func toFieldValue_v2(cfields []C.dcgmFieldValue_v2) []FieldValue_v2 {
 	fields := make([]FieldValue_v2, len(cfields))
 	for i := range cfields {
-		if uint(cfields[i].fieldType) == DCGM_FT_STRING {
-			fields[i] = FieldValue_v2{
-				Version:       uint(cfields[i].version),
-				EntityGroupId: Field_Entity_Group(cfields[i].entityGroupId),
-				EntityID:      uint(cfields[i].entityId),
-				FieldID:       Short(cfields[i].fieldId),
-				FieldType:     uint(cfields[i].fieldType),
-				Status:        int(cfields[i].status),
-				TS:            int64(cfields[i].ts),
-				Value:         cfields[i].value,
-				StringValue:   stringPtr((*C.char)(unsafe.Pointer(&cfields[i].value[0]))),
-			}
-		} else {
-			fields[i] = FieldValue_v2{
-				Version:       uint(cfields[i].version),
-				EntityGroupId: Field_Entity_Group(cfields[i].entityGroupId),
-				EntityID:      uint(cfields[i].entityId),
-				FieldID:       Short(cfields[i].fieldId),
-				FieldType:     uint(cfields[i].fieldType),
-				Status:        int(cfields[i].status),
-				TS:            int64(cfields[i].ts),
-				Value:         cfields[i].value,
-				StringValue:   nil,
-			}
-		}
+		fields[i] = toFieldValueV2(&cfields[i])
 	}
 
 	return fields
 }
 
+func toFieldValueV2(cfield *C.dcgmFieldValue_v2) FieldValue_v2 {
+	field := FieldValue_v2{
+		Version:       uint(cfield.version),
+		EntityGroupId: Field_Entity_Group(cfield.entityGroupId),
+		EntityID:      uint(cfield.entityId),
+		FieldID:       Short(cfield.fieldId),
+		FieldType:     uint(cfield.fieldType),
+		Status:        int(cfield.status),
+		TS:            int64(cfield.ts),
+		Value:         cfield.value,
+	}
+	if field.FieldType == DCGM_FT_STRING {
+		field.StringValue = stringPtr((*C.char)(unsafe.Pointer(&cfield.value[0])))
+	}
+
+	return field
+}
+
 func dcgmFieldValue_v1ToFieldValue_v2(
 	fieldEntityGroup Field_Entity_Group, entityId uint, cfields []C.dcgmFieldValue_v1,
 ) []FieldValue_v2 {
@@ -429,7 +764,7 @@ func Fv2_Blob(fv FieldValue_v2) [4096]byte {
 
 // ToFieldMeta converts a C DCGM field metadata structure to a Go FieldMeta struct.
 func ToFieldMeta(fieldInfo C.dcgm_field_meta_p) FieldMeta {
-	return FieldMeta{
+	meta := FieldMeta{
 		FieldID:     Short(fieldInfo.fieldId),
 		FieldType:   byte(fieldInfo.fieldType),
 		Size:        byte(fieldInfo.size),
@@ -438,13 +773,71 @@ func ToFieldMeta(fieldInfo C.dcgm_field_meta_p) FieldMeta {
 		NvmlFieldID: int(fieldInfo.nvmlFieldId),
 		EntityLevel: Field_Entity_Group(fieldInfo.entityLevel),
 	}
+
+	if format := fieldInfo.valueFormat; format != nil {
+		meta.ShortName = strings.TrimSpace(C.GoStringN(&format.shortName[0], C.int(cStringLen(format.shortName[:]))))
+		meta.Unit = strings.TrimSpace(C.GoStringN(&format.unit[0], C.int(cStringLen(format.unit[:]))))
+		meta.Width = int(format.width)
+	}
+
+	return meta
+}
+
+// cStringLen returns the length of a C string stored in a fixed size array, which is not
+// NUL-terminated if it fills the whole array
+func cStringLen(s []C.char) int {
+	for i, c := range s {
+		if c == 0 {
+			return i
+		}
+	}
+	return len(s)
 }
 
 // FieldGetByID retrieves field metadata for the specified field ID.
+// It panics if the field ID is unknown; use GetFieldMeta to check.
 func FieldGetByID(fieldId Short) FieldMeta {
 	return ToFieldMeta(C.DcgmFieldGetById(C.ushort(fieldId)))
 }
 
+// GetFieldMeta returns the metadata of a field: its tag, type, scope, unit and the column name
+// used by dcgmi dmon. Returns ErrUnknownField if DCGM does not know the field ID.
+func GetFieldMeta(fieldID Short) (FieldMeta, error) {
+	fieldInfo := C.DcgmFieldGetById(C.ushort(fieldID))
+	if fieldInfo == nil {
+		return FieldMeta{}, fmt.Errorf("%w: %d", ErrUnknownField, fieldID)
+	}
+	return ToFieldMeta(fieldInfo), nil
+}
+
+// FieldIDByName resolves a field name to its ID. The name may be a DCGM_FI_ constant name, in any
+// case and with or without the DCGM_FI_ prefix, e.g. "DCGM_FI_DEV_GPU_TEMP" or "dev_gpu_temp", a
+// legacy dcgm-exporter name such as "dcgm_gpu_temp", or a DCGM field tag such as "gpu_temp". Tags
+// are resolved by the loaded DCGM library, so fields newer than this package are found by tag.
+// Returns ErrUnknownField if the name matches no field.
+func FieldIDByName(name string) (Short, error) {
+	if fieldID, ok := GetFieldID(name); ok {
+		return fieldID, nil
+	}
+
+	upper := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(upper, "DCGM_FI_") {
+		upper = "DCGM_FI_" + upper
+	}
+	if fieldID, ok := dcgmFields[upper]; ok {
+		return fieldID, nil
+	}
+
+	tag := C.CString(strings.TrimSpace(name))
+	defer freeCString(tag)
+
+	if fieldInfo := C.DcgmFieldGetByTag(tag); fieldInfo != nil {
+		return Short(fieldInfo.fieldId), nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownField, name)
+}
+
 // FieldsInit initializes the DCGM fields module.
 // Returns an integer status code.
 func FieldsInit() int {
@@ -24,6 +24,78 @@ func TestFieldHandle(t *testing.T) {
 	}
 }
 
+func TestFieldGroupGetInfo(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	fields := []Short{DCGM_FI_DEV_GPU_TEMP, DCGM_FI_DEV_POWER_USAGE}
+
+	fieldsGroup, err := FieldGroupCreate("fieldGroupInfoTest", fields)
+	require.NoError(t, err)
+
+	info, err := FieldGroupGetInfo(fieldsGroup)
+	require.NoError(t, err)
+	assert.Equal(t, fieldsGroup, info.Handle)
+	assert.Equal(t, "fieldGroupInfoTest", info.Name)
+	assert.Equal(t, fields, info.Fields)
+
+	require.NoError(t, FieldGroupDestroy(fieldsGroup))
+
+	_, err = FieldGroupGetInfo(fieldsGroup)
+	require.Error(t, err)
+}
+
+func TestUnwatchFields(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	fieldsGroup, err := FieldGroupCreate("unwatchFieldsTest", []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsGroup) }()
+
+	group := GroupAllGPUs()
+	require.NoError(t, WatchFieldsWithOptions(fieldsGroup, group, DefaultWatchOptions()))
+	require.NoError(t, UnwatchFields(group, fieldsGroup))
+}
+
+func TestGetFieldMeta(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	meta, err := GetFieldMeta(DCGM_FI_DEV_GPU_TEMP)
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_FI_DEV_GPU_TEMP, meta.FieldID)
+	assert.Equal(t, byte(DCGM_FT_INT64), meta.FieldType)
+	assert.NotEmpty(t, meta.Tag)
+	assert.NotEmpty(t, meta.ShortName)
+	assert.Equal(t, "C", meta.Unit)
+
+	_, err = GetFieldMeta(65535)
+	require.ErrorIs(t, err, ErrUnknownField)
+}
+
+func TestFieldIDByName(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	for _, name := range []string{"DCGM_FI_DEV_GPU_TEMP", "dcgm_fi_dev_gpu_temp", "DEV_GPU_TEMP", "dcgm_gpu_temp"} {
+		fieldID, err := FieldIDByName(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, DCGM_FI_DEV_GPU_TEMP, fieldID, name)
+	}
+
+	meta, err := GetFieldMeta(DCGM_FI_DEV_POWER_USAGE)
+	require.NoError(t, err)
+	fieldID, err := FieldIDByName(meta.Tag)
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_FI_DEV_POWER_USAGE, fieldID)
+
+	_, err = FieldIDByName("no_such_field")
+	require.ErrorIs(t, err, ErrUnknownField)
+}
+
 func TestGetLatestValuesForFields(t *testing.T) {
 	teardownTest := setupTest(t)
 	defer teardownTest(t)
@@ -180,3 +252,266 @@ func BenchmarkGetLatestValuesForFieldsVariousSize(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkEntitiesGetLatestValues(b *testing.B) {
+	teardownTest := setupTest(b)
+	defer teardownTest(b)
+
+	gpus, err := withInjectionGPUs(b, 4)
+	require.NoError(b, err)
+
+	groupId, err := NewDefaultGroup("mygroup")
+	require.NoError(b, err)
+	defer func() {
+		err := DestroyGroup(groupId)
+		require.NoError(b, err)
+	}()
+
+	fieldIds := []Short{
+		DCGM_FI_DEV_GPU_TEMP,
+		DCGM_FI_DEV_GPU_UTIL,
+		DCGM_FI_DEV_MEM_COPY_UTIL,
+		DCGM_FI_DEV_FB_FREE,
+		DCGM_FI_DEV_FB_USED,
+		DCGM_FI_DEV_SM_CLOCK,
+	}
+
+	fieldsGroup, err := FieldGroupCreate("fieldGroup-entities", fieldIds)
+	require.NoError(b, err)
+	defer func() {
+		destroyFieldsGroupErr := FieldGroupDestroy(fieldsGroup)
+		require.NoError(b, destroyFieldsGroupErr)
+	}()
+
+	err = WatchFieldsWithGroupEx(fieldsGroup, groupId, defaultUpdateFreq, defaultMaxKeepAge, defaultMaxKeepSamples)
+	require.NoError(b, err)
+
+	entities := make([]GroupEntityPair, len(gpus))
+	for i, gpu := range gpus {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu}
+		for _, fieldId := range fieldIds {
+			err = InjectFieldValue(gpu, fieldId, DCGM_FT_INT64, 0, time.Now().Add(-5*time.Second).UnixMicro(), int64(10))
+			require.NoError(b, err)
+		}
+	}
+
+	err = UpdateAllFields()
+	require.NoError(b, err)
+
+	b.Run("Batched", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			values, err := EntitiesGetLatestValues(entities, fieldIds, 0)
+			require.NoError(b, err)
+			require.Len(b, values, len(entities)*len(fieldIds))
+			runtime.KeepAlive(values)
+		}
+	})
+
+	b.Run("PerGPU", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, gpu := range gpus {
+				values, err := GetLatestValuesForFields(gpu, fieldIds)
+				require.NoError(b, err)
+				runtime.KeepAlive(values)
+			}
+		}
+	})
+}
+
+func TestInjectEntityFieldValue(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	switches, err := CreateFakeNvSwitches(1)
+	require.NoError(t, err)
+	require.Len(t, switches, 1)
+
+	entity := Entity{Group: FE_SWITCH, ID: switches[0]}
+
+	fieldsID, err := FieldGroupCreate("fakeSwitchFields", []Short{DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsID) }()
+
+	groupID, err := WatchEntityFields([]Entity{entity}, fieldsID, "fakeSwitch")
+	require.NoError(t, err)
+	defer func() { _ = DestroyGroup(groupID) }()
+
+	err = InjectEntityFieldValue(entity, DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT, DCGM_FT_INT64, 0, time.Now().UnixMicro(), int64(42))
+	require.NoError(t, err)
+
+	values, err := GetEntitiesLatestValues([]Entity{entity}, []Short{DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT})
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	assert.Equal(t, int64(42), values[0].Int64())
+
+	err = InjectEntityFieldValue(entity, DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT, DCGM_FT_INT64, 0, 0, "42")
+	require.Error(t, err)
+}
+
+func TestInjectHelpers(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	gpus, err := CreateFakeGPUs(1)
+	require.NoError(t, err)
+
+	gpu := Entity{Group: FE_GPU, ID: gpus[0]}
+	fields := []Short{DCGM_FI_DEV_GPU_TEMP, DCGM_FI_DEV_XID_ERRORS, DCGM_FI_DEV_ECC_SBE_VOL_TOTAL, DCGM_FI_DEV_ECC_DBE_AGG_TOTAL}
+
+	fieldsID, err := FieldGroupCreate("fakeGpuFields", fields)
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsID) }()
+
+	groupID, err := WatchEntityFields([]Entity{gpu}, fieldsID, "fakeGpu")
+	require.NoError(t, err)
+	defer func() { _ = DestroyGroup(groupID) }()
+
+	require.NoError(t, InjectTemperature(gpu.ID, 95))
+	require.NoError(t, InjectXid(gpu.ID, 79))
+	require.NoError(t, InjectECCErrors(gpu.ID, 3, 1))
+
+	values, err := GetEntityValues([]Entity{gpu}, fields, 0)
+	require.NoError(t, err)
+
+	for field, expected := range map[Short]int64{
+		DCGM_FI_DEV_GPU_TEMP:          95,
+		DCGM_FI_DEV_XID_ERRORS:        79,
+		DCGM_FI_DEV_ECC_SBE_VOL_TOTAL: 3,
+		DCGM_FI_DEV_ECC_DBE_AGG_TOTAL: 1,
+	} {
+		value, ok := values.Get(gpu, field)
+		require.True(t, ok)
+		v, ok := value.AsInt64()
+		require.True(t, ok)
+		assert.Equal(t, expected, v)
+	}
+
+	require.Error(t, InjectValue(gpu, DCGM_FI_DEV_GPU_TEMP, []byte("hot")))
+}
+
+func TestGroupLatestValues(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	gpus, err := CreateFakeGPUs(1)
+	require.NoError(t, err)
+	switches, err := CreateFakeNvSwitches(1)
+	require.NoError(t, err)
+
+	gpu := Entity{Group: FE_GPU, ID: gpus[0]}
+	sw := Entity{Group: FE_SWITCH, ID: switches[0]}
+	fields := []Short{DCGM_FI_DEV_GPU_TEMP, DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT}
+
+	fieldsID, err := FieldGroupCreate("fakeMixedFields", fields)
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsID) }()
+
+	groupID, err := WatchEntityFields([]Entity{gpu, sw}, fieldsID, "fakeMixed")
+	require.NoError(t, err)
+	defer func() { _ = DestroyGroup(groupID) }()
+
+	require.NoError(t, InjectTemperature(gpu.ID, 61))
+	err = InjectEntityFieldValue(sw, DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT, DCGM_FT_INT64, 0, time.Now().UnixMicro(), int64(42))
+	require.NoError(t, err)
+
+	values, err := groupID.LatestValues(fieldsID)
+	require.NoError(t, err)
+
+	value, ok := values.Get(gpu, DCGM_FI_DEV_GPU_TEMP)
+	require.True(t, ok)
+	assert.Equal(t, int64(61), value.Value)
+
+	value, ok = values.Get(sw, DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT)
+	require.True(t, ok)
+	assert.Equal(t, int64(42), value.Value)
+}
+
+func TestFieldValueSupported(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+
+	assert.True(t, fieldValueSupported(fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 42, 0)))
+	assert.True(t, fieldValueSupported(fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_BLANK, 0)))
+	assert.False(t, fieldValueSupported(fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_NOT_SUPPORTED, 0)))
+	assert.False(t, fieldValueSupported(fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_NOT_FOUND, 0)))
+	assert.True(t, fieldValueSupported(fakeStringFieldValue(gpu, DCGM_FI_DEV_NAME, "Tesla", 0)))
+	assert.False(t, fieldValueSupported(fakeStringFieldValue(gpu, DCGM_FI_DEV_NAME, "<<<NOT_SUPPORTED>>>", 0)))
+
+	notSupported := fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 42, 0)
+	notSupported.Status = DCGM_ST_NOT_SUPPORTED
+	assert.False(t, fieldValueSupported(notSupported))
+
+	assert.True(t, isProfilingField(DCGM_FI_PROF_SM_ACTIVE))
+	assert.False(t, isProfilingField(DCGM_FI_DEV_GPU_TEMP))
+}
+
+func TestGetSupportedFields(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+
+	fields := []Short{DCGM_FI_DEV_NAME, DCGM_FI_DEV_GPU_TEMP, DCGM_FI_PROF_SM_ACTIVE}
+	supported, err := GetSupportedFields(gpus[0], fields)
+	require.NoError(t, err)
+	assert.Contains(t, supported, DCGM_FI_DEV_NAME)
+	assert.Subset(t, fields, supported)
+}
+
+func TestWatchOptionsValidate(t *testing.T) {
+	opts := DefaultWatchOptions()
+	assert.Equal(t, 30*time.Second, opts.UpdateFreq)
+	assert.Equal(t, 1, opts.MaxKeepSamples)
+	require.NoError(t, opts.validate())
+
+	require.NoError(t, WatchOptions{UpdateFreq: time.Second, MaxKeepAge: time.Hour}.validate())
+	require.Error(t, WatchOptions{}.validate())
+	require.Error(t, WatchOptions{UpdateFreq: time.Second, MaxKeepAge: -time.Second}.validate())
+	require.Error(t, WatchOptions{UpdateFreq: time.Second, MaxKeepSamples: -1}.validate())
+}
+
+func TestGetMultipleValuesForField(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	gpus, err := CreateFakeGPUs(1)
+	require.NoError(t, err)
+
+	fieldsID, err := FieldGroupCreate("fieldHistory", []Short{DCGM_FI_DEV_GPU_TEMP})
+	require.NoError(t, err)
+	defer func() { _ = FieldGroupDestroy(fieldsID) }()
+
+	groupID, err := WatchEntityFields([]Entity{{Group: FE_GPU, ID: gpus[0]}}, fieldsID, "fieldHistory")
+	require.NoError(t, err)
+	defer func() { _ = DestroyGroup(groupID) }()
+	require.NoError(t, WatchFieldsWithOptions(fieldsID, groupID, WatchOptions{UpdateFreq: time.Second, MaxKeepAge: time.Hour}))
+
+	for _, temp := range []int64{60, 70, 80} {
+		require.NoError(t, InjectTemperature(gpus[0], temp))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	latest, err := GetMultipleValuesForField(gpus[0], DCGM_FI_DEV_GPU_TEMP, HistoryOptions{Count: 2})
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	assert.Equal(t, int64(80), latest[0].Int64())
+	assert.Equal(t, int64(70), latest[1].Int64())
+
+	earliest, err := GetMultipleValuesForField(gpus[0], DCGM_FI_DEV_GPU_TEMP, HistoryOptions{Count: 10, Order: OrderAscending})
+	require.NoError(t, err)
+	require.NotEmpty(t, earliest)
+	assert.Equal(t, int64(80), earliest[len(earliest)-1].Int64())
+}
+
+func TestEntitiesGetLatestValuesEmpty(t *testing.T) {
+	values, err := (&Client{}).EntitiesGetLatestValues(nil, []Short{DCGM_FI_DEV_GPU_TEMP}, 0)
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = (&Client{closing: true}).EntitiesGetLatestValues(nil, []Short{DCGM_FI_DEV_GPU_TEMP}, 0)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,174 @@
+package dcgm
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// HostResult holds the outcome of a fleet query on a single nv-hostengine
+type HostResult[T any] struct {
+	// Host is the address of the hostengine, as given in its ConnectOptions
+	Host  string
+	Value T
+	Err   error
+}
+
+type fleetMember struct {
+	opts   ConnectOptions
+	client *Client
+	err    error
+}
+
+// FleetClient maintains connections to several nv-hostengine instances and runs
+// queries on all of them concurrently
+type FleetClient struct {
+	// mu guards the clients of the members: queries hold it for reading while they run, so
+	// that Close waits for them before closing the clients
+	mu      sync.RWMutex
+	members []*fleetMember
+}
+
+// NewFleetClient connects to every endpoint concurrently. Endpoints that cannot be
+// reached do not fail the call; their connection error is reported in the results of
+// every query instead. An error is returned only if no endpoint could be reached.
+func NewFleetClient(endpoints []ConnectOptions) (*FleetClient, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no nv-hostengine endpoints given")
+	}
+
+	f := &FleetClient{members: make([]*fleetMember, len(endpoints))}
+
+	var wg sync.WaitGroup
+	for i, opts := range endpoints {
+		member := &fleetMember{opts: opts}
+		f.members[i] = member
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			member.client, member.err = ConnectWithOptions(member.opts)
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, member := range f.members {
+		if member.err == nil {
+			return f, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", member.opts.Addr, member.err))
+	}
+
+	return nil, fmt.Errorf("error connecting to any nv-hostengine: %w", errors.Join(errs...))
+}
+
+// Hosts returns the addresses of all endpoints, in the order they were given
+func (f *FleetClient) Hosts() []string {
+	hosts := make([]string, len(f.members))
+	for i, member := range f.members {
+		hosts[i] = member.opts.Addr
+	}
+	return hosts
+}
+
+// Client returns the client connected to host, or nil if there is none
+func (f *FleetClient) Client(host string) *Client {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, member := range f.members {
+		if member.opts.Addr == host {
+			return member.client
+		}
+	}
+	return nil
+}
+
+// Close disconnects from all hostengines, once the queries in progress are finished
+func (f *FleetClient) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var errs []error
+	for _, member := range f.members {
+		if member.client == nil {
+			continue
+		}
+		if err := member.client.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", member.opts.Addr, err))
+		}
+		member.client = nil
+	}
+	return errors.Join(errs...)
+}
+
+// FleetQuery runs fn against every connected hostengine concurrently and returns one
+// result per endpoint, in the order the endpoints were given
+func FleetQuery[T any](f *FleetClient, fn func(*Client) (T, error)) []HostResult[T] {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	results := make([]HostResult[T], len(f.members))
+
+	var wg sync.WaitGroup
+	for i, member := range f.members {
+		results[i].Host = member.opts.Addr
+
+		switch {
+		case member.err != nil:
+			results[i].Err = member.err
+			continue
+		case member.client == nil:
+			results[i].Err = errors.New("connection to nv-hostengine is closed")
+			continue
+		}
+
+		client := member.client
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i].Value, results[i].Err = fn(client)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// GetSupportedDevices returns the DCGM-supported GPU IDs of every host
+func (f *FleetClient) GetSupportedDevices() []HostResult[[]uint] {
+	return FleetQuery(f, (*Client).GetSupportedDevices)
+}
+
+// GetAllDeviceInfo returns detailed information about all GPUs of every host
+func (f *FleetClient) GetAllDeviceInfo() []HostResult[[]Device] {
+	return FleetQuery(f, (*Client).GetAllDeviceInfo)
+}
+
+// EntitiesGetLatestValues returns the latest values of fields for the given entities on every host
+func (f *FleetClient) EntitiesGetLatestValues(entities []GroupEntityPair, fields []Short, flags uint) []HostResult[[]FieldValue_v2] {
+	return FleetQuery(f, func(c *Client) ([]FieldValue_v2, error) {
+		return c.EntitiesGetLatestValues(entities, fields, flags)
+	})
+}
+
+// HealthCheckAll performs a health check on every supported GPU of every host
+func (f *FleetClient) HealthCheckAll() []HostResult[[]DeviceHealth] {
+	return FleetQuery(f, func(c *Client) ([]DeviceHealth, error) {
+		gpus, err := c.GetSupportedDevices()
+		if err != nil {
+			return nil, err
+		}
+
+		health := make([]DeviceHealth, 0, len(gpus))
+		for _, gpu := range gpus {
+			h, err := c.HealthCheckByGpuId(gpu)
+			if err != nil {
+				return nil, err
+			}
+			health = append(health, h)
+		}
+		return health, nil
+	})
+}
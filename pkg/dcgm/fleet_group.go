@@ -0,0 +1,125 @@
+package dcgm
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	errFleetGroupMissing = errors.New("group was not created on this host")
+	errFleetGroupFields  = errors.New("no fields are watched on the group")
+)
+
+// FleetGroup is a group replicated on every hostengine of a FleetClient. Group and field group
+// handles are only valid on the hostengine that returned them, so a FleetGroup keeps the handles
+// of every host and its operations run on all of them concurrently, returning one result per host
+// in the order of the endpoints. Hosts where the group could not be created report that error.
+type FleetGroup struct {
+	fleet *FleetClient
+	name  string
+	// hosts holds the group of every client it was created on; it is only written while the group
+	// is created, operations only update their own host's entry
+	hosts map[*Client]*fleetGroupHost
+}
+
+type fleetGroupHost struct {
+	group      GroupHandle
+	fieldGroup *FieldHandle
+}
+
+// CreateGroup creates a group of the specified type and name on every host, like GroupCreate,
+// and adds the entities to it. Entity IDs are local to each hostengine, so GPU 0 is the first GPU
+// of every host. The results hold the handle of the group on every host.
+func (f *FleetClient) CreateGroup(groupType GroupType, groupName string, entities ...Entity) (*FleetGroup, []HostResult[GroupHandle]) {
+	g := &FleetGroup{fleet: f, name: groupName, hosts: make(map[*Client]*fleetGroupHost)}
+
+	var mu sync.Mutex
+	results := FleetQuery(f, func(c *Client) (GroupHandle, error) {
+		group, err := c.createGroupWithEntities(groupType, groupName, entityPairs(entities))
+		if err != nil {
+			return GroupHandle{}, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		g.hosts[c] = &fleetGroupHost{group: group}
+		return group, nil
+	})
+
+	return g, results
+}
+
+// fleetGroupQuery runs fn with the group of every host where it was created, like FleetQuery
+func fleetGroupQuery[T any](g *FleetGroup, fn func(*Client, *fleetGroupHost) (T, error)) []HostResult[T] {
+	return FleetQuery(g.fleet, func(c *Client) (T, error) {
+		host, ok := g.hosts[c]
+		if !ok {
+			var zero T
+			return zero, errFleetGroupMissing
+		}
+		return fn(c, host)
+	})
+}
+
+// WatchFields starts watching the fields on the group of every host with the options, in a field
+// group created on every host. The fields replace those of a previous call.
+func (g *FleetGroup) WatchFields(fields []Short, opts WatchOptions) []HostResult[FieldHandle] {
+	return fleetGroupQuery(g, func(c *Client, host *fleetGroupHost) (FieldHandle, error) {
+		if err := g.unwatchFields(c, host); err != nil {
+			return FieldHandle{}, err
+		}
+
+		fieldGroup, err := c.FieldGroupCreate(g.name+"Fields", fields)
+		if err != nil {
+			return FieldHandle{}, err
+		}
+		if err := c.WatchFieldsWithOptions(fieldGroup, host.group, opts); err != nil {
+			_ = c.FieldGroupDestroy(fieldGroup)
+			return FieldHandle{}, err
+		}
+		host.fieldGroup = &fieldGroup
+		return fieldGroup, nil
+	})
+}
+
+// LatestValues returns the latest values of the watched fields for the members of the group on
+// every host, like GroupLatestValues
+func (g *FleetGroup) LatestValues() []HostResult[EntityValues] {
+	return fleetGroupQuery(g, func(c *Client, host *fleetGroupHost) (EntityValues, error) {
+		if host.fieldGroup == nil {
+			return nil, errFleetGroupFields
+		}
+		return c.GroupLatestValues(host.group, *host.fieldGroup)
+	})
+}
+
+// HealthSet enables the health watches of the systems on the group of every host
+func (g *FleetGroup) HealthSet(systems HealthSystem) []HostResult[struct{}] {
+	return fleetGroupQuery(g, func(c *Client, host *fleetGroupHost) (struct{}, error) {
+		return struct{}{}, c.HealthSet(host.group, systems)
+	})
+}
+
+// HealthCheck checks the health watches of the group on every host, like HealthCheck
+func (g *FleetGroup) HealthCheck() []HostResult[HealthResponse] {
+	return fleetGroupQuery(g, func(c *Client, host *fleetGroupHost) (HealthResponse, error) {
+		return c.HealthCheck(host.group)
+	})
+}
+
+// Destroy stops watching the fields and destroys the group and its field group on every host
+func (g *FleetGroup) Destroy() []HostResult[struct{}] {
+	return fleetGroupQuery(g, func(c *Client, host *fleetGroupHost) (struct{}, error) {
+		return struct{}{}, errors.Join(g.unwatchFields(c, host), c.GroupDestroy(host.group))
+	})
+}
+
+// unwatchFields stops watching the fields of the previous WatchFields call on a host, if any
+func (g *FleetGroup) unwatchFields(c *Client, host *fleetGroupHost) error {
+	if host.fieldGroup == nil {
+		return nil
+	}
+	err := errors.Join(c.UnwatchFields(host.group, *host.fieldGroup), c.FieldGroupDestroy(*host.fieldGroup))
+	host.fieldGroup = nil
+	return err
+}
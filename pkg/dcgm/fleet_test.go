@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFleetClientNoEndpoints(t *testing.T) {
+	fleet, err := NewFleetClient(nil)
+	require.Error(t, err)
+	assert.Nil(t, fleet)
+}
+
+func TestFleetQueryReportsPerHostErrors(t *testing.T) {
+	connectErr := errors.New("connection refused")
+	fleet := &FleetClient{members: []*fleetMember{
+		{opts: ConnectOptions{Addr: "host-a"}, client: &Client{}},
+		{opts: ConnectOptions{Addr: "host-b"}, err: connectErr},
+		{opts: ConnectOptions{Addr: "host-c"}, client: &Client{}},
+	}}
+
+	assert.Equal(t, []string{"host-a", "host-b", "host-c"}, fleet.Hosts())
+
+	results := FleetQuery(fleet, func(c *Client) (int, error) {
+		return 42, nil
+	})
+	require.Len(t, results, 3)
+
+	assert.Equal(t, HostResult[int]{Host: "host-a", Value: 42}, results[0])
+	assert.Equal(t, "host-b", results[1].Host)
+	assert.ErrorIs(t, results[1].Err, connectErr)
+	assert.Equal(t, HostResult[int]{Host: "host-c", Value: 42}, results[2])
+}
+
+func TestFleetCloseWaitsForQueries(t *testing.T) {
+	fleet := &FleetClient{members: []*fleetMember{
+		{opts: ConnectOptions{Addr: "host-a"}, client: &Client{closed: true}},
+	}}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	queried := make(chan []HostResult[int])
+	go func() {
+		queried <- FleetQuery(fleet, func(c *Client) (int, error) {
+			close(started)
+			<-release
+			return 42, nil
+		})
+	}()
+	<-started
+
+	closed := make(chan error)
+	go func() {
+		closed <- fleet.Close()
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("Close returned while a query was in progress")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	assert.Equal(t, []HostResult[int]{{Host: "host-a", Value: 42}}, <-queried)
+	require.NoError(t, <-closed)
+	assert.Nil(t, fleet.Client("host-a"))
+
+	results := FleetQuery(fleet, func(c *Client) (int, error) {
+		return 42, nil
+	})
+	assert.Error(t, results[0].Err)
+}
+
+func TestFleetGroupReportsPerHostErrors(t *testing.T) {
+	connectErr := errors.New("connection refused")
+	fleet := &FleetClient{members: []*fleetMember{
+		{opts: ConnectOptions{Addr: "host-a"}, client: &Client{closing: true}},
+		{opts: ConnectOptions{Addr: "host-b"}, err: connectErr},
+	}}
+
+	group, results := fleet.CreateGroup(GroupEmpty, "fleet", Entity{Group: FE_GPU, ID: 0})
+	require.Len(t, results, 2)
+	assert.Equal(t, "host-a", results[0].Host)
+	assert.ErrorIs(t, results[0].Err, ErrClientClosed)
+	assert.ErrorIs(t, results[1].Err, connectErr)
+
+	health := group.HealthCheck()
+	require.Len(t, health, 2)
+	assert.ErrorIs(t, health[0].Err, errFleetGroupMissing)
+	assert.ErrorIs(t, health[1].Err, connectErr)
+
+	host := &fleetGroupHost{}
+	group.hosts[fleet.members[0].client] = host
+	values := group.LatestValues()
+	assert.ErrorIs(t, values[0].Err, errFleetGroupFields)
+
+	watches := group.WatchFields([]Short{DCGM_FI_DEV_GPU_TEMP}, DefaultWatchOptions())
+	assert.ErrorIs(t, watches[0].Err, ErrClientClosed)
+	assert.Nil(t, host.fieldGroup)
+}
@@ -0,0 +1,217 @@
+//go:build ignore
+
+// gen_fields generates const_fields.go, the field ID constants, from dcgm_fields.h. It defaults to
+// the header bundled with this package; point -header at the dcgm_fields.h of a DCGM installation to
+// pick up the fields of a newer release, then copy that header here so that cgo builds against it
+// too. Comments of constants already in const_fields.go are kept, new constants are documented
+// with the comments of the header.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// renamed maps the constants that this package named differently from the header, before the
+// constants were generated, to their header names. They are kept as aliases.
+var renamed = map[string]string{
+	"DCGM_FI_DEV_CPU_TEMP_SHUTDOWN": "DCGM_FI_DEV_CPU_TEMP_CRITICAL",
+	"DCGM_FI_DEV_CPU_POWER_CURRENT": "DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT",
+}
+
+var (
+	define = regexp.MustCompile(`^#define\s+(DCGM_FI_\w+)\s+(\d+|DCGM_FI_\w+)\s*$`)
+	// internal matches the ranges reserved for the hostengine
+	internal = regexp.MustCompile(`^DCGM_FI_INTERNAL_`)
+	tag      = regexp.MustCompile(`<[^>]*>|&nbsp;`)
+)
+
+// writeDoc writes the existing comment of a constant, or one made of the first sentence of its
+// header comment
+func writeDoc(buf *bytes.Buffer, name string, existing []string, header string) {
+	if len(existing) == 0 {
+		sentence, _, _ := strings.Cut(header, ". ")
+		existing = []string{name + " represents " + lowerFirst(strings.TrimSuffix(sentence, "."))}
+	}
+	for _, line := range existing {
+		fmt.Fprintf(buf, "\t// %s\n", line)
+	}
+}
+
+type constant struct {
+	name, value, doc string
+}
+
+func main() {
+	header := flag.String("header", "dcgm_fields.h", "path of dcgm_fields.h")
+	flag.Parse()
+
+	docs := existingDocs("const_fields.go")
+	constants, err := parseHeader(*header)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, license)
+	fmt.Fprintln(&buf, "// Code generated by gen_fields.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package dcgm")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "const (")
+	for _, c := range constants {
+		writeDoc(&buf, c.name, docs[c.name], c.doc)
+		fmt.Fprintf(&buf, "\t%s Short = %s\n", c.name, c.value)
+		for old, name := range renamed {
+			if name != c.name {
+				continue
+			}
+			writeDoc(&buf, old, docs[old], c.doc)
+			fmt.Fprintf(&buf, "\t//\n\t// Deprecated: Use %s instead.\n\t%s Short = %s\n", name, old, name)
+		}
+	}
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "var dcgmFields = map[string]Short{")
+	fmt.Fprintln(&buf, "\t// Field types")
+	for _, ft := range []string{"BINARY:b", "DOUBLE:d", "INT64:i", "STRING:s", "TIMESTAMP:t"} {
+		name, char, _ := strings.Cut(ft, ":")
+		fmt.Fprintf(&buf, "\t\"DCGM_FT_%s\": Short('%s'),\n", name, char)
+	}
+	fmt.Fprintln(&buf)
+	values := make(map[string]string, len(constants))
+	for _, c := range constants {
+		values[c.name] = c.value
+		// aliases are commented with the ID of the field they alias
+		id := c.value
+		for values[id] != "" {
+			id = values[id]
+		}
+		fmt.Fprintf(&buf, "\t%q: %s, // %s\n", c.name, c.name, id)
+		for old, name := range renamed {
+			if name == c.name {
+				fmt.Fprintf(&buf, "\t%q: %s, // %s\n", old, old, id)
+			}
+		}
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("const_fields.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseHeader returns the DCGM_FI_ constants defined in the header, in the order they are
+// defined. A comment documents the defines that follow it up to the next blank line.
+func parseHeader(path string) ([]constant, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		constants []constant
+		comment   []string
+		doc       string
+		inComment bool
+	)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "/**"):
+			inComment, comment, doc = !strings.HasSuffix(line, "*/"), nil, ""
+		case inComment && strings.HasSuffix(line, "*/"):
+			inComment = false
+			doc = strings.Join(comment, " ")
+		case inComment:
+			text := strings.TrimSpace(tag.ReplaceAllString(strings.TrimPrefix(line, "*"), " "))
+			if text != "" && !strings.HasPrefix(text, "@") {
+				comment = append(comment, text)
+			}
+		case line == "":
+			doc = ""
+		default:
+			m := define.FindStringSubmatch(line)
+			if m == nil || internal.MatchString(m[1]) {
+				continue
+			}
+			constants = append(constants, constant{name: m[1], value: m[2], doc: doc})
+		}
+	}
+	return constants, scanner.Err()
+}
+
+// existingDocs returns the comments of the constants in the file, so that regenerating it does not
+// lose comments written for this package
+func existingDocs(path string) map[string][]string {
+	docs := make(map[string][]string)
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+	if err != nil {
+		return docs
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if len(value.Names) != 1 || value.Doc == nil {
+				continue
+			}
+			// the first paragraph, without the deprecation notes added by this generator
+			doc, _, _ := strings.Cut(value.Doc.Text(), "\n\n")
+			docs[value.Names[0].Name] = strings.Split(strings.TrimSpace(doc), "\n")
+		}
+	}
+	return docs
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return "a DCGM field"
+	}
+	runes := []rune(s)
+	if len(runes) > 1 && unicode.IsUpper(runes[1]) {
+		// an acronym, such as NVLink
+		return s
+	}
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+const license = `/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+`
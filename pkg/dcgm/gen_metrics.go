@@ -0,0 +1,166 @@
+//go:build ignore
+
+// gen_metrics generates metrics_generated.go, the metric metadata of every field in
+// const_fields.go. Names and help texts are derived from the constants and their comments; units
+// and metric types from the rules below. Run it with go generate after adding fields.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// rule assigns a unit and metric type to the fields whose name, without the DCGM_FI_ prefix,
+// matches pattern. The first matching rule wins.
+type rule struct {
+	pattern    *regexp.Regexp
+	unit       string
+	metricType string
+}
+
+func r(pattern, unit, metricType string) rule {
+	return rule{regexp.MustCompile(pattern), unit, metricType}
+}
+
+var rules = []rule{
+	// strings, identifiers, bitmasks and binary structures
+	r(`^(DRIVER_VERSION|NVML_VERSION|PROCESS_NAME|CUDA_DRIVER_VERSION)$`, "", "MetricInfo"),
+	r(`^GPU_TOPOLOGY_`, "", "MetricInfo"),
+	r(`^DEV_(NAME|BRAND|SERIAL|UUID|MINOR_NUMBER|NVML_INDEX|PCI_BUSID|PCI_COMBINED_ID|PCI_SUBSYS_ID)$`, "", "MetricInfo"),
+	r(`_(INFOROM_VER|VBIOS_VERSION|INFOROM_IMAGE_VER|CUDA_VISIBLE_DEVICES_STR|CUDA_COMPUTE_CAPABILITY)$`, "", "MetricInfo"),
+	r(`^DEV_(CPU|MEM)_AFFINITY_\d+$`, "", "MetricInfo"),
+	r(`^DEV_(MIG_ATTRIBUTES|MIG_GI_INFO|MIG_CI_INFO|SUPPORTED_CLOCKS|ACCOUNTING_DATA|CPU_VENDOR|CPU_MODEL)$`, "", "MetricInfo"),
+	r(`_(UUID|MASK|REASONS|TYPE_IDS|INSTANCE_IDS|TYPE_INFO|STATS|SESSIONS_INFO|UTILIZATIONS|PER_PROCESS_UTILIZATION)$`, "", "MetricInfo"),
+	r(`^DEV_VGPU_(TYPE_NAME|TYPE_CLASS|TYPE_LICENSE|VM_ID|VM_NAME|TYPE|DRIVER_VERSION|PCI_ID|VM_GPU_INSTANCE_ID)$`, "", "MetricInfo"),
+	r(`^DEV_PLATFORM_`, "", "MetricInfo"),
+	r(`^DEV_NVSWITCH_(PHYS_ID|LINK_ID|PCIE_\w+|LINK_REMOTE_PCIE_\w+|LINK_DEVICE_LINK_S?ID|LINK_TYPE)$`, "", "MetricInfo"),
+	r(`^DEV_FABRIC_(CLIQUE_ID|MANAGER_ERROR_CODE)$`, "", "MetricInfo"),
+	r(`^DEV_CONNECTX_(UNCORRECTABLE|CORRECTABLE)_ERR_`, "", "MetricInfo"),
+
+	// counters
+	r(`^DEV_TOTAL_ENERGY_CONSUMPTION$`, "mJ", "MetricCounter"),
+	r(`_VIOLATION$`, "us", "MetricCounter"),
+	r(`^DEV_PCIE_REPLAY_COUNTER$`, "", "MetricCounter"),
+	r(`^DEV_ECC_(SBE|DBE)_(VOL|AGG)_`, "", "MetricCounter"),
+	r(`^DEV_NVLINK_\w+_ERROR_COUNT_`, "", "MetricCounter"),
+	r(`^DEV_NVLINK_ERROR_DL_`, "", "MetricCounter"),
+	r(`^DEV_NVLINK_COUNT_\w+_BYTES$`, "B", "MetricCounter"),
+	r(`^DEV_NVLINK_COUNT_SYMBOL_BER$`, "", "MetricGauge"),
+	r(`^DEV_NVLINK_COUNT_`, "", "MetricCounter"),
+	r(`^DEV_NVLINK_(TX_|RX_)?BANDWIDTH_`, "MiB", "MetricCounter"),
+	r(`^DEV_NVSWITCH_(LINK_)?\w*ERRORS(_LANE\d+)?$`, "", "MetricCounter"),
+	r(`^DEV_NVSWITCH_LINK_LATENCY_`, "", "MetricCounter"),
+	r(`^DEV_NVSWITCH_(LINK_)?THROUGHPUT_(TX|RX)$`, "", "MetricCounter"),
+
+	// gauges with a unit
+	r(`^DEV_NVSWITCH_VOLTAGE_MVOLT$`, "mV", "MetricGauge"),
+	r(`^DEV_NVSWITCH_POWER_`, "W", "MetricGauge"),
+	r(`(TEMP|TEMPERATURE)(_\w+)?$`, "C", "MetricGauge"),
+	r(`CLOCK(_CURRENT)?$`, "MHz", "MetricGauge"),
+	r(`^DEV_(POWER_USAGE|POWER_USAGE_INSTANT|POWER_MGMT_LIMIT\w*|ENFORCED_POWER_LIMIT|CPU_POWER_\w+|SYSIO_POWER_UTIL_CURRENT|MODULE_POWER_UTIL_CURRENT)$`, "W", "MetricGauge"),
+	r(`^DEV_(FB|BAR1)_(TOTAL|FREE|USED|RESERVED)$`, "MiB", "MetricGauge"),
+	r(`^DEV_(GPU|MEM_COPY|ENC|DEC)_UTIL$|^DEV_FB_USED_PERCENT$|^DEV_FAN_SPEED$`, "%", "MetricGauge"),
+	r(`^DEV_CPU_UTIL_`, "", "MetricGauge"),
+	r(`^DEV_PCIE_(TX|RX)_THROUGHPUT$`, "KB/s", "MetricGauge"),
+	r(`^PROF_\w+_BYTES$`, "B/s", "MetricGauge"),
+	r(`^PROF_`, "ratio", "MetricGauge"),
+}
+
+// skip matches the constants that are not fields
+var skip = regexp.MustCompile(`^DCGM_FI_(UNKNOWN|MAX_FIELDS|\w*FIRST_\w+|\w*LAST_\w+)$`)
+
+type metric struct {
+	id                                     int
+	constant, name, help, unit, metricType string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "const_fields.go", nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var metrics []metric
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if len(value.Names) != 1 || len(value.Values) != 1 {
+				continue
+			}
+			// aliases of other fields, e.g. deprecated names, have no literal value
+			lit, ok := value.Values[0].(*ast.BasicLit)
+			if !ok {
+				continue
+			}
+			constant := value.Names[0].Name
+			if !strings.HasPrefix(constant, "DCGM_FI_") || skip.MatchString(constant) {
+				continue
+			}
+			m := newMetric(constant, value.Doc.Text())
+			if m.id, err = strconv.Atoi(lit.Value); err != nil {
+				log.Fatalf("%s: %s", constant, err)
+			}
+			metrics = append(metrics, m)
+		}
+	}
+	slices.SortStableFunc(metrics, func(a, b metric) int { return a.id - b.id })
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_metrics.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package dcgm")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var metricMetas = []MetricMeta{")
+	for _, m := range metrics {
+		fmt.Fprintf(&buf, "\t{FieldID: %s, Name: %q, Help: %q, Unit: %q, Type: %s},\n", m.constant, m.name, m.help, m.unit, m.metricType)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("metrics_generated.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newMetric(constant, doc string) metric {
+	field := strings.TrimPrefix(constant, "DCGM_FI_")
+	m := metric{constant: constant, name: "dcgm_" + strings.ToLower(field), metricType: "MetricGauge"}
+
+	for _, rule := range rules {
+		if rule.pattern.MatchString(field) {
+			m.unit, m.metricType = rule.unit, rule.metricType
+			break
+		}
+	}
+
+	help := strings.Join(strings.Fields(doc), " ")
+	for _, prefix := range []string{constant + " represents ", constant + " is "} {
+		help = strings.TrimPrefix(help, prefix)
+	}
+	if help != "" {
+		runes := []rune(help)
+		runes[0] = unicode.ToUpper(runes[0])
+		help = string(runes)
+	}
+	m.help = help
+	return m
+}
@@ -8,7 +8,6 @@ import "C"
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
 )
 
@@ -35,75 +34,263 @@ func GroupAllGPUs() GroupHandle {
 	return GroupHandle{C.DCGM_GROUP_ALL_GPUS}
 }
 
-// CreateGroup creates a new empty GPU group with the specified name
-func CreateGroup(groupName string) (goGroupId GroupHandle, err error) {
-	var cGroupID C.dcgmGpuGrp_t
-	cname := C.CString(groupName)
-	defer freeCString(cname)
+// GroupAllNvSwitches returns a GroupHandle representing all NvSwitches in the system
+func GroupAllNvSwitches() GroupHandle {
+	return GroupHandle{C.DCGM_GROUP_ALL_NVSWITCHES}
+}
 
-	result := C.dcgmGroupCreate(handle.handle, C.DCGM_GROUP_EMPTY, cname, &cGroupID)
-	if err = errorString(result); err != nil {
-		return goGroupId, fmt.Errorf("error creating group: %s", err)
+// GroupAllInstances returns a GroupHandle representing all GPU instances in the system
+func GroupAllInstances() GroupHandle {
+	return GroupHandle{C.DCGM_GROUP_ALL_INSTANCES}
+}
+
+// GroupAllComputeInstances returns a GroupHandle representing all compute instances in the system
+func GroupAllComputeInstances() GroupHandle {
+	return GroupHandle{C.DCGM_GROUP_ALL_COMPUTE_INSTANCES}
+}
+
+// GroupAllEntities returns a GroupHandle representing all entities in the system
+func GroupAllEntities() GroupHandle {
+	return GroupHandle{C.DCGM_GROUP_ALL_ENTITIES}
+}
+
+// GroupType selects which entities a new group starts with
+type GroupType int
+
+const (
+	// GroupDefault adds all GPUs of the node to the group
+	GroupDefault GroupType = C.DCGM_GROUP_DEFAULT
+	// GroupEmpty creates an empty group
+	GroupEmpty GroupType = C.DCGM_GROUP_EMPTY
+	// GroupDefaultNvSwitches adds all NvSwitches of the node to the group
+	GroupDefaultNvSwitches GroupType = C.DCGM_GROUP_DEFAULT_NVSWITCHES
+	// GroupDefaultInstances adds all GPU instances of the node to the group
+	GroupDefaultInstances GroupType = C.DCGM_GROUP_DEFAULT_INSTANCES
+	// GroupDefaultComputeInstances adds all compute instances of the node to the group
+	GroupDefaultComputeInstances GroupType = C.DCGM_GROUP_DEFAULT_COMPUTE_INSTANCES
+	// GroupDefaultEverything adds all entities of the node to the group
+	GroupDefaultEverything GroupType = C.DCGM_GROUP_DEFAULT_EVERYTHING
+)
+
+func (t GroupType) String() string {
+	switch t {
+	case GroupDefault:
+		return "Default"
+	case GroupEmpty:
+		return "Empty"
+	case GroupDefaultNvSwitches:
+		return "Default NvSwitches"
+	case GroupDefaultInstances:
+		return "Default Instances"
+	case GroupDefaultComputeInstances:
+		return "Default Compute Instances"
+	case GroupDefaultEverything:
+		return "Default Everything"
 	}
+	return fmt.Sprintf("Unknown(%d)", int(t))
+}
 
-	goGroupId = GroupHandle{cGroupID}
-	return
+// GroupCreate creates a new group of the specified type with the specified name. The group must
+// be destroyed with GroupDestroy when no longer needed.
+func GroupCreate(groupType GroupType, groupName string) (GroupHandle, error) {
+	return defaultClient.GroupCreate(groupType, groupName)
 }
 
-// NewDefaultGroup creates a new group with default GPUs and the specified name
-func NewDefaultGroup(groupName string) (GroupHandle, error) {
-	var cGroupID C.dcgmGpuGrp_t
+// GroupCreate creates a new group of the specified type with the specified name. The group must
+// be destroyed with GroupDestroy when no longer needed.
+func (c *Client) GroupCreate(groupType GroupType, groupName string) (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
 
+	var cGroupID C.dcgmGpuGrp_t
 	cname := C.CString(groupName)
 	defer freeCString(cname)
 
-	result := C.dcgmGroupCreate(handle.handle, C.DCGM_GROUP_DEFAULT, cname, &cGroupID)
+	result := C.dcgmGroupCreate(c.dcgmHandle(), C.dcgmGroupType_t(groupType), cname, &cGroupID)
 	if err := errorString(result); err != nil {
 		return GroupHandle{}, fmt.Errorf("error creating group: %s", err)
 	}
 
-	return GroupHandle{cGroupID}, nil
+	return c.trackGroup(cGroupID, C.dcgmGroupType_t(groupType), groupName), nil
+}
+
+// GroupDestroy destroys a group, like DestroyGroup
+func GroupDestroy(groupID GroupHandle) error {
+	return defaultClient.GroupDestroy(groupID)
+}
+
+// GroupDestroy destroys a group, like DestroyGroup
+func (c *Client) GroupDestroy(groupID GroupHandle) error {
+	return c.DestroyGroup(groupID)
+}
+
+// CreateGroup creates a new empty GPU group with the specified name
+func CreateGroup(groupName string) (goGroupId GroupHandle, err error) {
+	return defaultClient.CreateGroup(groupName)
+}
+
+// CreateGroup creates a new empty GPU group with the specified name
+func (c *Client) CreateGroup(groupName string) (goGroupId GroupHandle, err error) {
+	return c.GroupCreate(GroupEmpty, groupName)
+}
+
+// NewDefaultGroup creates a new group with default GPUs and the specified name
+func NewDefaultGroup(groupName string) (GroupHandle, error) {
+	return defaultClient.NewDefaultGroup(groupName)
+}
+
+// NewDefaultGroup creates a new group with default GPUs and the specified name
+func (c *Client) NewDefaultGroup(groupName string) (GroupHandle, error) {
+	return c.GroupCreate(GroupDefault, groupName)
 }
 
 // AddToGroup adds a GPU to an existing group
 func AddToGroup(groupID GroupHandle, gpuID uint) (err error) {
-	result := C.dcgmGroupAddDevice(handle.handle, groupID.handle, C.uint(gpuID))
+	return defaultClient.AddToGroup(groupID, gpuID)
+}
+
+// AddToGroup adds a GPU to an existing group
+func (c *Client) AddToGroup(groupID GroupHandle, gpuID uint) (err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
+	result := C.dcgmGroupAddDevice(c.dcgmHandle(), c.groupHandle(groupID), C.uint(gpuID))
 	if err = errorString(result); err != nil {
 		return fmt.Errorf("error adding GPU %v to group: %s", gpuID, err)
 	}
 
+	c.trackGroupEntity(groupID, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID})
 	return
 }
 
 // AddLinkEntityToGroup adds a link entity to the group
 func AddLinkEntityToGroup(groupID GroupHandle, index, parentID uint) (err error) {
-	/* Only supported on little-endian systems currently */
-	slice := []byte{uint8(FE_SWITCH), uint8(index), uint8(parentID), 0}
+	return defaultClient.AddLinkEntityToGroup(groupID, index, parentID)
+}
 
-	entityId := binary.LittleEndian.Uint32(slice)
+// AddLinkEntityToGroup adds a link entity to the group
+func (c *Client) AddLinkEntityToGroup(groupID GroupHandle, index, parentID uint) (err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
 
-	return AddEntityToGroup(groupID, FE_LINK, uint(entityId))
+	return c.AddEntityToGroup(groupID, FE_LINK, linkEntityID(FE_SWITCH, parentID, index))
 }
 
 // AddEntityToGroup adds an entity to an existing group
 func AddEntityToGroup(groupID GroupHandle, entityGroupID Field_Entity_Group, entityID uint) (err error) {
-	result := C.dcgmGroupAddEntity(handle.handle, groupID.handle, C.dcgm_field_entity_group_t(entityGroupID),
+	return defaultClient.AddEntityToGroup(groupID, entityGroupID, entityID)
+}
+
+// AddEntityToGroup adds an entity to an existing group
+func (c *Client) AddEntityToGroup(groupID GroupHandle, entityGroupID Field_Entity_Group, entityID uint) (err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
+	result := C.dcgmGroupAddEntity(c.dcgmHandle(), c.groupHandle(groupID), C.dcgm_field_entity_group_t(entityGroupID),
 		C.uint(entityID))
 	if err = errorString(result); err != nil {
 		return fmt.Errorf("error adding entity group type %v, entity %v to group: %s", entityGroupID, entityID, err)
 	}
 
+	c.trackGroupEntity(groupID, GroupEntityPair{EntityGroupId: entityGroupID, EntityId: entityID})
 	return
 }
 
+// GroupAddEntity adds an entity, such as a GPU, a GPU or compute instance, an NvSwitch, a link, a
+// CPU or a CPU core, to an existing group. A group can hold entities of different types.
+func GroupAddEntity(groupID GroupHandle, entity GroupEntityPair) error {
+	return defaultClient.GroupAddEntity(groupID, entity)
+}
+
+// GroupAddEntity adds an entity, such as a GPU, a GPU or compute instance, an NvSwitch, a link, a
+// CPU or a CPU core, to an existing group. A group can hold entities of different types.
+func (c *Client) GroupAddEntity(groupID GroupHandle, entity GroupEntityPair) error {
+	return c.AddEntityToGroup(groupID, entity.EntityGroupId, entity.EntityId)
+}
+
+// GroupRemoveEntity removes an entity from an existing group
+func GroupRemoveEntity(groupID GroupHandle, entity GroupEntityPair) error {
+	return defaultClient.GroupRemoveEntity(groupID, entity)
+}
+
+// GroupRemoveEntity removes an entity from an existing group
+func (c *Client) GroupRemoveEntity(groupID GroupHandle, entity GroupEntityPair) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	result := C.dcgmGroupRemoveEntity(c.dcgmHandle(), c.groupHandle(groupID), C.dcgm_field_entity_group_t(entity.EntityGroupId),
+		C.uint(entity.EntityId))
+	if err := errorString(result); err != nil {
+		return fmt.Errorf("error removing entity group type %v, entity %v from group: %s", entity.EntityGroupId, entity.EntityId, err)
+	}
+
+	c.untrackGroupEntity(groupID, entity)
+	return nil
+}
+
 // DestroyGroup destroys an existing GPU group
 func DestroyGroup(groupID GroupHandle) (err error) {
-	result := C.dcgmGroupDestroy(handle.handle, groupID.handle)
-	if err = errorString(result); err != nil {
+	return defaultClient.DestroyGroup(groupID)
+}
+
+// DestroyGroup destroys an existing GPU group
+func (c *Client) DestroyGroup(groupID GroupHandle) (err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
+	return c.destroyGroup(groupID)
+}
+
+func (c *Client) destroyGroup(groupID GroupHandle) error {
+	result := C.dcgmGroupDestroy(c.dcgmHandle(), c.groupHandle(groupID))
+	if err := errorString(result); err != nil {
 		return fmt.Errorf("error destroying group: %s", err)
 	}
 
-	return
+	c.untrackGroup(groupID)
+	return nil
+}
+
+// GetAllGroupIDs returns the handles of all groups of the hostengine, including groups created by
+// other clients, such as dcgmi or exporters, and the default groups of the hostengine
+func GetAllGroupIDs() ([]GroupHandle, error) {
+	return defaultClient.GetAllGroupIDs()
+}
+
+// GetAllGroupIDs returns the handles of all groups of the hostengine, including groups created by
+// other clients, such as dcgmi or exporters, and the default groups of the hostengine
+func (c *Client) GetAllGroupIDs() ([]GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	var (
+		groupIDs [C.DCGM_MAX_NUM_GROUPS]C.dcgmGpuGrp_t
+		count    C.uint
+	)
+	result := C.dcgmGroupGetAllIds(c.dcgmHandle(), &groupIDs[0], &count)
+	if err := errorString(result); err != nil {
+		return nil, fmt.Errorf("error getting group IDs: %s", err)
+	}
+
+	groups := make([]GroupHandle, count)
+	for i := range groups {
+		groups[i] = c.callerGroup(groupIDs[i])
+	}
+	return groups, nil
 }
 
 // GroupInfo contains information about a DCGM group
@@ -113,13 +300,23 @@ type GroupInfo struct {
 	EntityList []GroupEntityPair
 }
 
-// GetGroupInfo retrieves information about a DCGM group
+// GetGroupInfo retrieves information about a DCGM group: its name and all of its members
 func GetGroupInfo(groupID GroupHandle) (*GroupInfo, error) {
+	return defaultClient.GetGroupInfo(groupID)
+}
+
+// GetGroupInfo retrieves information about a DCGM group: its name and all of its members
+func (c *Client) GetGroupInfo(groupID GroupHandle) (*GroupInfo, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
 	response := C.dcgmGroupInfo_v3{
 		version: C.dcgmGroupInfo_version3,
 	}
 
-	result := C.dcgmGroupGetInfo(handle.handle, groupID.handle, &response)
+	result := C.dcgmGroupGetInfo(c.dcgmHandle(), c.groupHandle(groupID), &response)
 	if err := errorString(result); err != nil {
 		return nil, err
 	}
@@ -138,12 +335,148 @@ func GetGroupInfo(groupID GroupHandle) (*GroupInfo, error) {
 	return &ret, nil
 }
 
+// Entities returns the members of the group, in the order the hostengine reports them
+func (g GroupInfo) Entities() []Entity {
+	entities := make([]Entity, len(g.EntityList))
+	for i, pair := range g.EntityList {
+		entities[i] = pair.Entity()
+	}
+	return entities
+}
+
+// Diff compares the members of the group with the desired members. missing are the desired
+// entities that are not in the group and extra the members that are not desired, both in the
+// order they are listed; adding missing and removing extra reconciles the group.
+func (g GroupInfo) Diff(desired []Entity) (missing, extra []Entity) {
+	actual := make(map[Entity]bool, len(g.EntityList))
+	for _, pair := range g.EntityList {
+		actual[pair.Entity()] = true
+	}
+	want := make(map[Entity]bool, len(desired))
+	for _, entity := range desired {
+		if !actual[entity] && !want[entity] {
+			missing = append(missing, entity)
+		}
+		want[entity] = true
+	}
+	for _, pair := range g.EntityList {
+		if !want[pair.Entity()] {
+			extra = append(extra, pair.Entity())
+		}
+	}
+	return missing, extra
+}
+
+// GroupClone creates a group with the specified name holding the same members as the group, for
+// instance to run a diagnostic on a copy while the group stays watched. Later changes to either
+// group do not affect the other.
+func GroupClone(group GroupHandle, newName string) (GroupHandle, error) {
+	return defaultClient.GroupClone(group, newName)
+}
+
+// GroupClone creates a group with the specified name holding the same members as the group, for
+// instance to run a diagnostic on a copy while the group stays watched. Later changes to either
+// group do not affect the other.
+func (c *Client) GroupClone(group GroupHandle, newName string) (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
+
+	info, err := c.GetGroupInfo(group)
+	if err != nil {
+		return GroupHandle{}, err
+	}
+	return c.createGroupWithEntities(GroupEmpty, newName, info.EntityList)
+}
+
+// EnsureGroup returns the group with the specified name, creating it if there is none, after
+// adding the listed entities that it is missing and removing its members that are not listed. If
+// several groups have the name, the first one listed by GetAllGroupIDs is used.
+func EnsureGroup(groupName string, entities []Entity) (GroupHandle, error) {
+	return defaultClient.EnsureGroup(groupName, entities)
+}
+
+// EnsureGroup returns the group with the specified name, creating it if there is none, after
+// adding the listed entities that it is missing and removing its members that are not listed. If
+// several groups have the name, the first one listed by GetAllGroupIDs is used.
+func (c *Client) EnsureGroup(groupName string, entities []Entity) (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
+
+	group, info, err := c.findGroup(groupName)
+	if err != nil {
+		return GroupHandle{}, err
+	}
+	if info == nil {
+		if group, err = c.GroupCreate(GroupEmpty, groupName); err != nil {
+			return GroupHandle{}, err
+		}
+		info = &GroupInfo{GroupName: groupName}
+	}
+
+	missing, extra := info.Diff(entities)
+	for _, entity := range missing {
+		if err := c.GroupAddEntity(group, entity.pair()); err != nil {
+			return GroupHandle{}, err
+		}
+	}
+	for _, entity := range extra {
+		if err := c.GroupRemoveEntity(group, entity.pair()); err != nil {
+			return GroupHandle{}, err
+		}
+	}
+	return group, nil
+}
+
+// createGroupWithEntities creates a group of the specified type and name and adds the entities
+// to it, destroying the group if any of them cannot be added
+func (c *Client) createGroupWithEntities(groupType GroupType, groupName string, entities []GroupEntityPair) (GroupHandle, error) {
+	group, err := c.GroupCreate(groupType, groupName)
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	for _, entity := range entities {
+		if err = c.GroupAddEntity(group, entity); err != nil {
+			_ = c.GroupDestroy(group)
+			return GroupHandle{}, err
+		}
+	}
+	return group, nil
+}
+
+// findGroup returns the first group listed by GetAllGroupIDs with the specified name and its
+// info, or a nil info if there is none
+func (c *Client) findGroup(groupName string) (GroupHandle, *GroupInfo, error) {
+	groups, err := c.GetAllGroupIDs()
+	if err != nil {
+		return GroupHandle{}, nil, err
+	}
+
+	for _, group := range groups {
+		// groups destroyed by other clients since they were listed are skipped
+		info, err := c.GetGroupInfo(group)
+		if err == nil && info.GroupName == groupName {
+			return group, info, nil
+		}
+	}
+	return GroupHandle{}, nil, nil
+}
+
 // CreateGroupWithContext creates a new group with a context
 func CreateGroupWithContext(ctx context.Context, groupName string) (GroupHandle, error) {
+	return defaultClient.CreateGroupWithContext(ctx, groupName)
+}
+
+// CreateGroupWithContext creates a new group with a context
+func (c *Client) CreateGroupWithContext(ctx context.Context, groupName string) (GroupHandle, error) {
 	select {
 	case <-ctx.Done():
 		return GroupHandle{}, ctx.Err()
 	default:
-		return CreateGroup(groupName)
+		return c.CreateGroup(groupName)
 	}
 }
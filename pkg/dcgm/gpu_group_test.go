@@ -19,6 +19,7 @@ package dcgm
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,218 @@ func TestGroupHandle(t *testing.T) {
 	}
 }
 
+func TestDefaultGroupHandles(t *testing.T) {
+	for handle, group := range map[uintptr]GroupHandle{
+		0x7fffffff: GroupAllGPUs(),
+		0x7ffffffe: GroupAllNvSwitches(),
+		0x7ffffffd: GroupAllInstances(),
+		0x7ffffffc: GroupAllComputeInstances(),
+		0x7ffffffb: GroupAllEntities(),
+	} {
+		assert.Equal(t, handle, group.GetHandle())
+	}
+}
+
+func TestGroupType(t *testing.T) {
+	assert.Equal(t, "Default NvSwitches", GroupDefaultNvSwitches.String())
+	assert.Equal(t, "Unknown(42)", GroupType(42).String())
+
+	_, err := (&Client{closing: true}).GroupCreate(GroupEmpty, "test")
+	assert.Error(t, err)
+	err = (&Client{closing: true}).GroupRemoveEntity(GroupHandle{}, GroupEntityPair{EntityGroupId: FE_SWITCH})
+	assert.Error(t, err)
+}
+
+func TestGroupInfoDiff(t *testing.T) {
+	info := GroupInfo{GroupName: "test", EntityList: []GroupEntityPair{
+		{EntityGroupId: FE_GPU, EntityId: 0},
+		{EntityGroupId: FE_SWITCH, EntityId: 2},
+		{EntityGroupId: FE_GPU_I, EntityId: 1},
+	}}
+	assert.Equal(t, []Entity{{Group: FE_GPU, ID: 0}, {Group: FE_SWITCH, ID: 2}, {Group: FE_GPU_I, ID: 1}}, info.Entities())
+
+	missing, extra := info.Diff([]Entity{{Group: FE_GPU, ID: 0}, {Group: FE_GPU, ID: 1}, {Group: FE_GPU, ID: 1}, {Group: FE_GPU_I, ID: 1}})
+	assert.Equal(t, []Entity{{Group: FE_GPU, ID: 1}}, missing)
+	assert.Equal(t, []Entity{{Group: FE_SWITCH, ID: 2}}, extra)
+
+	missing, extra = info.Diff(info.Entities())
+	assert.Empty(t, missing)
+	assert.Empty(t, extra)
+}
+
+func TestGroupCreate(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+
+	groupID, err := GroupCreate(GroupDefault, "testDefault")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, GroupDestroy(groupID))
+	}()
+
+	grInfo, err := GetGroupInfo(groupID)
+	require.NoError(t, err)
+	assert.Equal(t, "testDefault", grInfo.GroupName)
+	assert.Len(t, grInfo.EntityList, len(gpus))
+
+	emptyID, err := GroupCreate(GroupEmpty, "testEmpty")
+	require.NoError(t, err)
+	grInfo, err = GetGroupInfo(emptyID)
+	require.NoError(t, err)
+	assert.Empty(t, grInfo.EntityList)
+	require.NoError(t, GroupDestroy(emptyID))
+}
+
+func TestGroupAddRemoveEntity(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+	gpus, err := withInjectionGPUs(t, 2)
+	require.NoError(t, err)
+
+	groupID, err := GroupCreate(GroupEmpty, "testEntities")
+	require.NoError(t, err)
+	defer func() {
+		_ = GroupDestroy(groupID)
+	}()
+
+	for _, gpuID := range gpus {
+		require.NoError(t, GroupAddEntity(groupID, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID}))
+	}
+	require.NoError(t, GroupRemoveEntity(groupID, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpus[0]}))
+
+	grInfo, err := GetGroupInfo(groupID)
+	require.NoError(t, err)
+	require.Len(t, grInfo.EntityList, 1)
+	assert.Equal(t, gpus[1], grInfo.EntityList[0].EntityId)
+
+	err = GroupRemoveEntity(groupID, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpus[0]})
+	assert.Error(t, err)
+}
+
+func TestGetAllGroupIDs(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	groupID, err := CreateGroup("testAllIDs")
+	require.NoError(t, err)
+	defer func() {
+		_ = DestroyGroup(groupID)
+	}()
+
+	groups, err := GetAllGroupIDs()
+	require.NoError(t, err)
+	assert.Contains(t, groups, groupID)
+
+	_, err = (&Client{closing: true}).GetAllGroupIDs()
+	assert.Error(t, err)
+}
+
+func TestEnsureGroup(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+	gpus, err := withInjectionGPUs(t, 2)
+	require.NoError(t, err)
+
+	first := Entity{Group: FE_GPU, ID: gpus[0]}
+	second := Entity{Group: FE_GPU, ID: gpus[1]}
+
+	groupID, err := EnsureGroup("testEnsure", []Entity{first})
+	require.NoError(t, err)
+	defer func() {
+		_ = DestroyGroup(groupID)
+	}()
+
+	again, err := EnsureGroup("testEnsure", []Entity{second})
+	require.NoError(t, err)
+	assert.Equal(t, groupID, again)
+
+	grInfo, err := GetGroupInfo(groupID)
+	require.NoError(t, err)
+	assert.Equal(t, []Entity{second}, grInfo.Entities())
+}
+
+func TestManagedGroup(t *testing.T) {
+	c := &Client{closing: true}
+	g := &ManagedGroup{client: c, name: "testManaged"}
+	g.Group.SetHandle(3)
+	c.managedGroups = map[*ManagedGroup]struct{}{g: {}}
+
+	var destroyed []GroupHandle
+	c.closeManagedGroups(func(group GroupHandle) error {
+		destroyed = append(destroyed, group)
+		return nil
+	})
+	assert.Equal(t, []GroupHandle{g.Group}, destroyed)
+	assert.Empty(t, c.managedGroups)
+	require.NoError(t, g.Close())
+
+	_, err := c.NewManagedGroup(GroupEmpty, "testManaged", true)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func TestManagedGroupClose(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	group, err := NewManagedGroup(GroupEmpty, "testManaged", true)
+	require.NoError(t, err)
+	_, err = GetGroupInfo(group.Group)
+	require.NoError(t, err)
+
+	require.NoError(t, group.Close())
+	require.NoError(t, group.Close())
+	_, err = GetGroupInfo(group.Group)
+	require.Error(t, err)
+}
+
+func TestGroupClone(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := GetSupportedDevices()
+	require.NoError(t, err)
+
+	group, err := GroupCreate(GroupDefault, "testCloneSource")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, GroupDestroy(group))
+	}()
+
+	clone, err := GroupClone(group, "testClone")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, GroupDestroy(clone))
+	}()
+
+	info, err := GetGroupInfo(clone)
+	require.NoError(t, err)
+	assert.Equal(t, "testClone", info.GroupName)
+	assert.Len(t, info.EntityList, len(gpus))
+
+	require.NoError(t, GroupRemoveEntity(clone, info.EntityList[0]))
+	source, err := GetGroupInfo(group)
+	require.NoError(t, err)
+	assert.Len(t, source.EntityList, len(gpus))
+
+	_, err = (&Client{closing: true}).GroupClone(group, "testClone")
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
 func TestGetGroupInfo(t *testing.T) {
 	teardownTest := setupTest(t)
 	defer teardownTest(t)
@@ -94,3 +307,58 @@ func TestCreateGroupWithContext(t *testing.T) {
 		require.Zero(t, group.GetHandle())
 	})
 }
+
+func TestGetExcludedDevices(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	gpus, err := withInjectionGPUs(t, 1)
+	require.NoError(t, err)
+
+	status, err := GetGpuStatus(gpus[0])
+	require.NoError(t, err)
+	assert.Equal(t, EntityStatusFake, status)
+
+	excluded, err := GetExcludedDevices()
+	require.NoError(t, err)
+	for _, device := range excluded {
+		assert.NotEqual(t, gpus[0], device.GPU)
+	}
+}
+
+func TestDiffGroupMembership(t *testing.T) {
+	gpu := func(id uint) GroupEntityPair { return GroupEntityPair{EntityGroupId: FE_GPU, EntityId: id} }
+	before := &GroupInfo{GroupName: "watched", EntityList: []GroupEntityPair{gpu(0), gpu(1)}}
+	after := &GroupInfo{GroupName: "watched", EntityList: []GroupEntityPair{gpu(1), gpu(2)}}
+
+	change, changed := diffGroupMembership(before, after)
+	assert.True(t, changed)
+	assert.True(t, change.Exists)
+	assert.Equal(t, []Entity{{Group: FE_GPU, ID: 2}}, change.Added)
+	assert.Equal(t, []Entity{{Group: FE_GPU, ID: 0}}, change.Removed)
+
+	_, changed = diffGroupMembership(after, after)
+	assert.False(t, changed)
+
+	change, changed = diffGroupMembership(after, nil)
+	assert.True(t, changed)
+	assert.False(t, change.Exists)
+	assert.Empty(t, change.Added)
+	assert.Len(t, change.Removed, 2)
+
+	change, changed = diffGroupMembership(nil, &GroupInfo{GroupName: "watched"})
+	assert.True(t, changed)
+	assert.True(t, change.Exists)
+	assert.Empty(t, change.Added)
+
+	_, changed = diffGroupMembership(nil, nil)
+	assert.False(t, changed)
+}
+
+func TestWatchGroupMembership(t *testing.T) {
+	_, err := (&Client{}).WatchGroupMembership(context.Background(), "watched", 0)
+	require.Error(t, err)
+
+	_, err = (&Client{closing: true}).WatchGroupMembership(context.Background(), "watched", time.Second)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,140 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"time"
+	"unsafe"
+)
+
+// xidFallenOffBus is the XID the driver reports when a GPU stops responding on the PCIe bus
+const xidFallenOffBus = 79
+
+// lostXidWindow is how long an XID 79 marks a GPU as lost. The last XID stays cached in the
+// hostengine after the GPU has been recovered, so older reports are ignored.
+const lostXidWindow = 10 * time.Minute
+
+// GpuState is the reachability of a GPU
+type GpuState int
+
+const (
+	// GpuStateUnknown means the state of the GPU cannot be determined, e.g. because DCGM does not
+	// monitor it
+	GpuStateUnknown GpuState = iota
+	// GpuStateHealthy means the GPU is reachable
+	GpuStateHealthy
+	// GpuStateLost means the GPU has fallen off the bus and needs a reset or reboot
+	GpuStateLost
+)
+
+func (s GpuState) String() string {
+	switch s {
+	case GpuStateHealthy:
+		return "Healthy"
+	case GpuStateLost:
+		return "Lost"
+	}
+	return "Unknown"
+}
+
+// GpuStateInfo is the reachability of a GPU and the evidence it is based on
+type GpuStateInfo struct {
+	GPU    uint
+	State  GpuState
+	Status EntityStatus
+	// LastXID is the last XID reported for the GPU and LastXIDTime when it was reported, both
+	// zero if there is none
+	LastXID     int64
+	LastXIDTime time.Time
+}
+
+// GetGpuStates classifies every GPU as healthy, lost or unknown. A GPU is lost if DCGM reports it
+// as lost, if its attributes can no longer be read, or if it reported XID 79 (fallen off the bus)
+// within the last 10 minutes.
+func GetGpuStates() ([]GpuStateInfo, error) {
+	return defaultClient.GetGpuStates()
+}
+
+// GetGpuStates classifies every GPU as healthy, lost or unknown. A GPU is lost if DCGM reports it
+// as lost, if its attributes can no longer be read, or if it reported XID 79 (fallen off the bus)
+// within the last 10 minutes.
+func (c *Client) GetGpuStates() ([]GpuStateInfo, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	gpus, err := c.getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]GpuStateInfo, len(gpus))
+	var reachable []GroupEntityPair
+	for i, gpu := range gpus {
+		states[i] = GpuStateInfo{GPU: gpu}
+		if states[i].Status, err = c.getGpuStatus(gpu); err != nil {
+			return nil, err
+		}
+		if states[i].Status != EntityStatusOk && states[i].Status != EntityStatusFake {
+			continue
+		}
+
+		var device C.dcgmDeviceAttributes_t
+		device.version = makeVersion3(unsafe.Sizeof(device))
+		if C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpu), &device) == C.DCGM_ST_GPU_IS_LOST {
+			states[i].Status = EntityStatusLost
+			continue
+		}
+
+		reachable = append(reachable, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu})
+	}
+
+	if len(reachable) > 0 {
+		values, err := c.sampleEntityFields("gpuStates", reachable, []Short{DCGM_FI_DEV_XID_ERRORS})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range values {
+			xid := value.Int64()
+			if value.Status != C.DCGM_ST_OK || xid <= 0 || xid >= DCGM_FT_INT64_BLANK {
+				continue
+			}
+			for i := range states {
+				if states[i].GPU == value.EntityID {
+					states[i].LastXID = xid
+					states[i].LastXIDTime = timestampUSECToTime(value.TS)
+				}
+			}
+		}
+	}
+
+	now := time.Now()
+	for i := range states {
+		states[i].State = classifyGpuState(states[i], now)
+	}
+
+	return states, nil
+}
+
+// classifyGpuState derives the state of a GPU from its DCGM status and last XID
+func classifyGpuState(info GpuStateInfo, now time.Time) GpuState {
+	switch info.Status {
+	case EntityStatusLost:
+		return GpuStateLost
+	case EntityStatusOk, EntityStatusFake:
+	default:
+		return GpuStateUnknown
+	}
+
+	if info.LastXID == xidFallenOffBus && now.Sub(info.LastXIDTime) < lostXidWindow {
+		return GpuStateLost
+	}
+
+	return GpuStateHealthy
+}
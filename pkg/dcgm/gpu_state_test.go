@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyGpuState(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		info GpuStateInfo
+		want GpuState
+	}{
+		{"ok", GpuStateInfo{Status: EntityStatusOk}, GpuStateHealthy},
+		{"lost status", GpuStateInfo{Status: EntityStatusLost}, GpuStateLost},
+		{"recent xid 79", GpuStateInfo{Status: EntityStatusOk, LastXID: 79, LastXIDTime: now.Add(-time.Minute)}, GpuStateLost},
+		{"old xid 79", GpuStateInfo{Status: EntityStatusOk, LastXID: 79, LastXIDTime: now.Add(-time.Hour)}, GpuStateHealthy},
+		{"other xid", GpuStateInfo{Status: EntityStatusOk, LastXID: 13, LastXIDTime: now}, GpuStateHealthy},
+		{"inaccessible", GpuStateInfo{Status: EntityStatusInaccessible}, GpuStateUnknown},
+		{"unsupported", GpuStateInfo{Status: EntityStatusUnsupported}, GpuStateUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, classifyGpuState(tt.info, now))
+		})
+	}
+}
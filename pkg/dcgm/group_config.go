@@ -0,0 +1,249 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MonitoringConfig is a document of group and field group definitions, so that monitoring
+// configuration can be kept in version control and applied at startup. It is encoded with
+// encoding/json or gopkg.in/yaml.v3, and read back with ParseMonitoringConfig.
+type MonitoringConfig struct {
+	Groups      []GroupDefinition      `json:"groups,omitempty" yaml:"groups,omitempty"`
+	FieldGroups []FieldGroupDefinition `json:"fieldGroups,omitempty" yaml:"fieldGroups,omitempty"`
+}
+
+// GroupDefinition is a group of a MonitoringConfig
+type GroupDefinition struct {
+	Name     string             `json:"name" yaml:"name"`
+	Entities []EntityDefinition `json:"entities,omitempty" yaml:"entities,omitempty"`
+}
+
+// EntityDefinition is a member of a GroupDefinition. Type is the name of its Field_Entity_Group
+// constant, e.g. "FE_GPU", "FE_SWITCH" or "FE_CPU".
+type EntityDefinition struct {
+	Type string `json:"type" yaml:"type"`
+	ID   uint   `json:"id" yaml:"id"`
+}
+
+// FieldGroupDefinition is a field group of a MonitoringConfig. Fields are the names of the field
+// constants, e.g. "DCGM_FI_DEV_GPU_TEMP", or the IDs of fields this package does not know.
+type FieldGroupDefinition struct {
+	Name   string   `json:"name" yaml:"name"`
+	Fields []string `json:"fields" yaml:"fields"`
+}
+
+var entityGroupNames = map[Field_Entity_Group]string{
+	FE_GPU:      "FE_GPU",
+	FE_VGPU:     "FE_VGPU",
+	FE_SWITCH:   "FE_SWITCH",
+	FE_GPU_I:    "FE_GPU_I",
+	FE_GPU_CI:   "FE_GPU_CI",
+	FE_LINK:     "FE_LINK",
+	FE_CPU:      "FE_CPU",
+	FE_CPU_CORE: "FE_CPU_CORE",
+}
+
+// ParseMonitoringConfig reads a MonitoringConfig from a JSON or YAML document and checks that its
+// entity types and fields are known
+func ParseMonitoringConfig(data []byte) (MonitoringConfig, error) {
+	var config MonitoringConfig
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		return MonitoringConfig{}, fmt.Errorf("error parsing monitoring config: %w", err)
+	}
+
+	for _, group := range config.Groups {
+		if _, err := group.entities(); err != nil {
+			return MonitoringConfig{}, err
+		}
+	}
+	for _, fieldGroup := range config.FieldGroups {
+		if _, err := fieldGroup.fields(); err != nil {
+			return MonitoringConfig{}, err
+		}
+	}
+	return config, nil
+}
+
+func (d GroupDefinition) entities() ([]Entity, error) {
+	entities := make([]Entity, len(d.Entities))
+	for i, entity := range d.Entities {
+		group := FE_NONE
+		for g, name := range entityGroupNames {
+			if name == entity.Type {
+				group = g
+			}
+		}
+		if group == FE_NONE {
+			return nil, fmt.Errorf("group %q: unknown entity type %q", d.Name, entity.Type)
+		}
+		entities[i] = Entity{Group: group, ID: entity.ID}
+	}
+	return entities, nil
+}
+
+func (d FieldGroupDefinition) fields() ([]Short, error) {
+	fields := make([]Short, len(d.Fields))
+	for i, name := range d.Fields {
+		if id, ok := GetFieldID(name); ok {
+			fields[i] = id
+		} else if id, err := strconv.ParseUint(name, 10, 16); err == nil {
+			fields[i] = Short(id)
+		} else {
+			return nil, fmt.Errorf("field group %q: unknown field %q", d.Name, name)
+		}
+	}
+	return fields, nil
+}
+
+// entityDefinition returns the definition of a group member, which fails for the entity types
+// that cannot be read back
+func entityDefinition(entity GroupEntityPair) (EntityDefinition, error) {
+	name, ok := entityGroupNames[entity.EntityGroupId]
+	if !ok {
+		return EntityDefinition{}, fmt.Errorf("unknown entity type %d", entity.EntityGroupId)
+	}
+	return EntityDefinition{Type: name, ID: entity.EntityId}, nil
+}
+
+// fieldName returns the name of the constant of a field, or its ID if this package does not know it
+func fieldName(field Short) string {
+	if meta, ok := GetMetricMeta(field); ok {
+		return "DCGM_FI_" + strings.ToUpper(strings.TrimPrefix(meta.Name, "dcgm_"))
+	}
+	return strconv.Itoa(int(field))
+}
+
+// ExportMonitoringConfig returns the definitions of the groups and field groups. It fails if a
+// group holds entities of a type that has no Field_Entity_Group name.
+func ExportMonitoringConfig(groups []GroupHandle, fieldGroups []FieldHandle) (MonitoringConfig, error) {
+	return defaultClient.ExportMonitoringConfig(groups, fieldGroups)
+}
+
+// ExportMonitoringConfig returns the definitions of the groups and field groups. It fails if a
+// group holds entities of a type that has no Field_Entity_Group name.
+func (c *Client) ExportMonitoringConfig(groups []GroupHandle, fieldGroups []FieldHandle) (MonitoringConfig, error) {
+	if err := c.beginCall(); err != nil {
+		return MonitoringConfig{}, err
+	}
+	defer c.endCall()
+
+	var config MonitoringConfig
+	for _, group := range groups {
+		info, err := c.GetGroupInfo(group)
+		if err != nil {
+			return MonitoringConfig{}, err
+		}
+		definition := GroupDefinition{Name: info.GroupName, Entities: make([]EntityDefinition, len(info.EntityList))}
+		for i, entity := range info.EntityList {
+			if definition.Entities[i], err = entityDefinition(entity); err != nil {
+				return MonitoringConfig{}, fmt.Errorf("group %q: %w", info.GroupName, err)
+			}
+		}
+		config.Groups = append(config.Groups, definition)
+	}
+
+	for _, fieldGroup := range fieldGroups {
+		info, err := c.FieldGroupGetInfo(fieldGroup)
+		if err != nil {
+			return MonitoringConfig{}, err
+		}
+		definition := FieldGroupDefinition{Name: info.Name, Fields: make([]string, len(info.Fields))}
+		for i, field := range info.Fields {
+			definition.Fields[i] = fieldName(field)
+		}
+		config.FieldGroups = append(config.FieldGroups, definition)
+	}
+	return config, nil
+}
+
+// ApplyMonitoringConfig creates the groups and field groups of the configuration and returns their
+// handles by name. It can be applied again at every startup: groups that exist are reconciled like
+// EnsureGroup does, field groups that exist with the same fields are reused and field groups that
+// exist with other fields are destroyed and created again.
+func ApplyMonitoringConfig(config MonitoringConfig) (map[string]GroupHandle, map[string]FieldHandle, error) {
+	return defaultClient.ApplyMonitoringConfig(config)
+}
+
+// ApplyMonitoringConfig creates the groups and field groups of the configuration and returns their
+// handles by name. It can be applied again at every startup: groups that exist are reconciled like
+// EnsureGroup does, field groups that exist with the same fields are reused and field groups that
+// exist with other fields are destroyed and created again.
+func (c *Client) ApplyMonitoringConfig(config MonitoringConfig) (map[string]GroupHandle, map[string]FieldHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, nil, err
+	}
+	defer c.endCall()
+
+	groups := make(map[string]GroupHandle, len(config.Groups))
+	for _, definition := range config.Groups {
+		entities, err := definition.entities()
+		if err != nil {
+			return nil, nil, err
+		}
+		if groups[definition.Name], err = c.EnsureGroup(definition.Name, entities); err != nil {
+			return nil, nil, fmt.Errorf("error applying group %q: %w", definition.Name, err)
+		}
+	}
+
+	fieldGroups := make(map[string]FieldHandle, len(config.FieldGroups))
+	for _, definition := range config.FieldGroups {
+		fields, err := definition.fields()
+		if err != nil {
+			return nil, nil, err
+		}
+		if fieldGroups[definition.Name], err = c.ensureFieldGroup(definition.Name, fields); err != nil {
+			return nil, nil, fmt.Errorf("error applying field group %q: %w", definition.Name, err)
+		}
+	}
+	return groups, fieldGroups, nil
+}
+
+// ensureFieldGroup returns the field group with the specified name if it holds the fields,
+// replacing it with a new one otherwise
+func (c *Client) ensureFieldGroup(name string, fields []Short) (FieldHandle, error) {
+	var all C.dcgmAllFieldGroup_v1
+	all.version = makeVersion1(unsafe.Sizeof(all))
+
+	result := C.dcgmFieldGroupGetAll(c.dcgmHandle(), &all)
+	if err := errorString(result); err != nil {
+		return FieldHandle{}, fmt.Errorf("error getting field groups: %s", err)
+	}
+
+	for i := 0; i < int(all.numFieldGroups) && i < len(all.fieldGroups); i++ {
+		info := &all.fieldGroups[i]
+		if C.GoString(&info.fieldGroupName[0]) != name {
+			continue
+		}
+
+		existing := make([]Short, min(int(info.numFieldIds), len(info.fieldIds)))
+		for j := range existing {
+			existing[j] = Short(info.fieldIds[j])
+		}
+		fieldGroup := c.callerFieldGroup(info.fieldGroupId)
+		if slices.Equal(existing, fields) {
+			return fieldGroup, nil
+		}
+		if err := c.FieldGroupDestroy(fieldGroup); err != nil {
+			return FieldHandle{}, err
+		}
+		break
+	}
+
+	return c.FieldGroupCreate(name, fields)
+}
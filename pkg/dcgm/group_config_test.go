@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMonitoringConfig(t *testing.T) {
+	config, err := ParseMonitoringConfig([]byte(`
+groups:
+  - name: training
+    entities:
+      - {type: FE_GPU, id: 0}
+      - {type: FE_SWITCH, id: 1}
+fieldGroups:
+  - name: thermals
+    fields: [DCGM_FI_DEV_GPU_TEMP, dcgm_power_usage, "1234"]
+`))
+	require.NoError(t, err)
+	require.Len(t, config.Groups, 1)
+	entities, err := config.Groups[0].entities()
+	require.NoError(t, err)
+	assert.Equal(t, []Entity{{Group: FE_GPU, ID: 0}, {Group: FE_SWITCH, ID: 1}}, entities)
+	fields, err := config.FieldGroups[0].fields()
+	require.NoError(t, err)
+	assert.Equal(t, []Short{DCGM_FI_DEV_GPU_TEMP, DCGM_FI_DEV_POWER_USAGE, 1234}, fields)
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	fromJSON, err := ParseMonitoringConfig(data)
+	require.NoError(t, err)
+	assert.Equal(t, config, fromJSON)
+
+	assert.Equal(t, "DCGM_FI_DEV_GPU_TEMP", fieldName(DCGM_FI_DEV_GPU_TEMP))
+	assert.Equal(t, "DCGM_FI_DEV_CPU_TEMP_CRITICAL", fieldName(DCGM_FI_DEV_CPU_TEMP_SHUTDOWN))
+	assert.Equal(t, "65000", fieldName(65000))
+
+	_, err = ParseMonitoringConfig([]byte(`groups: [{name: bad, entities: [{type: FE_DISK, id: 0}]}]`))
+	require.ErrorContains(t, err, "FE_DISK")
+	_, err = ParseMonitoringConfig([]byte(`fieldGroups: [{name: bad, fields: [DCGM_FI_NOPE]}]`))
+	require.ErrorContains(t, err, "DCGM_FI_NOPE")
+	_, err = ParseMonitoringConfig([]byte(`group: []`))
+	require.Error(t, err)
+
+	_, _, err = (&Client{closing: true}).ApplyMonitoringConfig(config)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func TestFieldNameRoundTrip(t *testing.T) {
+	for _, meta := range MetricMetas() {
+		name := fieldName(meta.FieldID)
+		fieldID, ok := GetFieldID(name)
+		if assert.True(t, ok, "%s of field %d is not a field name", name, meta.FieldID) {
+			assert.Equal(t, meta.FieldID, fieldID, name)
+		}
+	}
+}
+
+func TestEntityDefinitionUnknownGroup(t *testing.T) {
+	definition, err := entityDefinition(GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, EntityDefinition{Type: "FE_SWITCH", ID: 1}, definition)
+
+	_, err = entityDefinition(GroupEntityPair{EntityGroupId: FE_COUNT, EntityId: 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+#include "field_values_cb.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"runtime/cgo"
+)
+
+// LatestValues returns the latest values of the fields of fieldGroup for every member of the
+// group, keyed by entity and field, in one call. The group can hold entities of any type. The
+// fields must be watched on the group.
+func (g GroupHandle) LatestValues(fieldGroup FieldHandle) (EntityValues, error) {
+	return defaultClient.GroupLatestValues(g, fieldGroup)
+}
+
+// GroupLatestValues returns the latest values of the fields of fieldGroup for every member of the
+// group, keyed by entity and field, in one call. The group can hold entities of any type. The
+// fields must be watched on the group.
+func GroupLatestValues(group GroupHandle, fieldGroup FieldHandle) (EntityValues, error) {
+	return defaultClient.GroupLatestValues(group, fieldGroup)
+}
+
+// GroupLatestValues returns the latest values of the fields of fieldGroup for every member of the
+// group, keyed by entity and field, in one call. The group can hold entities of any type. The
+// fields must be watched on the group.
+func (c *Client) GroupLatestValues(group GroupHandle, fieldGroup FieldHandle) (EntityValues, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	cbResult := &callback{}
+	handle := cgo.NewHandle(cbResult)
+	defer handle.Delete()
+	result := C.dcgmGetLatestValues_v2(c.dcgmHandle(),
+		c.groupHandle(group),
+		c.fieldGroupHandle(fieldGroup),
+		C.dcgmFieldValueEnumeration_f(C.fieldValueEntityCallback),
+		C.callbackUserData(C.uintptr_t(handle)))
+	if err := errorString(result); err != nil {
+		return nil, fmt.Errorf("error getting latest values of group: %s", err)
+	}
+
+	return toEntityValues(cbResult.Values), nil
+}
@@ -0,0 +1,109 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// GroupMembershipChange reports a change of the members of a named group
+type GroupMembershipChange struct {
+	// Group is the handle of the group, zero if it does not exist
+	Group GroupHandle
+	// Exists is false once no group has the name, for instance after it was destroyed
+	Exists bool
+	// Added and Removed are the entities that joined and left the group, in the order they are
+	// listed. When the group is destroyed, all of its members are reported removed; when it is
+	// destroyed and created again between polls, the change is reported with the new handle and
+	// the members of both groups are compared.
+	Added, Removed []Entity
+}
+
+// WatchGroupMembership polls the group with the specified name every interval and reports the
+// changes of its members on the returned channel, such as those made by other clients. Groups
+// are looked up by name like EnsureGroup does, so the group may not exist yet when the watch
+// starts, and the creation and destruction of the group are reported too. The membership when
+// WatchGroupMembership is called is not reported. The channel is closed once ctx is done or the
+// client is closed.
+func WatchGroupMembership(ctx context.Context, groupName string, interval time.Duration) (<-chan GroupMembershipChange, error) {
+	return defaultClient.WatchGroupMembership(ctx, groupName, interval)
+}
+
+// WatchGroupMembership polls the group with the specified name every interval and reports the
+// changes of its members on the returned channel, such as those made by other clients. Groups
+// are looked up by name like EnsureGroup does, so the group may not exist yet when the watch
+// starts, and the creation and destruction of the group are reported too. The membership when
+// WatchGroupMembership is called is not reported. The channel is closed once ctx is done or the
+// client is closed.
+func (c *Client) WatchGroupMembership(ctx context.Context, groupName string, interval time.Duration) (<-chan GroupMembershipChange, error) {
+	if interval <= 0 {
+		return nil, errors.New("group watch interval must be positive")
+	}
+
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	group, info, err := c.findGroup(groupName)
+	c.endCall()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(chan GroupMembershipChange, 16)
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := c.beginCall(); err != nil {
+				return
+			}
+			currentGroup, current, err := c.findGroup(groupName)
+			c.endCall()
+			if err != nil {
+				// keep the last known members and try again on the next tick
+				continue
+			}
+
+			change, changed := diffGroupMembership(info, current)
+			if changed || currentGroup != group {
+				change.Group = currentGroup
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+			group, info = currentGroup, current
+		}
+	}()
+
+	return changes, nil
+}
+
+// diffGroupMembership returns the change from the prev to the cur members of a group, either of
+// which is nil if the group did not exist, and whether there is any
+func diffGroupMembership(prev, cur *GroupInfo) (GroupMembershipChange, bool) {
+	change := GroupMembershipChange{Exists: cur != nil}
+
+	var before []Entity
+	if prev != nil {
+		before = prev.Entities()
+	}
+	after := GroupInfo{}
+	if cur != nil {
+		after = *cur
+	}
+	change.Removed, change.Added = after.Diff(before)
+
+	changed := (prev != nil) != (cur != nil) || len(change.Added) > 0 || len(change.Removed) > 0
+	return change, changed
+}
@@ -24,7 +24,7 @@ import "C"
 
 import (
 	"fmt"
-	"math/rand"
+	"strings"
 	"unsafe"
 )
 
@@ -48,10 +48,115 @@ type DeviceHealth struct {
 	Watches []SystemWatch
 }
 
+// healthSystemNames are the names of the health watch systems, as used by String and
+// ParseHealthSystems
+var healthSystemNames = []struct {
+	system HealthSystem
+	name   string
+}{
+	{DCGM_HEALTH_WATCH_PCIE, "PCIe"},
+	{DCGM_HEALTH_WATCH_NVLINK, "NVLink"},
+	{DCGM_HEALTH_WATCH_PMU, "PMU"},
+	{DCGM_HEALTH_WATCH_MCU, "MCU"},
+	{DCGM_HEALTH_WATCH_MEM, "Memory"},
+	{DCGM_HEALTH_WATCH_SM, "SM"},
+	{DCGM_HEALTH_WATCH_INFOROM, "InfoROM"},
+	{DCGM_HEALTH_WATCH_THERMAL, "Thermal"},
+	{DCGM_HEALTH_WATCH_POWER, "Power"},
+	{DCGM_HEALTH_WATCH_DRIVER, "Driver"},
+	{DCGM_HEALTH_WATCH_NVSWITCH_NONFATAL, "NvSwitchNonFatal"},
+	{DCGM_HEALTH_WATCH_NVSWITCH_FATAL, "NvSwitchFatal"},
+}
+
+// Has reports whether all of the systems are set
+func (s HealthSystem) Has(systems HealthSystem) bool {
+	return s&systems == systems
+}
+
+// Systems returns the individual systems that are set, in bit order
+func (s HealthSystem) Systems() []HealthSystem {
+	var systems []HealthSystem
+	for _, n := range healthSystemNames {
+		if s.Has(n.system) {
+			systems = append(systems, n.system)
+		}
+	}
+	return systems
+}
+
+// String returns the names of the systems joined by "|", e.g. "PCIe|NVLink", "All" for
+// DCGM_HEALTH_WATCH_ALL and "None" if no system is set
+func (s HealthSystem) String() string {
+	switch s {
+	case DCGM_HEALTH_WATCH_ALL:
+		return "All"
+	case 0:
+		return "None"
+	}
+
+	var names []string
+	rest := s
+	for _, n := range healthSystemNames {
+		if s.Has(n.system) {
+			names = append(names, n.name)
+			rest &^= n.system
+		}
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint(rest)))
+	}
+	return strings.Join(names, "|")
+}
+
+// ParseHealthSystems returns the systems named in a list separated by "," or "|", such as
+// "pcie,nvlink,mem" or the output of String. Names are case-insensitive; "mem" and "all" are
+// accepted too.
+func ParseHealthSystems(list string) (HealthSystem, error) {
+	var systems HealthSystem
+	for _, name := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == '|' }) {
+		name = strings.TrimSpace(name)
+		switch {
+		case strings.EqualFold(name, "all"):
+			systems |= DCGM_HEALTH_WATCH_ALL
+			continue
+		case strings.EqualFold(name, "none"):
+			continue
+		case strings.EqualFold(name, "mem"):
+			systems |= DCGM_HEALTH_WATCH_MEM
+			continue
+		}
+
+		found := false
+		for _, n := range healthSystemNames {
+			if strings.EqualFold(name, n.name) {
+				systems |= n.system
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown health watch system %q", name)
+		}
+	}
+	return systems, nil
+}
+
 // HealthSet enables the DCGM health check system for the given systems.
-// It configures which health watch systems should be monitored for the specified group.
+// It configures which health watch systems should be monitored for the specified group;
+// systems is a bitmask of DCGM_HEALTH_WATCH_* values, and the other systems are disabled.
 func HealthSet(groupID GroupHandle, systems HealthSystem) (err error) {
-	result := C.dcgmHealthSet(handle.handle, groupID.handle, C.dcgmHealthSystems_t(systems))
+	return defaultClient.HealthSet(groupID, systems)
+}
+
+// HealthSet enables the DCGM health check system for the given systems.
+// It configures which health watch systems should be monitored for the specified group;
+// systems is a bitmask of DCGM_HEALTH_WATCH_* values, and the other systems are disabled.
+func (c *Client) HealthSet(groupID GroupHandle, systems HealthSystem) (err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
+	result := C.dcgmHealthSet(c.dcgmHandle(), c.groupHandle(groupID), C.dcgmHealthSystems_t(systems))
 	if err := errorString(result); err != nil {
 		return fmt.Errorf("error setting health watches: %w", err)
 	}
@@ -61,9 +166,20 @@ func HealthSet(groupID GroupHandle, systems HealthSystem) (err error) {
 // HealthGet retrieves the current state of the DCGM health check system.
 // It returns which health watch systems are currently enabled for the specified group.
 func HealthGet(groupID GroupHandle) (HealthSystem, error) {
+	return defaultClient.HealthGet(groupID)
+}
+
+// HealthGet retrieves the current state of the DCGM health check system.
+// It returns which health watch systems are currently enabled for the specified group.
+func (c *Client) HealthGet(groupID GroupHandle) (HealthSystem, error) {
+	if err := c.beginCall(); err != nil {
+		return 0, err
+	}
+	defer c.endCall()
+
 	var systems C.dcgmHealthSystems_t
 
-	result := C.dcgmHealthGet(handle.handle, groupID.handle, (*C.dcgmHealthSystems_t)(unsafe.Pointer(&systems)))
+	result := C.dcgmHealthGet(c.dcgmHandle(), c.groupHandle(groupID), (*C.dcgmHealthSystems_t)(unsafe.Pointer(&systems)))
 	if err := errorString(result); err != nil {
 		return HealthSystem(0), err
 	}
@@ -103,10 +219,23 @@ type HealthResponse struct {
 // about all of the enabled watches within a group is created but no error results are
 // provided. On subsequent calls, any error information will be returned.
 func HealthCheck(groupID GroupHandle) (HealthResponse, error) {
+	return defaultClient.HealthCheck(groupID)
+}
+
+// HealthCheck checks the configured watches for any errors/failures/warnings that have occurred
+// since the last time this check was invoked. On the first call, stateful information
+// about all of the enabled watches within a group is created but no error results are
+// provided. On subsequent calls, any error information will be returned.
+func (c *Client) HealthCheck(groupID GroupHandle) (HealthResponse, error) {
+	if err := c.beginCall(); err != nil {
+		return HealthResponse{}, err
+	}
+	defer c.endCall()
+
 	var healthResults C.dcgmHealthResponse_v5
 	healthResults.version = makeVersion5(unsafe.Sizeof(healthResults))
 
-	result := C.dcgmHealthCheck(handle.handle, groupID.handle, (*C.dcgmHealthResponse_t)(unsafe.Pointer(&healthResults)))
+	result := C.dcgmHealthCheck(c.dcgmHandle(), c.groupHandle(groupID), (*C.dcgmHealthResponse_t)(unsafe.Pointer(&healthResults)))
 
 	if err := errorString(result); err != nil {
 		return HealthResponse{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -139,24 +268,8 @@ func HealthCheck(groupID GroupHandle) (HealthResponse, error) {
 	return response, nil
 }
 
-func healthCheckByGpuId(gpuID uint) (deviceHealth DeviceHealth, err error) {
-	name := fmt.Sprintf("health%d", rand.Uint64())
-	groupID, err := CreateGroup(name)
-	if err != nil {
-		return
-	}
-
-	err = AddToGroup(groupID, gpuID)
-	if err != nil {
-		return
-	}
-
-	err = HealthSet(groupID, DCGM_HEALTH_WATCH_ALL)
-	if err != nil {
-		return
-	}
-
-	result, err := HealthCheck(groupID)
+func (c *Client) healthCheckByGpuId(gpuID uint) (deviceHealth DeviceHealth, err error) {
+	result, err := c.healthCheckEntities(DCGM_HEALTH_WATCH_ALL, Entity{Group: FE_GPU, ID: gpuID})
 	if err != nil {
 		return
 	}
@@ -180,7 +293,6 @@ func healthCheckByGpuId(gpuID uint) (deviceHealth DeviceHealth, err error) {
 		Status:  status,
 		Watches: watches,
 	}
-	_ = DestroyGroup(groupID)
 	return
 }
 
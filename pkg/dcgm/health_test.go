@@ -222,3 +222,33 @@ func skipTestIfUnhealthy(t *testing.T, groupId GroupHandle) {
 		t.Skip(msg + strings.Join(incidents, ", "))
 	}
 }
+
+func TestHealthSystem(t *testing.T) {
+	systems := DCGM_HEALTH_WATCH_PCIE | DCGM_HEALTH_WATCH_NVLINK | DCGM_HEALTH_WATCH_MEM
+	assert.True(t, systems.Has(DCGM_HEALTH_WATCH_PCIE|DCGM_HEALTH_WATCH_MEM))
+	assert.False(t, systems.Has(DCGM_HEALTH_WATCH_THERMAL))
+	assert.Equal(t, []HealthSystem{DCGM_HEALTH_WATCH_PCIE, DCGM_HEALTH_WATCH_NVLINK, DCGM_HEALTH_WATCH_MEM}, systems.Systems())
+
+	assert.Equal(t, "PCIe|NVLink|Memory", systems.String())
+	assert.Equal(t, "All", DCGM_HEALTH_WATCH_ALL.String())
+	assert.Equal(t, "None", HealthSystem(0).String())
+	assert.Equal(t, "Driver|0x1000", (DCGM_HEALTH_WATCH_DRIVER | 0x1000).String())
+
+	parsed, err := ParseHealthSystems(systems.String())
+	require.NoError(t, err)
+	assert.Equal(t, systems, parsed)
+
+	parsed, err = ParseHealthSystems("pcie, mem,thermal")
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_HEALTH_WATCH_PCIE|DCGM_HEALTH_WATCH_MEM|DCGM_HEALTH_WATCH_THERMAL, parsed)
+
+	parsed, err = ParseHealthSystems("all")
+	require.NoError(t, err)
+	assert.Equal(t, DCGM_HEALTH_WATCH_ALL, parsed)
+
+	_, err = ParseHealthSystems("pcie,gpu")
+	require.ErrorContains(t, err, "gpu")
+
+	_, err = (&Client{closing: true}).HealthCheckEntitiesWithSystems(DCGM_HEALTH_WATCH_PCIE, Entity{Group: FE_GPU, ID: 0})
+	require.ErrorIs(t, err, ErrClientClosed)
+}
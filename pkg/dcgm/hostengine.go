@@ -0,0 +1,121 @@
+package dcgm
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log"
+	"net"
+	"strconv"
+)
+
+const (
+	defaultHostengineSocketDir   = "/tmp"
+	defaultHostengineBindAddress = "127.0.0.1"
+	defaultHostenginePort        = 5555
+	defaultHostengineLogPrefix   = "nv-hostengine: "
+)
+
+// Logger receives the output of a child nv-hostengine. *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// HostengineOptions configures the nv-hostengine started as a child process in StartHostengine mode
+type HostengineOptions struct {
+	// SocketDir is the directory in which the Unix domain socket is created. Defaults to /tmp.
+	SocketDir string
+	// TCP makes nv-hostengine listen on a TCP port instead of a Unix domain socket
+	TCP bool
+	// Port is the TCP port to listen on when TCP is set. Defaults to 5555.
+	Port int
+	// BindAddress is the address to listen on when TCP is set. Defaults to 127.0.0.1.
+	BindAddress string
+	// LogFile is the file nv-hostengine writes its log to
+	LogFile string
+	// LogLevel is the nv-hostengine log level: NONE, FATAL, ERROR, WARN, INFO, DEBUG or VERB
+	LogLevel string
+	// ExtraArgs are appended to the nv-hostengine command line
+	ExtraArgs []string
+	// Logger receives each line nv-hostengine writes to stdout or stderr. Defaults to the
+	// standard logger.
+	Logger Logger
+	// LogPrefix is prepended to each line passed to Logger. Defaults to "nv-hostengine: ".
+	LogPrefix string
+	// Supervisor, if set, keeps nv-hostengine in the foreground and watches it for exits,
+	// optionally restarting it and reconnecting
+	Supervisor *SupervisorOptions
+}
+
+// StartHostengineWithOptions starts nv-hostengine as a child process configured by opts
+// and returns a Client connected to it. The hostengine is terminated by Close.
+func StartHostengineWithOptions(opts HostengineOptions) (*Client, error) {
+	return StartHostengineWithOptionsContext(context.Background(), opts)
+}
+
+// StartHostengineWithOptionsContext is like StartHostengineWithOptions but gives up once ctx
+// is cancelled or its deadline expires.
+func StartHostengineWithOptionsContext(ctx context.Context, opts HostengineOptions) (*Client, error) {
+	c := &Client{mode: StartHostengine, hostengineOpts: opts}
+	return c.openContext(ctx)
+}
+
+// address returns the address the hostengine listens on in TCP mode
+func (opts HostengineOptions) address() string {
+	bindAddress := opts.BindAddress
+	if bindAddress == "" {
+		bindAddress = defaultHostengineBindAddress
+	}
+
+	port := opts.Port
+	if port == 0 {
+		port = defaultHostenginePort
+	}
+
+	return net.JoinHostPort(bindAddress, strconv.Itoa(port))
+}
+
+// args returns the nv-hostengine command line, listening on socketPath unless TCP is set
+func (opts HostengineOptions) args(bin, socketPath string) []string {
+	args := []string{bin}
+
+	if opts.TCP {
+		if opts.BindAddress != "" {
+			args = append(args, "--bind-interface", opts.BindAddress)
+		}
+		if opts.Port != 0 {
+			args = append(args, "--port", strconv.Itoa(opts.Port))
+		}
+	} else {
+		args = append(args, "--domain-socket", socketPath)
+	}
+
+	if opts.LogFile != "" {
+		args = append(args, "--log-filename", opts.LogFile)
+	}
+	if opts.LogLevel != "" {
+		args = append(args, "--log-level", opts.LogLevel)
+	}
+
+	return append(args, opts.ExtraArgs...)
+}
+
+// forwardOutput passes each line read from r to the configured logger until r is closed
+func (opts HostengineOptions) forwardOutput(r io.ReadCloser) {
+	defer r.Close()
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	prefix := opts.LogPrefix
+	if prefix == "" {
+		prefix = defaultHostengineLogPrefix
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		logger.Printf("%s%s", prefix, scanner.Text())
+	}
+}
@@ -8,6 +8,9 @@ package dcgm
 import "C"
 
 import (
+	"context"
+	"fmt"
+	"time"
 	"unsafe"
 )
 
@@ -19,11 +22,11 @@ type Status struct {
 	CPU float64
 }
 
-func introspect() (engine Status, err error) {
+func (c *Client) introspect() (engine Status, err error) {
 	var memory C.dcgmIntrospectMemory_t
 	memory.version = makeVersion1(unsafe.Sizeof(memory))
 	waitIfNoData := 1
-	result := C.dcgmIntrospectGetHostengineMemoryUsage(handle.handle, &memory, C.int(waitIfNoData))
+	result := C.dcgmIntrospectGetHostengineMemoryUsage(c.dcgmHandle(), &memory, C.int(waitIfNoData))
 
 	if err = errorString(result); err != nil {
 		return engine, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -32,7 +35,7 @@ func introspect() (engine Status, err error) {
 	var cpu C.dcgmIntrospectCpuUtil_t
 
 	cpu.version = makeVersion1(unsafe.Sizeof(cpu))
-	result = C.dcgmIntrospectGetHostengineCpuUtilization(handle.handle, &cpu, C.int(waitIfNoData))
+	result = C.dcgmIntrospectGetHostengineCpuUtilization(c.dcgmHandle(), &cpu, C.int(waitIfNoData))
 
 	if err = errorString(result); err != nil {
 		return engine, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -44,3 +47,74 @@ func introspect() (engine Status, err error) {
 	}
 	return
 }
+
+// HostengineHealth describes whether the DCGM hostengine considers itself healthy
+type HostengineHealth struct {
+	// OverallHealth is 0 if the hostengine is healthy, or a code describing the problem otherwise
+	OverallHealth uint
+}
+
+// Healthy reports whether the hostengine considers itself healthy
+func (h HostengineHealth) Healthy() bool {
+	return h.OverallHealth == 0
+}
+
+// HostengineIsHealthy asks the DCGM hostengine whether it considers itself healthy
+func HostengineIsHealthy() (HostengineHealth, error) {
+	return defaultClient.HostengineIsHealthy()
+}
+
+// HostengineIsHealthy asks the DCGM hostengine whether it considers itself healthy
+func (c *Client) HostengineIsHealthy() (HostengineHealth, error) {
+	if err := c.beginCall(); err != nil {
+		return HostengineHealth{}, err
+	}
+	defer c.endCall()
+
+	var health C.dcgmHostengineHealth_t
+	health.version = makeVersion1(unsafe.Sizeof(health))
+
+	result := C.dcgmHostengineIsHealthy(c.dcgmHandle(), &health)
+	if err := errorString(result); err != nil {
+		return HostengineHealth{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return HostengineHealth{OverallHealth: uint(health.overallHealth)}, nil
+}
+
+// Ping checks that the DCGM hostengine responds and reports itself healthy, and returns
+// the round trip time. It gives up once ctx is cancelled or its deadline expires, so a
+// wedged hostengine is reported as an error rather than blocking the caller.
+func Ping(ctx context.Context) (time.Duration, error) {
+	return defaultClient.Ping(ctx)
+}
+
+// Ping checks that the DCGM hostengine responds and reports itself healthy, and returns
+// the round trip time. It gives up once ctx is cancelled or its deadline expires, so a
+// wedged hostengine is reported as an error rather than blocking the caller.
+func (c *Client) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- c.ping()
+	}()
+
+	select {
+	case err := <-done:
+		return time.Since(start), err
+	case <-ctx.Done():
+		return time.Since(start), fmt.Errorf("error pinging nv-hostengine: %w", ctx.Err())
+	}
+}
+
+// ping checks that the hostengine reports itself healthy, waiting for as long as it takes
+func (c *Client) ping() error {
+	health, err := c.HostengineIsHealthy()
+	if err != nil {
+		return err
+	}
+	if !health.Healthy() {
+		return fmt.Errorf("nv-hostengine is unhealthy: code %d", health.OverallHealth)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostengineOptionsArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     HostengineOptions
+		expected []string
+	}{
+		{
+			name:     "defaults",
+			opts:     HostengineOptions{},
+			expected: []string{"nv-hostengine", "--domain-socket", "/tmp/dcgm123"},
+		},
+		{
+			name: "tcp with logging",
+			opts: HostengineOptions{
+				TCP:         true,
+				Port:        5556,
+				BindAddress: "0.0.0.0",
+				LogFile:     "/var/log/nv-hostengine.log",
+				LogLevel:    "DEBUG",
+				ExtraArgs:   []string{"--no-daemon"},
+			},
+			expected: []string{
+				"nv-hostengine", "--bind-interface", "0.0.0.0", "--port", "5556",
+				"--log-filename", "/var/log/nv-hostengine.log", "--log-level", "DEBUG", "--no-daemon",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.opts.args("nv-hostengine", "/tmp/dcgm123"))
+		})
+	}
+}
+
+func TestHostengineOptionsAddress(t *testing.T) {
+	assert.Equal(t, "127.0.0.1:5555", HostengineOptions{TCP: true}.address())
+	assert.Equal(t, "[::1]:6000", HostengineOptions{TCP: true, BindAddress: "::1", Port: 6000}.address())
+}
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, v ...any) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestHostengineOptionsForwardOutput(t *testing.T) {
+	logger := &recordingLogger{}
+	opts := HostengineOptions{Logger: logger, LogPrefix: "he: "}
+
+	opts.forwardOutput(io.NopCloser(strings.NewReader("Started host engine version 4.1.1\nHost Engine Listener Started\n")))
+
+	assert.Equal(t, []string{"he: Started host engine version 4.1.1", "he: Host Engine Listener Started"}, logger.lines)
+}
@@ -0,0 +1,219 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+	"unsafe"
+)
+
+// gpuCountWatchInterval is how often WatchGpuCount polls the number of GPUs
+const gpuCountWatchInterval = 5 * time.Second
+
+// DeviceEventType is the kind of change reported by WatchDevices
+type DeviceEventType int
+
+const (
+	// DeviceAdded is reported when a GPU appears, e.g. after a reset or passthrough attach
+	DeviceAdded DeviceEventType = iota
+	// DeviceRemoved is reported when a GPU disappears or falls off the bus
+	DeviceRemoved
+)
+
+func (t DeviceEventType) String() string {
+	switch t {
+	case DeviceAdded:
+		return "added"
+	case DeviceRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// DeviceEvent reports a GPU appearing or disappearing
+type DeviceEvent struct {
+	Type DeviceEventType
+	GPU  uint
+	UUID string
+}
+
+// WatchDevices polls the set of GPUs every interval and reports the GPUs that appear or
+// disappear on the returned channel. GPUs are identified by UUID, so a GPU ID that is reused
+// by a different GPU is reported as a removal followed by an addition. The GPUs present when
+// WatchDevices is called are not reported. The channel is closed once ctx is done or the
+// client is closed.
+func WatchDevices(ctx context.Context, interval time.Duration) (<-chan DeviceEvent, error) {
+	return defaultClient.WatchDevices(ctx, interval)
+}
+
+// WatchDevices polls the set of GPUs every interval and reports the GPUs that appear or
+// disappear on the returned channel. GPUs are identified by UUID, so a GPU ID that is reused
+// by a different GPU is reported as a removal followed by an addition. The GPUs present when
+// WatchDevices is called are not reported. The channel is closed once ctx is done or the
+// client is closed.
+func (c *Client) WatchDevices(ctx context.Context, interval time.Duration) (<-chan DeviceEvent, error) {
+	events := make(chan DeviceEvent, 16)
+
+	err := c.pollDevices(ctx, interval, func(prev, cur map[uint]string) bool {
+		for _, event := range diffDevices(prev, cur) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return false
+			}
+		}
+		return true
+	}, func() { close(events) })
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GpuCountChange reports a change in the number of reachable GPUs
+type GpuCountChange struct {
+	Previous int
+	Current  int
+}
+
+// WatchGpuCount polls the number of reachable GPUs every 5 seconds and reports every change on
+// the returned channel. The count usually changes when a GPU falls off the bus (XID 79) or is
+// reset, and consumers should re-initialize any state tied to GPU IDs when it does. The channel
+// is closed once ctx is done or the client is closed.
+func WatchGpuCount(ctx context.Context) (<-chan GpuCountChange, error) {
+	return defaultClient.WatchGpuCount(ctx)
+}
+
+// WatchGpuCount polls the number of reachable GPUs every 5 seconds and reports every change on
+// the returned channel. The count usually changes when a GPU falls off the bus (XID 79) or is
+// reset, and consumers should re-initialize any state tied to GPU IDs when it does. The channel
+// is closed once ctx is done or the client is closed.
+func (c *Client) WatchGpuCount(ctx context.Context) (<-chan GpuCountChange, error) {
+	changes := make(chan GpuCountChange, 1)
+
+	err := c.pollDevices(ctx, gpuCountWatchInterval, func(prev, cur map[uint]string) bool {
+		if len(prev) == len(cur) {
+			return true
+		}
+		select {
+		case changes <- GpuCountChange{Previous: len(prev), Current: len(cur)}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(changes) })
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// pollDevices takes a device snapshot and then, every interval, passes the previous and the
+// current snapshot to onPoll until ctx is done, the client is closed or onPoll returns false.
+// done is called once polling stops. The initial snapshot is taken synchronously so that its
+// error can be returned, in which case done is not called.
+func (c *Client) pollDevices(ctx context.Context, interval time.Duration, onPoll func(prev, cur map[uint]string) bool, done func()) error {
+	if interval <= 0 {
+		return errors.New("device watch interval must be positive")
+	}
+
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	devices, err := c.deviceSnapshot()
+	c.endCall()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := c.beginCall(); err != nil {
+				return
+			}
+			current, err := c.deviceSnapshot()
+			c.endCall()
+			if err != nil {
+				// keep the last known set and try again on the next tick, the hostengine may be restarting
+				continue
+			}
+
+			if !onPoll(devices, current) {
+				return
+			}
+			devices = current
+		}
+	}()
+
+	return nil
+}
+
+// deviceSnapshot returns the UUIDs of the reachable GPUs, keyed by GPU ID
+func (c *Client) deviceSnapshot() (map[uint]string, error) {
+	gpus, err := c.getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(map[uint]string, len(gpus))
+	for _, gpu := range gpus {
+		var device C.dcgmDeviceAttributes_t
+		device.version = makeVersion3(unsafe.Sizeof(device))
+
+		result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpu), &device)
+		if result == C.DCGM_ST_GPU_IS_LOST {
+			continue
+		}
+		if err = errorString(result); err != nil {
+			return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+
+		devices[gpu] = *stringPtr(&device.identifiers.uuid[0])
+	}
+
+	return devices, nil
+}
+
+// diffDevices returns the removals followed by the additions that turn prev into cur, each in
+// GPU ID order
+func diffDevices(prev, cur map[uint]string) []DeviceEvent {
+	var removed, added []DeviceEvent
+
+	for gpu, uuid := range prev {
+		if cur[gpu] != uuid {
+			removed = append(removed, DeviceEvent{Type: DeviceRemoved, GPU: gpu, UUID: uuid})
+		}
+	}
+	for gpu, uuid := range cur {
+		if prevUUID, ok := prev[gpu]; !ok || prevUUID != uuid {
+			added = append(added, DeviceEvent{Type: DeviceAdded, GPU: gpu, UUID: uuid})
+		}
+	}
+
+	byGPU := func(a, b DeviceEvent) int { return int(a.GPU) - int(b.GPU) }
+	slices.SortFunc(removed, byGPU)
+	slices.SortFunc(added, byGPU)
+
+	return append(removed, added...)
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDevices(t *testing.T) {
+	prev := map[uint]string{0: "GPU-a", 1: "GPU-b", 2: "GPU-c"}
+	cur := map[uint]string{0: "GPU-a", 2: "GPU-d", 3: "GPU-e"}
+
+	assert.Equal(t, []DeviceEvent{
+		{Type: DeviceRemoved, GPU: 1, UUID: "GPU-b"},
+		{Type: DeviceRemoved, GPU: 2, UUID: "GPU-c"},
+		{Type: DeviceAdded, GPU: 2, UUID: "GPU-d"},
+		{Type: DeviceAdded, GPU: 3, UUID: "GPU-e"},
+	}, diffDevices(prev, cur))
+
+	assert.Empty(t, diffDevices(prev, prev))
+}
+
+func TestWatchDevicesInvalidInterval(t *testing.T) {
+	_, err := (&Client{}).WatchDevices(context.Background(), 0)
+	require.Error(t, err)
+}
+
+func TestWatchGpuCountClosedClient(t *testing.T) {
+	_, err := (&Client{closing: true}).WatchGpuCount(context.Background())
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -95,3 +95,62 @@ func verifyProfileNames(tb testing.TB, entityIds []uint, isGpuInstance bool) {
 			values[i].EntityID, expectedFakeName, values[i].String())
 	}
 }
+
+func TestMigHierarchyEntities(t *testing.T) {
+	gpu := func(id uint) GroupEntityPair { return GroupEntityPair{EntityGroupId: FE_GPU, EntityId: id} }
+	gi := func(id uint) GroupEntityPair { return GroupEntityPair{EntityGroupId: FE_GPU_I, EntityId: id} }
+	ci := func(id uint) GroupEntityPair { return GroupEntityPair{EntityGroupId: FE_GPU_CI, EntityId: id} }
+
+	var hierarchy MigHierarchy_v2
+	for _, info := range []MigHierarchyInfo_v2{
+		{Entity: gi(0), Parent: gpu(0)},
+		{Entity: ci(0), Parent: gi(0)},
+		{Entity: gi(1), Parent: gpu(1)},
+		{Entity: ci(1), Parent: gi(1)},
+		{Entity: ci(2), Parent: gi(1)},
+	} {
+		hierarchy.EntityList[hierarchy.Count] = info
+		hierarchy.Count++
+	}
+
+	assert.Equal(t, []GroupEntityPair{gi(0), gi(1)}, hierarchy.Entities(false))
+	assert.Equal(t, []GroupEntityPair{gi(0), gi(1), ci(0), ci(1), ci(2)}, hierarchy.Entities(true))
+	assert.Equal(t, []GroupEntityPair{gi(1), ci(1), ci(2)}, hierarchy.Entities(true, 1))
+	assert.Empty(t, hierarchy.Entities(true, 2))
+}
+
+func TestNewMigGroup(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuIDs, err := withInjectionGPUs(t, 1)
+	require.NoError(t, err)
+	gpuInstanceMap, err := withInjectionGPUInstances(t, gpuIDs[0], 2)
+	require.NoError(t, err)
+	gpuInstanceIDs := make([]uint, 0, len(gpuInstanceMap))
+	for instanceID := range gpuInstanceMap {
+		gpuInstanceIDs = append(gpuInstanceIDs, instanceID)
+	}
+	_, err = withInjectionComputeInstances(t, gpuInstanceIDs, len(gpuInstanceIDs))
+	require.NoError(t, err)
+
+	group, err := NewMigGroup("test_mig_group", true, gpuIDs[0])
+	require.NoError(t, err)
+	defer func() {
+		_ = DestroyGroup(group)
+	}()
+
+	info, err := GetGroupInfo(group)
+	require.NoError(t, err)
+	var instances, computeInstances int
+	for _, entity := range info.EntityList {
+		switch entity.EntityGroupId {
+		case FE_GPU_I:
+			instances++
+		case FE_GPU_CI:
+			computeInstances++
+		}
+	}
+	assert.Equal(t, len(gpuInstanceIDs), instances)
+	assert.Equal(t, len(gpuInstanceIDs), computeInstances)
+}
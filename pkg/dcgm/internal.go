@@ -18,9 +18,6 @@
 package dcgm
 
 /*
-#cgo linux LDFLAGS: -ldl -Wl,--export-dynamic -Wl,--unresolved-symbols=ignore-in-object-files
-#cgo darwin LDFLAGS: -ldl -Wl,--export-dynamic -Wl,-undefined,dynamic_lookup
-
 #include "dcgm_test_apis.h"
 #include "dcgm_test_structs.h"
 #include "dcgm_structs_internal.h"
@@ -28,6 +25,8 @@ package dcgm
 import "C"
 
 import (
+	"fmt"
+	"time"
 	"unsafe"
 )
 
@@ -46,6 +45,18 @@ type MigHierarchyInfo struct {
 // This function is intended for testing purposes only.
 // Returns a slice of Entity IDs for the created entities and any error encountered.
 func CreateFakeEntities(entities []MigHierarchyInfo) ([]uint, error) {
+	return defaultClient.CreateFakeEntities(entities)
+}
+
+// CreateFakeEntities creates test entities with the specified MIG hierarchy information.
+// This function is intended for testing purposes only.
+// Returns a slice of Entity IDs for the created entities and any error encountered.
+func (c *Client) CreateFakeEntities(entities []MigHierarchyInfo) ([]uint, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
 	ccfe := C.dcgmCreateFakeEntities_v2{
 		version:     C.dcgmCreateFakeEntities_version2,
 		numToCreate: C.uint(len(entities)),
@@ -69,7 +80,7 @@ func CreateFakeEntities(entities []MigHierarchyInfo) ([]uint, error) {
 			sliceProfile: C.dcgmMigProfile_t(entity.SliceProfile),
 		}
 	}
-	result := C.dcgmCreateFakeEntities(handle.handle, &ccfe)
+	result := C.dcgmCreateFakeEntities(c.dcgmHandle(), &ccfe)
 
 	if err := errorString(result); err != nil {
 		return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -82,6 +93,68 @@ func CreateFakeEntities(entities []MigHierarchyInfo) ([]uint, error) {
 	return entityIDs, nil
 }
 
+// CreateFakeGPUs creates count fake GPUs for injection testing. Returns the IDs of the new GPUs.
+func CreateFakeGPUs(count int) ([]uint, error) {
+	return defaultClient.CreateFakeGPUs(count)
+}
+
+// CreateFakeGPUs creates count fake GPUs for injection testing. Returns the IDs of the new GPUs.
+func (c *Client) CreateFakeGPUs(count int) ([]uint, error) {
+	return c.createFakeChildren(FE_GPU, GroupEntityPair{}, count)
+}
+
+// CreateFakeNvSwitches creates count fake NvSwitches for injection testing. Returns the IDs of the
+// new switches.
+func CreateFakeNvSwitches(count int) ([]uint, error) {
+	return defaultClient.CreateFakeNvSwitches(count)
+}
+
+// CreateFakeNvSwitches creates count fake NvSwitches for injection testing. Returns the IDs of the
+// new switches.
+func (c *Client) CreateFakeNvSwitches(count int) ([]uint, error) {
+	return c.createFakeChildren(FE_SWITCH, GroupEntityPair{}, count)
+}
+
+// CreateFakeGPUInstances creates count fake MIG GPU instances on the specified GPU for injection
+// testing. Returns the IDs of the new GPU instances.
+func CreateFakeGPUInstances(gpuID uint, count int) ([]uint, error) {
+	return defaultClient.CreateFakeGPUInstances(gpuID, count)
+}
+
+// CreateFakeGPUInstances creates count fake MIG GPU instances on the specified GPU for injection
+// testing. Returns the IDs of the new GPU instances.
+func (c *Client) CreateFakeGPUInstances(gpuID uint, count int) ([]uint, error) {
+	return c.createFakeChildren(FE_GPU_I, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID}, count)
+}
+
+// CreateFakeComputeInstances creates count fake MIG compute instances in the specified GPU
+// instance for injection testing. Returns the IDs of the new compute instances.
+func CreateFakeComputeInstances(gpuInstanceID uint, count int) ([]uint, error) {
+	return defaultClient.CreateFakeComputeInstances(gpuInstanceID, count)
+}
+
+// CreateFakeComputeInstances creates count fake MIG compute instances in the specified GPU
+// instance for injection testing. Returns the IDs of the new compute instances.
+func (c *Client) CreateFakeComputeInstances(gpuInstanceID uint, count int) ([]uint, error) {
+	return c.createFakeChildren(FE_GPU_CI, GroupEntityPair{EntityGroupId: FE_GPU_I, EntityId: gpuInstanceID}, count)
+}
+
+func (c *Client) createFakeChildren(group Field_Entity_Group, parent GroupEntityPair, count int) ([]uint, error) {
+	if count <= 0 || count > C.DCGM_MAX_HIERARCHY_INFO {
+		return nil, fmt.Errorf("cannot create %d fake entities, the limit is %d", count, C.DCGM_MAX_HIERARCHY_INFO)
+	}
+
+	entities := make([]MigHierarchyInfo, count)
+	for i := range entities {
+		entities[i] = MigHierarchyInfo{
+			Entity: GroupEntityPair{EntityGroupId: group},
+			Parent: parent,
+		}
+	}
+
+	return c.CreateFakeEntities(entities)
+}
+
 // InjectFieldValue injects a test value for a specific field into DCGM's field manager.
 // This function is intended for testing purposes only.
 //
@@ -95,6 +168,72 @@ func CreateFakeEntities(entities []MigHierarchyInfo) ([]uint, error) {
 //
 // Returns an error if the injection fails
 func InjectFieldValue(gpu uint, fieldID Short, fieldType uint, status int, ts int64, value any) error {
+	return defaultClient.InjectFieldValue(gpu, fieldID, fieldType, status, ts, value)
+}
+
+// InjectFieldValue injects a test value for a specific field into DCGM's field manager.
+// This function is intended for testing purposes only.
+//
+// Parameters:
+//   - gpu: The GPU ID to inject the field value for
+//   - fieldID: The DCGM field identifier
+//   - fieldType: The type of the field (e.g., DCGM_FT_INT64, DCGM_FT_DOUBLE)
+//   - status: The status code for the field
+//   - ts: The timestamp for the field value
+//   - value: The value to inject (must match fieldType)
+//
+// Returns an error if the injection fails
+func (c *Client) InjectFieldValue(gpu uint, fieldID Short, fieldType uint, status int, ts int64, value any) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	field, err := toInjectFieldValue(fieldID, fieldType, status, ts, value)
+	if err != nil {
+		return err
+	}
+
+	result := C.dcgmInjectFieldValue(c.dcgmHandle(), C.uint(gpu), &field)
+
+	if err := errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return nil
+}
+
+// InjectEntityFieldValue injects a test value for a field of an entity of any type, such as a fake
+// NvSwitch or MIG instance, into DCGM's field manager. value must be an int64, float64 or string
+// matching fieldType. This function is intended for testing purposes only.
+func InjectEntityFieldValue(entity Entity, fieldID Short, fieldType uint, status int, ts int64, value any) error {
+	return defaultClient.InjectEntityFieldValue(entity, fieldID, fieldType, status, ts, value)
+}
+
+// InjectEntityFieldValue injects a test value for a field of an entity of any type, such as a fake
+// NvSwitch or MIG instance, into DCGM's field manager. value must be an int64, float64 or string
+// matching fieldType. This function is intended for testing purposes only.
+func (c *Client) InjectEntityFieldValue(entity Entity, fieldID Short, fieldType uint, status int, ts int64, value any) error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	field, err := toInjectFieldValue(fieldID, fieldType, status, ts, value)
+	if err != nil {
+		return err
+	}
+
+	result := C.dcgmEntityInjectFieldValue(c.dcgmHandle(), C.dcgm_field_entity_group_t(entity.Group), C.dcgm_field_eid_t(entity.ID), &field)
+
+	if err := errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return nil
+}
+
+func toInjectFieldValue(fieldID Short, fieldType uint, status int, ts int64, value any) (C.dcgmInjectFieldValue_t, error) {
 	field := C.dcgmInjectFieldValue_t{
 		version:   C.dcgmInjectFieldValue_version1,
 		fieldId:   C.ushort(fieldID),
@@ -105,20 +244,123 @@ func InjectFieldValue(gpu uint, fieldID Short, fieldType uint, status int, ts in
 
 	switch fieldType {
 	case DCGM_FT_INT64:
-		i64Val := value.(int64)
+		i64Val, ok := value.(int64)
+		if !ok {
+			return field, fmt.Errorf("expected an int64 value for field %d, got %T", fieldID, value)
+		}
 		ptr := (*C.int64_t)(unsafe.Pointer(&field.value[0]))
 		*ptr = C.int64_t(i64Val)
 	case DCGM_FT_DOUBLE:
-		dbVal := value.(float64)
+		dbVal, ok := value.(float64)
+		if !ok {
+			return field, fmt.Errorf("expected a float64 value for field %d, got %T", fieldID, value)
+		}
 		ptr := (*C.double)(unsafe.Pointer(&field.value[0]))
 		*ptr = C.double(dbVal)
+	case DCGM_FT_STRING:
+		strVal, ok := value.(string)
+		if !ok {
+			return field, fmt.Errorf("expected a string value for field %d, got %T", fieldID, value)
+		}
+		if len(strVal) >= len(field.value) {
+			return field, fmt.Errorf("string value for field %d is too long", fieldID)
+		}
+		buf := unsafe.Slice((*byte)(unsafe.Pointer(&field.value[0])), len(field.value))
+		copy(buf, strVal)
 	}
 
-	result := C.dcgmInjectFieldValue(handle.handle, C.uint(gpu), &field)
+	return field, nil
+}
 
-	if err := errorString(result); err != nil {
-		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+// InjectValue injects value for a field of an entity, timestamped now. The field type follows
+// from the type of value: integers are injected as DCGM_FT_INT64, floats as DCGM_FT_DOUBLE and
+// strings as DCGM_FT_STRING. This function is intended for testing purposes only.
+func InjectValue(entity Entity, fieldID Short, value any) error {
+	return defaultClient.InjectValue(entity, fieldID, value)
+}
+
+// InjectValue injects value for a field of an entity, timestamped now. The field type follows
+// from the type of value: integers are injected as DCGM_FT_INT64, floats as DCGM_FT_DOUBLE and
+// strings as DCGM_FT_STRING. This function is intended for testing purposes only.
+func (c *Client) InjectValue(entity Entity, fieldID Short, value any) error {
+	fieldType, value, err := injectionType(value)
+	if err != nil {
+		return fmt.Errorf("field %d: %w", fieldID, err)
+	}
+	return c.InjectEntityFieldValue(entity, fieldID, fieldType, DCGM_ST_OK, time.Now().UnixMicro(), value)
+}
+
+// injectionType returns the field type to inject value as, and value converted to the type
+// toInjectFieldValue expects for it
+func injectionType(value any) (uint, any, error) {
+	switch v := value.(type) {
+	case int:
+		return DCGM_FT_INT64, int64(v), nil
+	case int32:
+		return DCGM_FT_INT64, int64(v), nil
+	case int64:
+		return DCGM_FT_INT64, v, nil
+	case uint:
+		return DCGM_FT_INT64, int64(v), nil
+	case uint32:
+		return DCGM_FT_INT64, int64(v), nil
+	case float32:
+		return DCGM_FT_DOUBLE, float64(v), nil
+	case float64:
+		return DCGM_FT_DOUBLE, v, nil
+	case string:
+		return DCGM_FT_STRING, v, nil
 	}
+	return 0, nil, fmt.Errorf("cannot inject a value of type %T", value)
+}
+
+// InjectTemperature injects a GPU temperature in °C for the specified GPU. This function is
+// intended for testing purposes only.
+func InjectTemperature(gpuID uint, celsius int64) error {
+	return defaultClient.InjectTemperature(gpuID, celsius)
+}
+
+// InjectTemperature injects a GPU temperature in °C for the specified GPU. This function is
+// intended for testing purposes only.
+func (c *Client) InjectTemperature(gpuID uint, celsius int64) error {
+	return c.InjectValue(Entity{Group: FE_GPU, ID: gpuID}, DCGM_FI_DEV_GPU_TEMP, celsius)
+}
+
+// InjectXid injects an XID error for the specified GPU. This function is intended for testing
+// purposes only.
+func InjectXid(gpuID uint, xid uint) error {
+	return defaultClient.InjectXid(gpuID, xid)
+}
+
+// InjectXid injects an XID error for the specified GPU. This function is intended for testing
+// purposes only.
+func (c *Client) InjectXid(gpuID uint, xid uint) error {
+	return c.InjectValue(Entity{Group: FE_GPU, ID: gpuID}, DCGM_FI_DEV_XID_ERRORS, xid)
+}
+
+// InjectECCErrors injects total single and double bit ECC error counts for the specified GPU,
+// both as volatile and aggregate counts. This function is intended for testing purposes only.
+func InjectECCErrors(gpuID uint, singleBit, doubleBit int64) error {
+	return defaultClient.InjectECCErrors(gpuID, singleBit, doubleBit)
+}
 
+// InjectECCErrors injects total single and double bit ECC error counts for the specified GPU,
+// both as volatile and aggregate counts. This function is intended for testing purposes only.
+func (c *Client) InjectECCErrors(gpuID uint, singleBit, doubleBit int64) error {
+	gpu := Entity{Group: FE_GPU, ID: gpuID}
+	counts := []struct {
+		field Short
+		value int64
+	}{
+		{DCGM_FI_DEV_ECC_SBE_VOL_TOTAL, singleBit},
+		{DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, doubleBit},
+		{DCGM_FI_DEV_ECC_SBE_AGG_TOTAL, singleBit},
+		{DCGM_FI_DEV_ECC_DBE_AGG_TOTAL, doubleBit},
+	}
+	for _, count := range counts {
+		if err := c.InjectValue(gpu, count.field, count.value); err != nil {
+			return err
+		}
+	}
 	return nil
 }
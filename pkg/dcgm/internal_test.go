@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectionType(t *testing.T) {
+	for _, tc := range []struct {
+		value     any
+		fieldType uint
+		converted any
+	}{
+		{42, DCGM_FT_INT64, int64(42)},
+		{uint(79), DCGM_FT_INT64, int64(79)},
+		{int64(-1), DCGM_FT_INT64, int64(-1)},
+		{float32(1.5), DCGM_FT_DOUBLE, float64(1.5)},
+		{2.5, DCGM_FT_DOUBLE, 2.5},
+		{"H100", DCGM_FT_STRING, "H100"},
+	} {
+		fieldType, converted, err := injectionType(tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.fieldType, fieldType)
+		assert.Equal(t, tc.converted, converted)
+	}
+
+	_, _, err := injectionType([]byte("blob"))
+	require.Error(t, err)
+}
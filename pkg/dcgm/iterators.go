@@ -0,0 +1,94 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"iter"
+)
+
+// The iterators below run their query when the range loop starts. A failed query is yielded once
+// as a zero value with the error, after which the iteration stops. No call is held open while the
+// loop body runs, so the body may use the client freely, including closing it.
+
+// LatestValues returns an iterator over the latest values of fields for entities, with the same
+// semantics as EntitiesGetLatestValues. The values are converted one at a time as the loop
+// advances, so breaking out early skips the conversion of the remaining values.
+func LatestValues(entities []GroupEntityPair, fields []Short, flags uint) iter.Seq2[FieldValue_v2, error] {
+	return defaultClient.LatestValues(entities, fields, flags)
+}
+
+// LatestValues returns an iterator over the latest values of fields for entities, with the same
+// semantics as EntitiesGetLatestValues. The values are converted one at a time as the loop
+// advances, so breaking out early skips the conversion of the remaining values.
+func (c *Client) LatestValues(entities []GroupEntityPair, fields []Short, flags uint) iter.Seq2[FieldValue_v2, error] {
+	return func(yield func(FieldValue_v2, error) bool) {
+		if len(entities) == 0 || len(fields) == 0 {
+			return
+		}
+
+		values := acquireFieldValueV2Slice(len(fields) * len(entities))
+		defer releaseFieldValueV2Slice(values)
+
+		if err := c.entitiesGetLatestValues(entities, fields, flags, values); err != nil {
+			yield(FieldValue_v2{}, err)
+			return
+		}
+
+		for i := range values {
+			if !yield(toFieldValueV2(&values[i]), nil) {
+				return
+			}
+		}
+	}
+}
+
+// Devices returns an iterator over the supported GPUs, with the same information as
+// GetDeviceInfo. The information of each GPU is only queried when the loop reaches it.
+func Devices() iter.Seq2[Device, error] {
+	return defaultClient.Devices()
+}
+
+// Devices returns an iterator over the supported GPUs, with the same information as
+// GetDeviceInfo. The information of each GPU is only queried when the loop reaches it.
+func (c *Client) Devices() iter.Seq2[Device, error] {
+	return func(yield func(Device, error) bool) {
+		gpus, err := c.GetSupportedDevices()
+		if err != nil {
+			yield(Device{}, err)
+			return
+		}
+
+		for _, gpu := range gpus {
+			device, err := c.GetDeviceInfo(gpu)
+			if !yield(device, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// GroupMembers returns an iterator over the entities of group
+func GroupMembers(group GroupHandle) iter.Seq2[GroupEntityPair, error] {
+	return defaultClient.GroupMembers(group)
+}
+
+// GroupMembers returns an iterator over the entities of group
+func (c *Client) GroupMembers(group GroupHandle) iter.Seq2[GroupEntityPair, error] {
+	return func(yield func(GroupEntityPair, error) bool) {
+		info, err := c.GetGroupInfo(group)
+		if err != nil {
+			yield(GroupEntityPair{}, err)
+			return
+		}
+
+		for _, member := range info.EntityList {
+			if !yield(member, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIteratorsClosedClient(t *testing.T) {
+	c := &Client{closing: true}
+
+	for _, err := range c.LatestValues([]GroupEntityPair{{EntityGroupId: FE_GPU}}, []Short{DCGM_FI_DEV_GPU_TEMP}, 0) {
+		require.ErrorIs(t, err, ErrClientClosed)
+	}
+	for _, err := range c.Devices() {
+		require.ErrorIs(t, err, ErrClientClosed)
+	}
+	for _, err := range c.GroupMembers(GroupAllGPUs()) {
+		require.ErrorIs(t, err, ErrClientClosed)
+	}
+}
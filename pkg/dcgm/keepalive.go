@@ -0,0 +1,139 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type keepaliveState struct {
+	stop chan struct{}
+}
+
+// OnDisconnect registers fn to be called when the connection to nv-hostengine is found to be lost,
+// either by the keepalive started with StartKeepalive or by auto-reconnect. fn is called once per
+// lost connection, from a background goroutine, with the error that revealed the loss.
+func OnDisconnect(fn func(error)) {
+	defaultClient.OnDisconnect(fn)
+}
+
+// OnDisconnect registers fn to be called when the connection to nv-hostengine is found to be lost,
+// either by the keepalive started with StartKeepalive or by auto-reconnect. fn is called once per
+// lost connection, from a background goroutine, with the error that revealed the loss.
+func (c *Client) OnDisconnect(fn func(error)) {
+	c.hookMu.Lock()
+	defer c.hookMu.Unlock()
+
+	c.disconnectHooks = append(c.disconnectHooks, fn)
+}
+
+// StartKeepalive pings nv-hostengine every interval, treating a ping that does not complete
+// within interval as a lost connection, so that OnDisconnect hooks run as soon as the
+// connection drops. The keepalive stops when the client is closed.
+func StartKeepalive(interval time.Duration) error {
+	return defaultClient.StartKeepalive(interval)
+}
+
+// StartKeepalive pings nv-hostengine every interval, treating a ping that does not complete
+// within interval as a lost connection, so that OnDisconnect hooks run as soon as the
+// connection drops. The keepalive stops when the client is closed.
+func (c *Client) StartKeepalive(interval time.Duration) error {
+	if c.mode == Embedded {
+		return errors.New("keepalive is not supported for embedded hostengines")
+	}
+	if interval <= 0 {
+		return errors.New("keepalive interval must be positive")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keepalive != nil {
+		return errors.New("keepalive is already running")
+	}
+
+	c.keepalive = &keepaliveState{stop: make(chan struct{})}
+	go c.runKeepalive(interval, c.keepalive.stop, c.ping)
+
+	return nil
+}
+
+// stopKeepalive stops the keepalive, if any.
+func (c *Client) stopKeepalive() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keepalive != nil {
+		close(c.keepalive.stop)
+		c.keepalive = nil
+	}
+}
+
+// runKeepalive calls ping every interval until stop is closed. A ping still in flight when the
+// next one is due is waited for again rather than repeated, so that a wedged hostengine does not
+// pile up pings that each hold a call on the client.
+func (c *Client) runKeepalive(interval time.Duration, stop chan struct{}, ping func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var pending chan error
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		if pending == nil {
+			pending = make(chan error, 1)
+			go func(result chan<- error) {
+				result <- ping()
+			}(pending)
+		}
+
+		var err error
+		select {
+		case <-stop:
+			return
+		case err = <-pending:
+			pending = nil
+		case <-time.After(interval):
+			err = fmt.Errorf("error pinging nv-hostengine: %w", context.DeadlineExceeded)
+		}
+
+		switch {
+		case errors.Is(err, ErrClientClosed):
+			// the client is closing; Close stops the keepalive once it is drained
+			continue
+		case err != nil:
+			c.notifyDisconnect(err)
+		default:
+			c.notifyConnected()
+		}
+	}
+}
+
+// notifyDisconnect runs the OnDisconnect hooks, unless they already ran for this connection
+func (c *Client) notifyDisconnect(err error) {
+	c.hookMu.Lock()
+	if c.disconnected {
+		c.hookMu.Unlock()
+		return
+	}
+	c.disconnected = true
+	hooks := append([]func(error){}, c.disconnectHooks...)
+	c.hookMu.Unlock()
+
+	for _, hook := range hooks {
+		hook(err)
+	}
+}
+
+// notifyConnected records that the connection works, so that the next loss runs the hooks again
+func (c *Client) notifyConnected() {
+	c.hookMu.Lock()
+	defer c.hookMu.Unlock()
+
+	c.disconnected = false
+}
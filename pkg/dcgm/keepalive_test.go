@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnDisconnectRunsOncePerConnection(t *testing.T) {
+	c := &Client{}
+
+	var calls []error
+	c.OnDisconnect(func(err error) {
+		calls = append(calls, err)
+	})
+
+	lost := errors.New("connection lost")
+	c.notifyDisconnect(lost)
+	c.notifyDisconnect(lost)
+	require.Len(t, calls, 1)
+	assert.ErrorIs(t, calls[0], lost)
+
+	c.notifyConnected()
+	c.notifyDisconnect(lost)
+	assert.Len(t, calls, 2)
+}
+
+func TestKeepaliveWaitsForPingInFlight(t *testing.T) {
+	c := &Client{}
+
+	disconnects := make(chan error, 10)
+	c.OnDisconnect(func(err error) {
+		disconnects <- err
+	})
+
+	var pings atomic.Int32
+	release := make(chan struct{})
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		c.runKeepalive(5*time.Millisecond, stop, func() error {
+			pings.Add(1)
+			<-release
+			return nil
+		})
+	}()
+
+	assert.ErrorIs(t, <-disconnects, context.DeadlineExceeded)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), pings.Load())
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		c.hookMu.Lock()
+		defer c.hookMu.Unlock()
+		return !c.disconnected
+	}, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return pings.Load() > 1 }, time.Second, time.Millisecond)
+
+	close(stop)
+	<-stopped
+}
@@ -0,0 +1,62 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"log"
+	"runtime"
+	"sync/atomic"
+)
+
+var leakDetection atomic.Bool
+
+// SetLeakDetection enables warnings about resources that are not released: a Client that is
+// garbage collected without being closed, groups or field groups that were never destroyed when
+// their Client is closed, and ManagedGroups garbage collected without being closed, unless they
+// are destroyed with their Client. It applies to clients opened after the call.
+func SetLeakDetection(enabled bool) {
+	leakDetection.Store(enabled)
+}
+
+// leakTracker records the groups and field groups created through a client, by name
+type leakTracker struct {
+	groups      map[C.dcgmGpuGrp_t]string
+	fieldGroups map[C.dcgmFieldGrp_t]string
+}
+
+// watchForLeaks starts tracking the client's resources if leak detection is enabled
+func (c *Client) watchForLeaks() {
+	if !leakDetection.Load() {
+		return
+	}
+
+	c.leaks = &leakTracker{
+		groups:      make(map[C.dcgmGpuGrp_t]string),
+		fieldGroups: make(map[C.dcgmFieldGrp_t]string),
+	}
+	runtime.SetFinalizer(c, (*Client).finalize)
+}
+
+// reportLeaks logs the groups and field groups that were not destroyed before Close
+func (c *Client) reportLeaks() {
+	if c.leaks == nil {
+		return
+	}
+	runtime.SetFinalizer(c, nil)
+
+	for _, name := range c.leaks.groups {
+		log.Printf("dcgm: group %q was not destroyed before its client was closed", name)
+	}
+	for _, name := range c.leaks.fieldGroups {
+		log.Printf("dcgm: field group %q was not destroyed before its client was closed", name)
+	}
+}
+
+func (c *Client) finalize() {
+	log.Printf("dcgm: client was garbage collected without being closed, leaking %d group(s) and %d field group(s)",
+		len(c.leaks.groups), len(c.leaks.fieldGroups))
+}
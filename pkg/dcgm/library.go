@@ -0,0 +1,224 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// LibraryPathEnv is the environment variable that may point at an explicit libdcgm to load
+const LibraryPathEnv = "DCGM_LIBRARY_PATH"
+
+// defaultLibraryNames lists the libdcgm names tried, in order, when no explicit path is configured
+var defaultLibraryNames = []string{"libdcgm.so.4", "libdcgm.so.3", "libdcgm.so"}
+
+// LibraryInfo describes the libdcgm that was loaded into the process
+type LibraryInfo struct {
+	// Path is the name or path that was passed to dlopen
+	Path string
+	// Version is the DCGM version reported by the library, if available
+	Version string
+}
+
+var (
+	libMux        sync.Mutex
+	libRefCount   int
+	coreRefCount  int
+	libPath       string
+	loadedLibrary LibraryInfo
+)
+
+// SetLibraryPath sets an explicit libdcgm path to load instead of searching for the default names.
+// It takes precedence over the DCGM_LIBRARY_PATH environment variable and only affects
+// subsequent loads, so it must be called before Init or NewClient. It has no effect when
+// built with the dcgm_static tag.
+func SetLibraryPath(path string) {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	libPath = path
+}
+
+// GetLoadedLibrary returns information about the currently loaded libdcgm.
+// Returns an error if the library is not loaded.
+func GetLoadedLibrary() (LibraryInfo, error) {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if libRefCount == 0 {
+		return LibraryInfo{}, errors.New("libdcgm is not loaded")
+	}
+	return loadedLibrary, nil
+}
+
+// libraryCandidates returns the library names to try, in order
+func libraryCandidates() []string {
+	if libPath != "" {
+		return []string{libPath}
+	}
+	if path := os.Getenv(LibraryPathEnv); path != "" {
+		return []string{path}
+	}
+	return defaultLibraryNames
+}
+
+// LoadLibrary opens libdcgm without initializing DCGM. Together with InitCore it lets the library
+// stay loaded while clients are started and closed repeatedly, for example with NewEmbeddedClient
+// or Connect. Every successful call must be paired with a call to UnloadLibrary.
+func LoadLibrary() error {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	return acquireLibrary()
+}
+
+// UnloadLibrary releases a reference taken by LoadLibrary. libdcgm is closed once it is no
+// longer used by any caller or client.
+func UnloadLibrary() error {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if libRefCount <= coreRefCount {
+		return errors.New("UnloadLibrary called without LoadLibrary or before ShutdownCore")
+	}
+	releaseLibrary()
+	return nil
+}
+
+// InitCore initializes DCGM in the loaded library. LoadLibrary must have been called first.
+// Every successful call must be paired with a call to ShutdownCore.
+func InitCore() error {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if libRefCount == 0 {
+		return errors.New("libdcgm is not loaded")
+	}
+	return acquireCore()
+}
+
+// ShutdownCore releases a reference taken by InitCore. DCGM is shut down once it is no
+// longer used by any caller or client.
+func ShutdownCore() error {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if coreRefCount <= 0 {
+		return errors.New("ShutdownCore called without InitCore")
+	}
+	return releaseCore()
+}
+
+// loadLibrary opens libdcgm and initializes DCGM on first use. Every
+// successful call must be paired with a call to unloadLibrary.
+func loadLibrary() (err error) {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if err = acquireLibrary(); err != nil {
+		return
+	}
+	if err = acquireCore(); err != nil {
+		releaseLibrary()
+	}
+	return
+}
+
+// unloadLibrary shuts DCGM down and closes libdcgm once the last user has released it.
+func unloadLibrary() (err error) {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if coreRefCount <= 0 {
+		return
+	}
+
+	err = releaseCore()
+	releaseLibrary()
+	return
+}
+
+// acquireLibrary opens libdcgm on first use. The caller must hold libMux.
+func acquireLibrary() error {
+	if libRefCount > 0 {
+		libRefCount++
+		return nil
+	}
+
+	path, err := openLibrary(libraryCandidates())
+	if err != nil {
+		return err
+	}
+	loadedLibrary = LibraryInfo{Path: path}
+
+	var versionInfo C.dcgmVersionInfo_t
+	versionInfo.version = C.dcgmVersionInfo_version
+	if result := C.dcgmVersionInfo(&versionInfo); result == C.DCGM_ST_OK {
+		loadedLibrary.Version = toVersionInfo(versionInfo).Version
+	}
+
+	libRefCount++
+	return nil
+}
+
+// releaseLibrary closes libdcgm once the last user has released it. The caller must hold libMux.
+func releaseLibrary() {
+	libRefCount--
+	if libRefCount > 0 {
+		return
+	}
+
+	closeLibrary()
+	loadedLibrary = LibraryInfo{}
+}
+
+// acquireCore initializes DCGM on first use. The caller must hold libMux.
+func acquireCore() error {
+	if coreRefCount > 0 {
+		coreRefCount++
+		return nil
+	}
+
+	result := C.dcgmInit()
+	if err := errorString(result); err != nil {
+		return fmt.Errorf("error initializing DCGM: %w", &Error{msg: C.GoString(C.errorString(result)), Code: result})
+	}
+
+	coreRefCount++
+	return nil
+}
+
+// releaseCore shuts DCGM down once the last user has released it. The caller must hold libMux.
+func releaseCore() error {
+	coreRefCount--
+	if coreRefCount > 0 {
+		return nil
+	}
+
+	result := C.dcgmShutdown()
+	if err := errorString(result); err != nil {
+		return fmt.Errorf("error shutting down DCGM: %w", &Error{msg: C.GoString(C.errorString(result)), Code: result})
+	}
+	return nil
+}
+
+// parseBuildInfo splits a DCGM raw build info string ("key:value;key:value") into its pairs
+func parseBuildInfo(raw string) map[string]string {
+	info := make(map[string]string)
+	for _, pair := range strings.Split(raw, ";") {
+		key, value, found := strings.Cut(pair, ":")
+		if !found {
+			continue
+		}
+		info[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return info
+}
@@ -0,0 +1,42 @@
+//go:build !dcgm_static
+
+package dcgm
+
+/*
+#cgo linux LDFLAGS: -ldl -Wl,--export-dynamic -Wl,--unresolved-symbols=ignore-in-object-files
+#cgo darwin LDFLAGS: -ldl -Wl,--export-dynamic -Wl,-undefined,dynamic_lookup
+
+#include <dlfcn.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+var dcgmLibHandle unsafe.Pointer
+
+// openLibrary dlopens the first of candidates that can be loaded and returns its name
+func openLibrary(candidates []string) (string, error) {
+	var loadErrors []string
+	for _, name := range candidates {
+		lib := C.CString(name)
+		dcgmLibHandle = C.dlopen(lib, C.RTLD_LAZY|C.RTLD_GLOBAL)
+		freeCString(lib)
+
+		if dcgmLibHandle != nil {
+			return name, nil
+		}
+		loadErrors = append(loadErrors, C.GoString(C.dlerror()))
+	}
+
+	return "", fmt.Errorf("%w: tried %s: %s", ErrLibraryNotFound, strings.Join(candidates, ", "), strings.Join(loadErrors, "; "))
+}
+
+// closeLibrary dlcloses the library opened by openLibrary
+func closeLibrary() {
+	C.dlclose(dcgmLibHandle)
+	dcgmLibHandle = nil
+}
@@ -0,0 +1,19 @@
+//go:build dcgm_static
+
+package dcgm
+
+/*
+#cgo LDFLAGS: -ldcgm
+*/
+import "C"
+
+// linkedLibraryName is reported as the library path when libdcgm is linked at build time
+const linkedLibraryName = "libdcgm (linked)"
+
+// openLibrary is a no-op when libdcgm is linked at build time
+func openLibrary([]string) (string, error) {
+	return linkedLibraryName, nil
+}
+
+// closeLibrary is a no-op when libdcgm is linked at build time
+func closeLibrary() {}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBuildInfo(t *testing.T) {
+	info := parseBuildInfo("version:4.1.1;arch:x86_64;buildid:12;commit:abc;builddate:2025-01-01 10:00:00")
+
+	assert.Equal(t, "4.1.1", info["version"])
+	assert.Equal(t, "x86_64", info["arch"])
+	assert.Equal(t, "2025-01-01 10:00:00", info["builddate"])
+	assert.NotContains(t, info, "branch")
+}
+
+func TestLibraryCandidates(t *testing.T) {
+	t.Setenv(LibraryPathEnv, "")
+	assert.Equal(t, defaultLibraryNames, libraryCandidates())
+
+	t.Setenv(LibraryPathEnv, "/opt/dcgm/libdcgm.so.4")
+	assert.Equal(t, []string{"/opt/dcgm/libdcgm.so.4"}, libraryCandidates())
+
+	SetLibraryPath("/usr/lib/libdcgm.so.3")
+	defer SetLibraryPath("")
+	assert.Equal(t, []string{"/usr/lib/libdcgm.so.3"}, libraryCandidates())
+}
@@ -0,0 +1,135 @@
+package dcgm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// LinkEntity returns the DCGM_FE_LINK entity of an NVLink. parentType is FE_SWITCH for the ports
+// of an NvSwitch or FE_GPU for the links of a GPU, parentID is the entity ID of the switch or GPU
+// and index is the link index.
+func LinkEntity(parentType Field_Entity_Group, parentID, index uint) Entity {
+	return Entity{Group: FE_LINK, ID: linkEntityID(parentType, parentID, index)}
+}
+
+// NvSwitchLinkEntity returns the DCGM_FE_LINK entity of a port of an NvSwitch
+func NvSwitchLinkEntity(switchID, index uint) Entity {
+	return LinkEntity(FE_SWITCH, switchID, index)
+}
+
+// Entity returns the DCGM_FE_LINK entity of the link
+func (s NvLinkStatus) Entity() Entity {
+	return LinkEntity(s.ParentType, s.ParentId, s.Index)
+}
+
+// SplitLinkEntityID returns the parent type, parent ID and link index packed into the entity ID of
+// a DCGM_FE_LINK entity, such as the EntityID of the values of a link field
+func SplitLinkEntityID(entityID uint) (parentType Field_Entity_Group, parentID, index uint) {
+	/* Only supported on little-endian systems currently */
+	var id [4]byte
+	binary.LittleEndian.PutUint32(id[:], uint32(entityID))
+	return Field_Entity_Group(id[0]), uint(id[2]), uint(id[1])
+}
+
+func linkEntityID(parentType Field_Entity_Group, parentID, index uint) uint {
+	/* Only supported on little-endian systems currently */
+	slice := []byte{uint8(parentType), uint8(index), uint8(parentID), 0}
+	return uint(binary.LittleEndian.Uint32(slice))
+}
+
+// GetNvSwitchLinkEntities returns the DCGM_FE_LINK entities of the supported ports of every
+// NvSwitch in the system
+func GetNvSwitchLinkEntities() ([]Entity, error) {
+	return defaultClient.GetNvSwitchLinkEntities()
+}
+
+// GetNvSwitchLinkEntities returns the DCGM_FE_LINK entities of the supported ports of every
+// NvSwitch in the system
+func (c *Client) GetNvSwitchLinkEntities() ([]Entity, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	links, err := c.getNvLinkLinkStatus()
+	if err != nil {
+		return nil, err
+	}
+	return switchLinkEntities(links), nil
+}
+
+func switchLinkEntities(links []NvLinkStatus) []Entity {
+	entities := make([]Entity, 0, len(links))
+	for _, link := range links {
+		if link.ParentType != FE_SWITCH || link.State == LS_NOT_SUPPORTED {
+			continue
+		}
+		entities = append(entities, link.Entity())
+	}
+	return entities
+}
+
+// WatchNvSwitchLinkFields starts watching link fields, such as DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS
+// or DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX, on every supported port of every NvSwitch. The ports
+// and fields are put in a new group and field group, which are returned and must be unwatched and
+// destroyed by the caller. The values of a port can be matched to it with SplitLinkEntityID.
+func WatchNvSwitchLinkFields(fields []Short, opts WatchOptions) (GroupHandle, FieldHandle, error) {
+	return defaultClient.WatchNvSwitchLinkFields(fields, opts)
+}
+
+// WatchNvSwitchLinkFields starts watching link fields, such as DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS
+// or DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX, on every supported port of every NvSwitch. The ports
+// and fields are put in a new group and field group, which are returned and must be unwatched and
+// destroyed by the caller. The values of a port can be matched to it with SplitLinkEntityID.
+func (c *Client) WatchNvSwitchLinkFields(fields []Short, opts WatchOptions) (GroupHandle, FieldHandle, error) {
+	if len(fields) == 0 {
+		return GroupHandle{}, FieldHandle{}, errors.New("no fields given")
+	}
+	if err := opts.validate(); err != nil {
+		return GroupHandle{}, FieldHandle{}, err
+	}
+
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, FieldHandle{}, err
+	}
+	defer c.endCall()
+
+	for _, field := range fields {
+		if level := FieldGetByID(field).EntityLevel; level != FE_LINK {
+			return GroupHandle{}, FieldHandle{}, fmt.Errorf("field %d is not a link field", field)
+		}
+	}
+
+	entities, err := c.GetNvSwitchLinkEntities()
+	if err != nil {
+		return GroupHandle{}, FieldHandle{}, err
+	}
+	if len(entities) == 0 {
+		return GroupHandle{}, FieldHandle{}, errors.New("no NvSwitch links found")
+	}
+
+	suffix := rand.Uint64()
+
+	fieldGroup, err := c.FieldGroupCreate(fmt.Sprintf("switchLinkFields%d", suffix), fields)
+	if err != nil {
+		return GroupHandle{}, FieldHandle{}, err
+	}
+	group, err := c.CreateGroup(fmt.Sprintf("switchLinks%d", suffix))
+	if err != nil {
+		_ = c.FieldGroupDestroy(fieldGroup)
+		return GroupHandle{}, FieldHandle{}, err
+	}
+
+	if err = c.AddEntitiesToGroup(group, entities...); err == nil {
+		err = c.WatchFieldsWithOptions(fieldGroup, group, opts)
+	}
+	if err != nil {
+		_ = c.DestroyGroup(group)
+		_ = c.FieldGroupDestroy(fieldGroup)
+		return GroupHandle{}, FieldHandle{}, err
+	}
+
+	return group, fieldGroup, nil
+}
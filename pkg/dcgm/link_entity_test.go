@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkEntity(t *testing.T) {
+	link := NvSwitchLinkEntity(3, 17)
+	assert.Equal(t, FE_LINK, link.Group)
+
+	parentType, parentID, index := SplitLinkEntityID(link.ID)
+	assert.Equal(t, FE_SWITCH, parentType)
+	assert.Equal(t, uint(3), parentID)
+	assert.Equal(t, uint(17), index)
+
+	links := []NvLinkStatus{
+		{ParentId: 0, ParentType: FE_GPU, State: LS_UP, Index: 0},
+		{ParentId: 3, ParentType: FE_SWITCH, State: LS_NOT_SUPPORTED, Index: 16},
+		{ParentId: 3, ParentType: FE_SWITCH, State: LS_UP, Index: 17},
+		{ParentId: 3, ParentType: FE_SWITCH, State: LS_DOWN, Index: 18},
+	}
+	assert.Equal(t, []Entity{link, NvSwitchLinkEntity(3, 18)}, switchLinkEntities(links))
+	assert.Equal(t, LinkEntity(FE_GPU, 0, 0), links[0].Entity())
+}
@@ -0,0 +1,95 @@
+package dcgm
+
+import (
+	"io"
+	"log"
+	"runtime"
+	"sync"
+)
+
+// ManagedGroup is a group that is destroyed by Close. Groups outlive the clients that create
+// them, so groups that are never destroyed accumulate in long-lived hostengines; a ManagedGroup
+// can also be destroyed automatically when its client is closed. Otherwise, with SetLeakDetection,
+// a warning is logged for ManagedGroups that are garbage collected without being closed.
+type ManagedGroup struct {
+	// Group is the handle of the group, to use with the other functions of the package
+	Group GroupHandle
+
+	client *Client
+	name   string
+	once   sync.Once
+	err    error
+}
+
+var _ io.Closer = (*ManagedGroup)(nil)
+
+// NewManagedGroup creates a group of the specified type and name, like GroupCreate. If
+// destroyOnClientClose is set, the group is destroyed when the client is closed if it was not
+// closed before.
+func NewManagedGroup(groupType GroupType, groupName string, destroyOnClientClose bool) (*ManagedGroup, error) {
+	return defaultClient.NewManagedGroup(groupType, groupName, destroyOnClientClose)
+}
+
+// NewManagedGroup creates a group of the specified type and name, like GroupCreate. If
+// destroyOnClientClose is set, the group is destroyed when the client is closed if it was not
+// closed before.
+func (c *Client) NewManagedGroup(groupType GroupType, groupName string, destroyOnClientClose bool) (*ManagedGroup, error) {
+	group, err := c.GroupCreate(groupType, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &ManagedGroup{Group: group, client: c, name: groupName}
+	if destroyOnClientClose {
+		// the client holds the group until it is closed, so it cannot be garbage collected and
+		// does not leak
+		c.hookMu.Lock()
+		if c.managedGroups == nil {
+			c.managedGroups = make(map[*ManagedGroup]struct{})
+		}
+		c.managedGroups[g] = struct{}{}
+		c.hookMu.Unlock()
+	} else if c.leaks != nil {
+		runtime.SetFinalizer(g, (*ManagedGroup).finalize)
+	}
+	return g, nil
+}
+
+// Close destroys the group. Only the first call destroys it; later calls return the same error.
+func (g *ManagedGroup) Close() error {
+	return g.close(g.client.GroupDestroy)
+}
+
+// close destroys the group with destroy, once
+func (g *ManagedGroup) close(destroy func(GroupHandle) error) error {
+	g.once.Do(func() {
+		runtime.SetFinalizer(g, nil)
+		g.client.hookMu.Lock()
+		delete(g.client.managedGroups, g)
+		g.client.hookMu.Unlock()
+
+		g.err = destroy(g.Group)
+	})
+	return g.err
+}
+
+func (g *ManagedGroup) finalize() {
+	log.Printf("dcgm: group %q was garbage collected without being closed", g.name)
+}
+
+// closeManagedGroups destroys the managed groups that are destroyed with the client with destroy.
+// Close calls it once the client is drained, so it cannot use the exported API.
+func (c *Client) closeManagedGroups(destroy func(GroupHandle) error) {
+	c.hookMu.Lock()
+	groups := make([]*ManagedGroup, 0, len(c.managedGroups))
+	for g := range c.managedGroups {
+		groups = append(groups, g)
+	}
+	c.hookMu.Unlock()
+
+	for _, g := range groups {
+		if err := g.close(destroy); err != nil {
+			log.Printf("dcgm: error destroying group %q on close: %v", g.name, err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package dcgm
+
+//go:generate go run gen_metrics.go
+
+// MetricType is how a field is exposed as a metric
+type MetricType int
+
+const (
+	// MetricGauge is a value that can go up and down, such as a temperature
+	MetricGauge MetricType = iota
+	// MetricCounter is a value that only increases, until it is reset, such as an error count
+	MetricCounter
+	// MetricInfo is a string, identifier, bitmask or structure that is not a time series, such as
+	// a UUID. Exporters usually expose it as a label.
+	MetricInfo
+)
+
+func (t MetricType) String() string {
+	switch t {
+	case MetricGauge:
+		return "gauge"
+	case MetricCounter:
+		return "counter"
+	case MetricInfo:
+		return "info"
+	}
+	return "unknown"
+}
+
+// MetricMeta is the canonical metric metadata of a field, so that exporters agree on how fields
+// are named and described
+type MetricMeta struct {
+	FieldID Short
+	// Name is the snake_case metric name: the field constant in lower case with "dcgm_" in place
+	// of "DCGM_FI_", e.g. "dcgm_dev_gpu_temp". It does not change between releases.
+	Name string
+	Help string
+	// Unit is the unit of the value, e.g. "C", "W" or "MiB"; empty if unitless or unknown
+	Unit string
+	Type MetricType
+}
+
+var metricMetasByField = func() map[Short]MetricMeta {
+	metas := make(map[Short]MetricMeta, len(metricMetas))
+	for _, meta := range metricMetas {
+		metas[meta.FieldID] = meta
+	}
+	return metas
+}()
+
+// GetMetricMeta returns the metric metadata of a field
+func GetMetricMeta(fieldID Short) (MetricMeta, bool) {
+	meta, ok := metricMetasByField[fieldID]
+	return meta, ok
+}
+
+// MetricMetas returns the metric metadata of every field known to this package, in field ID order
+func MetricMetas() []MetricMeta {
+	metas := make([]MetricMeta, len(metricMetas))
+	copy(metas, metricMetas)
+	return metas
+}
@@ -0,0 +1,544 @@
+// Code generated by gen_metrics.go; DO NOT EDIT.
+
+package dcgm
+
+var metricMetas = []MetricMeta{
+	{FieldID: DCGM_FI_DRIVER_VERSION, Name: "dcgm_driver_version", Help: "The driver version string", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_NVML_VERSION, Name: "dcgm_nvml_version", Help: "The underlying NVML version string", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_PROCESS_NAME, Name: "dcgm_process_name", Help: "The process name", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_COUNT, Name: "dcgm_dev_count", Help: "The number of devices on the node", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_CUDA_DRIVER_VERSION, Name: "dcgm_cuda_driver_version", Help: "The CUDA driver version. Retrieves a number with the major value in the thousands place and the minor value in the hundreds place. (e.g. CUDA 11.1 = 11100)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NAME, Name: "dcgm_dev_name", Help: "The name of the GPU device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_BRAND, Name: "dcgm_dev_brand", Help: "The device brand", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVML_INDEX, Name: "dcgm_dev_nvml_index", Help: "The NVML index of this GPU", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_SERIAL, Name: "dcgm_dev_serial", Help: "The device serial number", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_UUID, Name: "dcgm_dev_uuid", Help: "The UUID corresponding to the device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MINOR_NUMBER, Name: "dcgm_dev_minor_number", Help: "The device node minor number (/dev/nvidia#)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_OEM_INFOROM_VER, Name: "dcgm_dev_oem_inforom_ver", Help: "The OEM inforom version", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PCI_BUSID, Name: "dcgm_dev_pci_busid", Help: "The PCI attributes for the device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PCI_COMBINED_ID, Name: "dcgm_dev_pci_combined_id", Help: "The combined 16-bit device id and 16-bit vendor id", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PCI_SUBSYS_ID, Name: "dcgm_dev_pci_subsys_id", Help: "The 32-bit Sub System Device ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_GPU_TOPOLOGY_PCI, Name: "dcgm_gpu_topology_pci", Help: "The topology of all GPUs on the system via PCI (static)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_GPU_TOPOLOGY_NVLINK, Name: "dcgm_gpu_topology_nvlink", Help: "The topology of all GPUs on the system via NVLINK (static)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_GPU_TOPOLOGY_AFFINITY, Name: "dcgm_gpu_topology_affinity", Help: "The affinity of all GPUs on the system (static)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CUDA_COMPUTE_CAPABILITY, Name: "dcgm_dev_cuda_compute_capability", Help: "The CUDA compute capability for the device. The major version is the upper 32 bits and the minor version is the lower 32 bits", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_COMPUTE_MODE, Name: "dcgm_dev_compute_mode", Help: "The compute mode for the device", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PERSISTENCE_MODE, Name: "dcgm_dev_persistence_mode", Help: "The persistence mode for the device. Boolean: 0 is disabled, 1 is enabled", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MIG_MODE, Name: "dcgm_dev_mig_mode", Help: "The MIG mode for the device. Boolean: 0 is disabled, 1 is enabled", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CUDA_VISIBLE_DEVICES_STR, Name: "dcgm_dev_cuda_visible_devices_str", Help: "The string that CUDA_VISIBLE_DEVICES should be set to for this entity (including MIG)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MIG_MAX_SLICES, Name: "dcgm_dev_mig_max_slices", Help: "The maximum number of MIG slices supported by this GPU", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_AFFINITY_0, Name: "dcgm_dev_cpu_affinity_0", Help: "The device CPU affinity for CPUs 0-63", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CPU_AFFINITY_1, Name: "dcgm_dev_cpu_affinity_1", Help: "The device CPU affinity for CPUs 64-127", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CPU_AFFINITY_2, Name: "dcgm_dev_cpu_affinity_2", Help: "The device CPU affinity for CPUs 128-191", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CPU_AFFINITY_3, Name: "dcgm_dev_cpu_affinity_3", Help: "The device CPU affinity for CPUs 192-255", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CC_MODE, Name: "dcgm_dev_cc_mode", Help: "The ConfidentialCompute/AmpereProtectedMemory status. 0 = disabled, 1 = enabled", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MIG_ATTRIBUTES, Name: "dcgm_dev_mig_attributes", Help: "The attributes for the given MIG device handles", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MIG_GI_INFO, Name: "dcgm_dev_mig_gi_info", Help: "The GPU instance profile information", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MIG_CI_INFO, Name: "dcgm_dev_mig_ci_info", Help: "The compute instance profile information", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_ECC_INFOROM_VER, Name: "dcgm_dev_ecc_inforom_ver", Help: "The ECC inforom version", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_POWER_INFOROM_VER, Name: "dcgm_dev_power_inforom_ver", Help: "The power management object inforom version", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_INFOROM_IMAGE_VER, Name: "dcgm_dev_inforom_image_ver", Help: "The inforom image version", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_INFOROM_CONFIG_CHECK, Name: "dcgm_dev_inforom_config_check", Help: "The inforom configuration checksum", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_INFOROM_CONFIG_VALID, Name: "dcgm_dev_inforom_config_valid", Help: "Whether the inforom configuration is valid. Reads the infoROM from the flash and verifies the checksums", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_VBIOS_VERSION, Name: "dcgm_dev_vbios_version", Help: "The VBIOS version of the device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MEM_AFFINITY_0, Name: "dcgm_dev_mem_affinity_0", Help: "The device memory node affinity for nodes 0-63", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MEM_AFFINITY_1, Name: "dcgm_dev_mem_affinity_1", Help: "The device memory node affinity for nodes 64-127", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MEM_AFFINITY_2, Name: "dcgm_dev_mem_affinity_2", Help: "The device memory node affinity for nodes 128-191", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MEM_AFFINITY_3, Name: "dcgm_dev_mem_affinity_3", Help: "The device memory node affinity for nodes 192-255", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_BAR1_TOTAL, Name: "dcgm_dev_bar1_total", Help: "The total BAR1 memory of the GPU in MB", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_SYNC_BOOST, Name: "dcgm_sync_boost", Help: "The sync boost settings on the node (Deprecated)", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BAR1_USED, Name: "dcgm_dev_bar1_used", Help: "The used BAR1 memory of the GPU in MB", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BAR1_FREE, Name: "dcgm_dev_bar1_free", Help: "The free BAR1 memory of the GPU in MB", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_GPM_SUPPORT, Name: "dcgm_dev_gpm_support", Help: "The GPM support for the device", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_SM_CLOCK, Name: "dcgm_dev_sm_clock", Help: "The SM clock for the device", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MEM_CLOCK, Name: "dcgm_dev_mem_clock", Help: "The memory clock for the device", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_VIDEO_CLOCK, Name: "dcgm_dev_video_clock", Help: "The video encoder/decoder clock for the device", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_APP_SM_CLOCK, Name: "dcgm_dev_app_sm_clock", Help: "The SM application clocks", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_APP_MEM_CLOCK, Name: "dcgm_dev_app_mem_clock", Help: "The memory application clocks", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CLOCKS_EVENT_REASONS, Name: "dcgm_dev_clocks_event_reasons", Help: "The current clock event reasons (bitmask of DCGM_CLOCKS_EVENT_REASON_*)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MAX_SM_CLOCK, Name: "dcgm_dev_max_sm_clock", Help: "The maximum supported SM clock for the device", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MAX_MEM_CLOCK, Name: "dcgm_dev_max_mem_clock", Help: "The maximum supported memory clock for the device", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MAX_VIDEO_CLOCK, Name: "dcgm_dev_max_video_clock", Help: "The maximum supported video encoder/decoder clock for the device", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_AUTOBOOST, Name: "dcgm_dev_autoboost", Help: "The auto-boost setting for the device (1 = enabled, 0 = disabled)", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_SUPPORTED_CLOCKS, Name: "dcgm_dev_supported_clocks", Help: "The supported clocks for the device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_MEMORY_TEMP, Name: "dcgm_dev_memory_temp", Help: "The memory temperature for the device", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_GPU_TEMP, Name: "dcgm_dev_gpu_temp", Help: "The current temperature readings for the device, in degrees C", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MEM_MAX_OP_TEMP, Name: "dcgm_dev_mem_max_op_temp", Help: "The maximum operating temperature for the memory of this GPU", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_GPU_MAX_OP_TEMP, Name: "dcgm_dev_gpu_max_op_temp", Help: "The maximum operating temperature for this GPU", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_GPU_TEMP_LIMIT, Name: "dcgm_dev_gpu_temp_limit", Help: "The thermal margin temperature (distance to nearest slowdown threshold) for this GPU", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_POWER_USAGE, Name: "dcgm_dev_power_usage", Help: "The power usage for the device in Watts", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION, Name: "dcgm_dev_total_energy_consumption", Help: "The total energy consumption for the GPU in mJ since the driver was last reloaded", Unit: "mJ", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_POWER_USAGE_INSTANT, Name: "dcgm_dev_power_usage_instant", Help: "The current instantaneous power usage of the device in Watts", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_SLOWDOWN_TEMP, Name: "dcgm_dev_slowdown_temp", Help: "The slowdown temperature for the device", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_SHUTDOWN_TEMP, Name: "dcgm_dev_shutdown_temp", Help: "The shutdown temperature for the device", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_POWER_MGMT_LIMIT, Name: "dcgm_dev_power_mgmt_limit", Help: "The current power limit for the device", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_POWER_MGMT_LIMIT_MIN, Name: "dcgm_dev_power_mgmt_limit_min", Help: "The minimum power management limit for the device", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_POWER_MGMT_LIMIT_MAX, Name: "dcgm_dev_power_mgmt_limit_max", Help: "The maximum power management limit for the device", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_POWER_MGMT_LIMIT_DEF, Name: "dcgm_dev_power_mgmt_limit_def", Help: "The default power management limit for the device", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ENFORCED_POWER_LIMIT, Name: "dcgm_dev_enforced_power_limit", Help: "The effective power limit that the driver enforces after taking into account all limiters", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_REQUESTED_POWER_PROFILE_MASK, Name: "dcgm_dev_requested_power_profile_mask", Help: "The requested workload power profile mask (Blackwell and newer)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_ENFORCED_POWER_PROFILE_MASK, Name: "dcgm_dev_enforced_power_profile_mask", Help: "The enforced workload power profile mask (Blackwell and newer)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VALID_POWER_PROFILE_MASK, Name: "dcgm_dev_valid_power_profile_mask", Help: "The valid workload power profile mask (Blackwell and newer)", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_FABRIC_MANAGER_STATUS, Name: "dcgm_dev_fabric_manager_status", Help: "The value for fabric manager status", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_FABRIC_MANAGER_ERROR_CODE, Name: "dcgm_dev_fabric_manager_error_code", Help: "The value for fabric manager error code NOTE: this is not populated unless the fabric manager completed startup", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_FABRIC_CLUSTER_UUID, Name: "dcgm_dev_fabric_cluster_uuid", Help: "The value for fabric cluster UUID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_FABRIC_CLIQUE_ID, Name: "dcgm_dev_fabric_clique_id", Help: "The value for fabric clique ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PSTATE, Name: "dcgm_dev_pstate", Help: "The value for P-state", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_FAN_SPEED, Name: "dcgm_dev_fan_speed", Help: "The value for fan speed", Unit: "%", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_TX_THROUGHPUT, Name: "dcgm_dev_pcie_tx_throughput", Help: "The PCIe transmit throughput in KB/s", Unit: "KB/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_RX_THROUGHPUT, Name: "dcgm_dev_pcie_rx_throughput", Help: "The PCIe receive throughput in KB/s", Unit: "KB/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_REPLAY_COUNTER, Name: "dcgm_dev_pcie_replay_counter", Help: "The PCIe replay counter value", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_GPU_UTIL, Name: "dcgm_dev_gpu_util", Help: "The GPU utilization in percent", Unit: "%", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MEM_COPY_UTIL, Name: "dcgm_dev_mem_copy_util", Help: "The memory copy utilization in percent", Unit: "%", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ACCOUNTING_DATA, Name: "dcgm_dev_accounting_data", Help: "The process accounting information", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_ENC_UTIL, Name: "dcgm_dev_enc_util", Help: "The encoder utilization in percent", Unit: "%", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DEC_UTIL, Name: "dcgm_dev_dec_util", Help: "The decoder utilization in percent", Unit: "%", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_XID_ERRORS, Name: "dcgm_dev_xid_errors", Help: "The value for XID errors", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_MAX_LINK_GEN, Name: "dcgm_dev_pcie_max_link_gen", Help: "The value for PCIe max link generation", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH, Name: "dcgm_dev_pcie_max_link_width", Help: "The value for PCIe max link width", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_LINK_GEN, Name: "dcgm_dev_pcie_link_gen", Help: "The value for PCIe link generation", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_PCIE_LINK_WIDTH, Name: "dcgm_dev_pcie_link_width", Help: "The value for PCIe link width", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_POWER_VIOLATION, Name: "dcgm_dev_power_violation", Help: "The value for power violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_THERMAL_VIOLATION, Name: "dcgm_dev_thermal_violation", Help: "The value for thermal violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_SYNC_BOOST_VIOLATION, Name: "dcgm_dev_sync_boost_violation", Help: "The value for sync boost violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_BOARD_LIMIT_VIOLATION, Name: "dcgm_dev_board_limit_violation", Help: "The value for board limit violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_LOW_UTIL_VIOLATION, Name: "dcgm_dev_low_util_violation", Help: "The value for low utilization violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_RELIABILITY_VIOLATION, Name: "dcgm_dev_reliability_violation", Help: "The value for reliability violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_TOTAL_APP_CLOCKS_VIOLATION, Name: "dcgm_dev_total_app_clocks_violation", Help: "The value for total application clocks violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_TOTAL_BASE_CLOCKS_VIOLATION, Name: "dcgm_dev_total_base_clocks_violation", Help: "The value for total base clocks violation time in microseconds", Unit: "us", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_FB_TOTAL, Name: "dcgm_dev_fb_total", Help: "The value for framebuffer total", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_FB_FREE, Name: "dcgm_dev_fb_free", Help: "The value for framebuffer free", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_FB_USED, Name: "dcgm_dev_fb_used", Help: "The value for framebuffer used", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_FB_RESERVED, Name: "dcgm_dev_fb_reserved", Help: "The value for framebuffer reserved", Unit: "MiB", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_FB_USED_PERCENT, Name: "dcgm_dev_fb_used_percent", Help: "The value for framebuffer used percent", Unit: "%", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_C2C_LINK_COUNT, Name: "dcgm_dev_c2c_link_count", Help: "The value for C2C link count", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_C2C_LINK_STATUS, Name: "dcgm_dev_c2c_link_status", Help: "The value for C2C link status", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_C2C_MAX_BANDWIDTH, Name: "dcgm_dev_c2c_max_bandwidth", Help: "The value for C2C max bandwidth", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ECC_CURRENT, Name: "dcgm_dev_ecc_current", Help: "The value for ECC current", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ECC_PENDING, Name: "dcgm_dev_ecc_pending", Help: "The value for ECC pending", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_TOTAL, Name: "dcgm_dev_ecc_sbe_vol_total", Help: "The total number of single-bit ECC errors detected since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, Name: "dcgm_dev_ecc_dbe_vol_total", Help: "The total number of double-bit ECC errors detected since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_TOTAL, Name: "dcgm_dev_ecc_sbe_agg_total", Help: "The total number of single-bit ECC errors detected since the last counter reset (aggregate)", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_TOTAL, Name: "dcgm_dev_ecc_dbe_agg_total", Help: "The total number of double-bit ECC errors detected since the last counter reset (aggregate)", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_L1, Name: "dcgm_dev_ecc_sbe_vol_l1", Help: "The number of single-bit ECC errors detected in L1 cache since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_L1, Name: "dcgm_dev_ecc_dbe_vol_l1", Help: "The number of double-bit ECC errors detected in L1 cache since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_L2, Name: "dcgm_dev_ecc_sbe_vol_l2", Help: "The number of single-bit ECC errors detected in L2 cache since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_L2, Name: "dcgm_dev_ecc_dbe_vol_l2", Help: "The number of double-bit ECC errors detected in L2 cache since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_DEV, Name: "dcgm_dev_ecc_sbe_vol_dev", Help: "The number of single-bit ECC errors detected in device memory since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_DEV, Name: "dcgm_dev_ecc_dbe_vol_dev", Help: "The number of double-bit ECC errors detected in device memory since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_REG, Name: "dcgm_dev_ecc_sbe_vol_reg", Help: "The number of single-bit ECC errors detected in register file since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_REG, Name: "dcgm_dev_ecc_dbe_vol_reg", Help: "The number of double-bit ECC errors detected in register file since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_TEX, Name: "dcgm_dev_ecc_sbe_vol_tex", Help: "The number of single-bit ECC errors detected in texture memory since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_TEX, Name: "dcgm_dev_ecc_dbe_vol_tex", Help: "The number of double-bit ECC errors detected in texture memory since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_L1, Name: "dcgm_dev_ecc_sbe_agg_l1", Help: "The aggregate number of single-bit ECC errors detected in L1 cache", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_L1, Name: "dcgm_dev_ecc_dbe_agg_l1", Help: "The aggregate number of double-bit ECC errors detected in L1 cache", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_L2, Name: "dcgm_dev_ecc_sbe_agg_l2", Help: "The aggregate number of single-bit ECC errors detected in L2 cache", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_L2, Name: "dcgm_dev_ecc_dbe_agg_l2", Help: "The aggregate number of double-bit ECC errors detected in L2 cache", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_DEV, Name: "dcgm_dev_ecc_sbe_agg_dev", Help: "The aggregate number of single-bit ECC errors detected in device memory", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_DEV, Name: "dcgm_dev_ecc_dbe_agg_dev", Help: "The aggregate number of double-bit ECC errors detected in device memory", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_REG, Name: "dcgm_dev_ecc_sbe_agg_reg", Help: "The aggregate number of single-bit ECC errors detected in register file", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_REG, Name: "dcgm_dev_ecc_dbe_agg_reg", Help: "The aggregate number of double-bit ECC errors detected in register file", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_TEX, Name: "dcgm_dev_ecc_sbe_agg_tex", Help: "The aggregate number of single-bit ECC errors detected in texture memory", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_TEX, Name: "dcgm_dev_ecc_dbe_agg_tex", Help: "The aggregate number of double-bit ECC errors detected in texture memory", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_SHM, Name: "dcgm_dev_ecc_sbe_vol_shm", Help: "The number of single-bit ECC errors detected in shared memory since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_SHM, Name: "dcgm_dev_ecc_dbe_vol_shm", Help: "The number of double-bit ECC errors detected in shared memory since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_CBU, Name: "dcgm_dev_ecc_sbe_vol_cbu", Help: "The number of single-bit ECC errors detected in CBU since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_CBU, Name: "dcgm_dev_ecc_dbe_vol_cbu", Help: "The number of double-bit ECC errors detected in CBU since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_SHM, Name: "dcgm_dev_ecc_sbe_agg_shm", Help: "The aggregate number of single-bit ECC errors detected in shared memory", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_SHM, Name: "dcgm_dev_ecc_dbe_agg_shm", Help: "The aggregate number of double-bit ECC errors detected in shared memory", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_CBU, Name: "dcgm_dev_ecc_sbe_agg_cbu", Help: "The aggregate number of single-bit ECC errors detected in CBU", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_CBU, Name: "dcgm_dev_ecc_dbe_agg_cbu", Help: "The aggregate number of double-bit ECC errors detected in CBU", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_VOL_SRM, Name: "dcgm_dev_ecc_sbe_vol_srm", Help: "The number of single-bit ECC errors detected in SRM since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_VOL_SRM, Name: "dcgm_dev_ecc_dbe_vol_srm", Help: "The number of double-bit ECC errors detected in SRM since the last counter reset", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_SBE_AGG_SRM, Name: "dcgm_dev_ecc_sbe_agg_srm", Help: "The aggregate number of single-bit ECC errors detected in SRM", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_ECC_DBE_AGG_SRM, Name: "dcgm_dev_ecc_dbe_agg_srm", Help: "The aggregate number of double-bit ECC errors detected in SRM", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_DIAG_MEMORY_RESULT, Name: "dcgm_dev_diag_memory_result", Help: "The value for ECC memory result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_DIAGNOSTIC_RESULT, Name: "dcgm_dev_diag_diagnostic_result", Help: "The value for ECC diagnostic result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_PCIE_RESULT, Name: "dcgm_dev_diag_pcie_result", Help: "The value for ECC PCIe result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_TARGETED_STRESS_RESULT, Name: "dcgm_dev_diag_targeted_stress_result", Help: "The value for ECC targeted stress result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_TARGETED_POWER_RESULT, Name: "dcgm_dev_diag_targeted_power_result", Help: "The value for ECC targeted power result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_MEMORY_BANDWIDTH_RESULT, Name: "dcgm_dev_diag_memory_bandwidth_result", Help: "The value for ECC memory bandwidth result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_MEMTEST_RESULT, Name: "dcgm_dev_diag_memtest_result", Help: "The value for ECC memtest result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_PULSE_TEST_RESULT, Name: "dcgm_dev_diag_pulse_test_result", Help: "The value for ECC pulse test result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_EUD_RESULT, Name: "dcgm_dev_diag_eud_result", Help: "The value for ECC EUD result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_CPU_EUD_RESULT, Name: "dcgm_dev_diag_cpu_eud_result", Help: "The value for ECC CPU EUD result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_SOFTWARE_RESULT, Name: "dcgm_dev_diag_software_result", Help: "The value for ECC software result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_NVBANDWIDTH_RESULT, Name: "dcgm_dev_diag_nvbandwidth_result", Help: "The value for ECC NVBandwidth result", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_DIAG_STATUS, Name: "dcgm_dev_diag_status", Help: "The value for ECC status", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BANKS_REMAP_ROWS_AVAIL_MAX, Name: "dcgm_dev_banks_remap_rows_avail_max", Help: "The value for ECC banks remap rows avail max", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BANKS_REMAP_ROWS_AVAIL_HIGH, Name: "dcgm_dev_banks_remap_rows_avail_high", Help: "The value for ECC banks remap rows avail high", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BANKS_REMAP_ROWS_AVAIL_PARTIAL, Name: "dcgm_dev_banks_remap_rows_avail_partial", Help: "The value for ECC banks remap rows avail partial", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BANKS_REMAP_ROWS_AVAIL_LOW, Name: "dcgm_dev_banks_remap_rows_avail_low", Help: "The value for ECC banks remap rows avail low", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_BANKS_REMAP_ROWS_AVAIL_NONE, Name: "dcgm_dev_banks_remap_rows_avail_none", Help: "The value for ECC banks remap rows avail none", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_RETIRED_SBE, Name: "dcgm_dev_retired_sbe", Help: "The value for ECC retired SBE", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_RETIRED_DBE, Name: "dcgm_dev_retired_dbe", Help: "The value for ECC retired DBE", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_RETIRED_PENDING, Name: "dcgm_dev_retired_pending", Help: "The value for ECC retired pending", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_UNCORRECTABLE_REMAPPED_ROWS, Name: "dcgm_dev_uncorrectable_remapped_rows", Help: "The value for ECC uncorrectable remapped rows", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CORRECTABLE_REMAPPED_ROWS, Name: "dcgm_dev_correctable_remapped_rows", Help: "The value for ECC correctable remapped rows", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ROW_REMAP_FAILURE, Name: "dcgm_dev_row_remap_failure", Help: "The value for ECC row remap failure", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_ROW_REMAP_PENDING, Name: "dcgm_dev_row_remap_pending", Help: "The value for ECC row remap pending", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L0, Name: "dcgm_dev_nvlink_crc_flit_error_count_l0", Help: "The value for ECC NVLink CRC FLIT error count L0", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L1, Name: "dcgm_dev_nvlink_crc_flit_error_count_l1", Help: "The value for ECC NVLink CRC FLIT error count L1", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L2, Name: "dcgm_dev_nvlink_crc_flit_error_count_l2", Help: "The value for ECC NVLink CRC FLIT error count L2", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L3, Name: "dcgm_dev_nvlink_crc_flit_error_count_l3", Help: "The value for ECC NVLink CRC FLIT error count L3", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L4, Name: "dcgm_dev_nvlink_crc_flit_error_count_l4", Help: "The value for ECC NVLink CRC FLIT error count L4", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L5, Name: "dcgm_dev_nvlink_crc_flit_error_count_l5", Help: "The value for ECC NVLink CRC FLIT error count L5", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L12, Name: "dcgm_dev_nvlink_crc_flit_error_count_l12", Help: "The value for ECC NVLink CRC FLIT error count L12", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L13, Name: "dcgm_dev_nvlink_crc_flit_error_count_l13", Help: "The value for ECC NVLink CRC FLIT error count L13", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L14, Name: "dcgm_dev_nvlink_crc_flit_error_count_l14", Help: "The value for ECC NVLink CRC FLIT error count L14", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL, Name: "dcgm_dev_nvlink_crc_flit_error_count_total", Help: "The value for ECC NVLink CRC FLIT error count total", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L0, Name: "dcgm_dev_nvlink_crc_data_error_count_l0", Help: "The value for ECC NVLink CRC DATA error count L0", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L1, Name: "dcgm_dev_nvlink_crc_data_error_count_l1", Help: "The value for ECC NVLink CRC DATA error count L1", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L2, Name: "dcgm_dev_nvlink_crc_data_error_count_l2", Help: "The value for ECC NVLink CRC DATA error count L2", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L3, Name: "dcgm_dev_nvlink_crc_data_error_count_l3", Help: "The value for ECC NVLink CRC DATA error count L3", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L4, Name: "dcgm_dev_nvlink_crc_data_error_count_l4", Help: "The value for ECC NVLink CRC DATA error count L4", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L5, Name: "dcgm_dev_nvlink_crc_data_error_count_l5", Help: "The value for ECC NVLink CRC DATA error count L5", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L12, Name: "dcgm_dev_nvlink_crc_data_error_count_l12", Help: "The value for ECC NVLink CRC DATA error count L12", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L13, Name: "dcgm_dev_nvlink_crc_data_error_count_l13", Help: "The value for ECC NVLink CRC DATA error count L13", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L14, Name: "dcgm_dev_nvlink_crc_data_error_count_l14", Help: "The value for ECC NVLink CRC DATA error count L14", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_TOTAL, Name: "dcgm_dev_nvlink_crc_data_error_count_total", Help: "The value for ECC NVLink CRC DATA error count total", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L0, Name: "dcgm_dev_nvlink_replay_error_count_l0", Help: "The value for ECC NVLink replay error count L0", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L1, Name: "dcgm_dev_nvlink_replay_error_count_l1", Help: "The value for ECC NVLink replay error count L1", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L2, Name: "dcgm_dev_nvlink_replay_error_count_l2", Help: "The value for ECC NVLink replay error count L2", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L3, Name: "dcgm_dev_nvlink_replay_error_count_l3", Help: "The value for ECC NVLink replay error count L3", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L4, Name: "dcgm_dev_nvlink_replay_error_count_l4", Help: "The value for ECC NVLink replay error count L4", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L5, Name: "dcgm_dev_nvlink_replay_error_count_l5", Help: "The value for ECC NVLink replay error count L5", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L12, Name: "dcgm_dev_nvlink_replay_error_count_l12", Help: "The value for ECC NVLink replay error count L12", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L13, Name: "dcgm_dev_nvlink_replay_error_count_l13", Help: "The value for ECC NVLink replay error count L13", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L14, Name: "dcgm_dev_nvlink_replay_error_count_l14", Help: "The value for ECC NVLink replay error count L14", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_TOTAL, Name: "dcgm_dev_nvlink_replay_error_count_total", Help: "The value for ECC NVLink replay error count total", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L0, Name: "dcgm_dev_nvlink_recovery_error_count_l0", Help: "The value for ECC NVLink recovery error count L0", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L1, Name: "dcgm_dev_nvlink_recovery_error_count_l1", Help: "The value for ECC NVLink recovery error count L1", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L2, Name: "dcgm_dev_nvlink_recovery_error_count_l2", Help: "The value for ECC NVLink recovery error count L2", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L3, Name: "dcgm_dev_nvlink_recovery_error_count_l3", Help: "The value for ECC NVLink recovery error count L3", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L4, Name: "dcgm_dev_nvlink_recovery_error_count_l4", Help: "The value for ECC NVLink recovery error count L4", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L5, Name: "dcgm_dev_nvlink_recovery_error_count_l5", Help: "The value for ECC NVLink recovery error count L5", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L12, Name: "dcgm_dev_nvlink_recovery_error_count_l12", Help: "The value for ECC NVLink recovery error count L12", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L13, Name: "dcgm_dev_nvlink_recovery_error_count_l13", Help: "The value for ECC NVLink recovery error count L13", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L14, Name: "dcgm_dev_nvlink_recovery_error_count_l14", Help: "The value for ECC NVLink recovery error count L14", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_TOTAL, Name: "dcgm_dev_nvlink_recovery_error_count_total", Help: "The value for ECC NVLink recovery error count total", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L0, Name: "dcgm_dev_nvlink_bandwidth_l0", Help: "The value for ECC NVLink bandwidth L0", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L1, Name: "dcgm_dev_nvlink_bandwidth_l1", Help: "The value for ECC NVLink bandwidth L1", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L2, Name: "dcgm_dev_nvlink_bandwidth_l2", Help: "The value for ECC NVLink bandwidth L2", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L3, Name: "dcgm_dev_nvlink_bandwidth_l3", Help: "The value for ECC NVLink bandwidth L3", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L4, Name: "dcgm_dev_nvlink_bandwidth_l4", Help: "The value for ECC NVLink bandwidth L4", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L5, Name: "dcgm_dev_nvlink_bandwidth_l5", Help: "The value for ECC NVLink bandwidth L5", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L12, Name: "dcgm_dev_nvlink_bandwidth_l12", Help: "The value for ECC NVLink bandwidth L12", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L13, Name: "dcgm_dev_nvlink_bandwidth_l13", Help: "The value for ECC NVLink bandwidth L13", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L14, Name: "dcgm_dev_nvlink_bandwidth_l14", Help: "The value for ECC NVLink bandwidth L14", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_TOTAL, Name: "dcgm_dev_nvlink_bandwidth_total", Help: "The value for ECC NVLink bandwidth total", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_GPU_NVLINK_ERRORS, Name: "dcgm_dev_gpu_nvlink_errors", Help: "The value for GPU NVLink error information", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L6, Name: "dcgm_dev_nvlink_crc_flit_error_count_l6", Help: "The value for ECC NVLink CRC FLIT error count L6", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L7, Name: "dcgm_dev_nvlink_crc_flit_error_count_l7", Help: "The value for ECC NVLink CRC FLIT error count L7", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L8, Name: "dcgm_dev_nvlink_crc_flit_error_count_l8", Help: "The value for ECC NVLink CRC FLIT error count L8", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L9, Name: "dcgm_dev_nvlink_crc_flit_error_count_l9", Help: "The value for ECC NVLink CRC FLIT error count L9", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L10, Name: "dcgm_dev_nvlink_crc_flit_error_count_l10", Help: "The value for ECC NVLink CRC FLIT error count L10", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L11, Name: "dcgm_dev_nvlink_crc_flit_error_count_l11", Help: "The value for ECC NVLink CRC FLIT error count L11", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L6, Name: "dcgm_dev_nvlink_crc_data_error_count_l6", Help: "The value for ECC NVLink CRC DATA error count L6", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L7, Name: "dcgm_dev_nvlink_crc_data_error_count_l7", Help: "The value for ECC NVLink CRC DATA error count L7", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L8, Name: "dcgm_dev_nvlink_crc_data_error_count_l8", Help: "The value for ECC NVLink CRC DATA error count L8", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L9, Name: "dcgm_dev_nvlink_crc_data_error_count_l9", Help: "The value for ECC NVLink CRC DATA error count L9", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L10, Name: "dcgm_dev_nvlink_crc_data_error_count_l10", Help: "The value for ECC NVLink CRC DATA error count L10", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L11, Name: "dcgm_dev_nvlink_crc_data_error_count_l11", Help: "The value for ECC NVLink CRC DATA error count L11", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L6, Name: "dcgm_dev_nvlink_replay_error_count_l6", Help: "The value for ECC NVLink replay error count L6", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L7, Name: "dcgm_dev_nvlink_replay_error_count_l7", Help: "The value for ECC NVLink replay error count L7", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L8, Name: "dcgm_dev_nvlink_replay_error_count_l8", Help: "The value for ECC NVLink replay error count L8", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L9, Name: "dcgm_dev_nvlink_replay_error_count_l9", Help: "The value for ECC NVLink replay error count L9", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L10, Name: "dcgm_dev_nvlink_replay_error_count_l10", Help: "The value for ECC NVLink replay error count L10", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L11, Name: "dcgm_dev_nvlink_replay_error_count_l11", Help: "The value for ECC NVLink replay error count L11", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L6, Name: "dcgm_dev_nvlink_recovery_error_count_l6", Help: "The value for ECC NVLink recovery error count L6", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L7, Name: "dcgm_dev_nvlink_recovery_error_count_l7", Help: "The value for ECC NVLink recovery error count L7", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L8, Name: "dcgm_dev_nvlink_recovery_error_count_l8", Help: "The value for ECC NVLink recovery error count L8", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L9, Name: "dcgm_dev_nvlink_recovery_error_count_l9", Help: "The value for ECC NVLink recovery error count L9", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L10, Name: "dcgm_dev_nvlink_recovery_error_count_l10", Help: "The value for ECC NVLink recovery error count L10", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L11, Name: "dcgm_dev_nvlink_recovery_error_count_l11", Help: "The value for ECC NVLink recovery error count L11", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L6, Name: "dcgm_dev_nvlink_bandwidth_l6", Help: "The value for ECC NVLink bandwidth L6", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L7, Name: "dcgm_dev_nvlink_bandwidth_l7", Help: "The value for ECC NVLink bandwidth L7", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L8, Name: "dcgm_dev_nvlink_bandwidth_l8", Help: "The value for ECC NVLink bandwidth L8", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L9, Name: "dcgm_dev_nvlink_bandwidth_l9", Help: "The value for ECC NVLink bandwidth L9", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L10, Name: "dcgm_dev_nvlink_bandwidth_l10", Help: "The value for ECC NVLink bandwidth L10", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L11, Name: "dcgm_dev_nvlink_bandwidth_l11", Help: "The value for ECC NVLink bandwidth L11", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L15, Name: "dcgm_dev_nvlink_crc_flit_error_count_l15", Help: "The value for ECC NVLink CRC FLIT error count L15", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L16, Name: "dcgm_dev_nvlink_crc_flit_error_count_l16", Help: "The value for ECC NVLink CRC FLIT error count L16", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L17, Name: "dcgm_dev_nvlink_crc_flit_error_count_l17", Help: "The value for ECC NVLink CRC FLIT error count L17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L15, Name: "dcgm_dev_nvlink_crc_data_error_count_l15", Help: "The value for ECC NVLink CRC DATA error count L15", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L16, Name: "dcgm_dev_nvlink_crc_data_error_count_l16", Help: "The value for ECC NVLink CRC DATA error count L16", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L17, Name: "dcgm_dev_nvlink_crc_data_error_count_l17", Help: "The value for ECC NVLink CRC DATA error count L17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L15, Name: "dcgm_dev_nvlink_replay_error_count_l15", Help: "The value for ECC NVLink replay error count L15", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L16, Name: "dcgm_dev_nvlink_replay_error_count_l16", Help: "The value for ECC NVLink replay error count L16", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L17, Name: "dcgm_dev_nvlink_replay_error_count_l17", Help: "The value for ECC NVLink replay error count L17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L15, Name: "dcgm_dev_nvlink_recovery_error_count_l15", Help: "The value for ECC NVLink recovery error count L15", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L16, Name: "dcgm_dev_nvlink_recovery_error_count_l16", Help: "The value for ECC NVLink recovery error count L16", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L17, Name: "dcgm_dev_nvlink_recovery_error_count_l17", Help: "The value for ECC NVLink recovery error count L17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L15, Name: "dcgm_dev_nvlink_bandwidth_l15", Help: "The value for ECC NVLink bandwidth L15", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L16, Name: "dcgm_dev_nvlink_bandwidth_l16", Help: "The value for ECC NVLink bandwidth L16", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_BANDWIDTH_L17, Name: "dcgm_dev_nvlink_bandwidth_l17", Help: "The value for ECC NVLink bandwidth L17", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_ERROR_DL_CRC, Name: "dcgm_dev_nvlink_error_dl_crc", Help: "The value for ECC NVLink error DL CRC", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_ERROR_DL_RECOVERY, Name: "dcgm_dev_nvlink_error_dl_recovery", Help: "The value for ECC NVLink error DL recovery", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_ERROR_DL_REPLAY, Name: "dcgm_dev_nvlink_error_dl_replay", Help: "The value for ECC NVLink error DL replay", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_VIRTUAL_MODE, Name: "dcgm_dev_virtual_mode", Help: "The value for ECC virtual mode", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_SUPPORTED_TYPE_INFO, Name: "dcgm_dev_supported_type_info", Help: "The value for ECC supported type info", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CREATABLE_VGPU_TYPE_IDS, Name: "dcgm_dev_creatable_vgpu_type_ids", Help: "The value for ECC creatable VGPU type IDs", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_INSTANCE_IDS, Name: "dcgm_dev_vgpu_instance_ids", Help: "The value for ECC VGPU instance IDs", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_UTILIZATIONS, Name: "dcgm_dev_vgpu_utilizations", Help: "The value for ECC VGPU utilizations", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_PER_PROCESS_UTILIZATION, Name: "dcgm_dev_vgpu_per_process_utilization", Help: "The value for ECC VGPU per process utilization", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_ENC_STATS, Name: "dcgm_dev_enc_stats", Help: "The value for ECC enc stats", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_FBC_STATS, Name: "dcgm_dev_fbc_stats", Help: "The value for ECC FBC stats", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_FBC_SESSIONS_INFO, Name: "dcgm_dev_fbc_sessions_info", Help: "The value for ECC FBC sessions info", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_SUPPORTED_VGPU_TYPE_IDS, Name: "dcgm_dev_supported_vgpu_type_ids", Help: "The value for ECC supported VGPU type IDs", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_TYPE_INFO, Name: "dcgm_dev_vgpu_type_info", Help: "The value for ECC VGPU type info", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_TYPE_NAME, Name: "dcgm_dev_vgpu_type_name", Help: "The value for ECC VGPU type name", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_TYPE_CLASS, Name: "dcgm_dev_vgpu_type_class", Help: "The value for ECC VGPU type class", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_TYPE_LICENSE, Name: "dcgm_dev_vgpu_type_license", Help: "The value for ECC VGPU type license", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_VM_ID, Name: "dcgm_dev_vgpu_vm_id", Help: "The VGPU VM ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_VM_NAME, Name: "dcgm_dev_vgpu_vm_name", Help: "The VGPU VM name", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_TYPE, Name: "dcgm_dev_vgpu_type", Help: "The VGPU type", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_UUID, Name: "dcgm_dev_vgpu_uuid", Help: "The VGPU UUID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_DRIVER_VERSION, Name: "dcgm_dev_vgpu_driver_version", Help: "The VGPU driver version", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_MEMORY_USAGE, Name: "dcgm_dev_vgpu_memory_usage", Help: "The VGPU memory usage", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_VGPU_LICENSE_STATUS, Name: "dcgm_dev_vgpu_license_status", Help: "The VGPU license status", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_VGPU_FRAME_RATE_LIMIT, Name: "dcgm_dev_vgpu_frame_rate_limit", Help: "The VGPU frame rate limit", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_VGPU_ENC_STATS, Name: "dcgm_dev_vgpu_enc_stats", Help: "The VGPU encoder statistics", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_ENC_SESSIONS_INFO, Name: "dcgm_dev_vgpu_enc_sessions_info", Help: "The VGPU encoder sessions information", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_FBC_STATS, Name: "dcgm_dev_vgpu_fbc_stats", Help: "The VGPU frame buffer capture statistics", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_FBC_SESSIONS_INFO, Name: "dcgm_dev_vgpu_fbc_sessions_info", Help: "The VGPU frame buffer capture sessions information", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_INSTANCE_LICENSE_STATE, Name: "dcgm_dev_vgpu_instance_license_state", Help: "The VGPU instance license state", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_VGPU_PCI_ID, Name: "dcgm_dev_vgpu_pci_id", Help: "The VGPU PCI ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_VGPU_VM_GPU_INSTANCE_ID, Name: "dcgm_dev_vgpu_vm_gpu_instance_id", Help: "The VGPU VM GPU instance ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_INFINIBAND_GUID, Name: "dcgm_dev_platform_infiniband_guid", Help: "The value for ECC platform InfiniBand GUID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_CHASSIS_SERIAL_NUMBER, Name: "dcgm_dev_platform_chassis_serial_number", Help: "The value for ECC platform chassis serial number", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_CHASSIS_SLOT_NUMBER, Name: "dcgm_dev_platform_chassis_slot_number", Help: "The value for ECC platform chassis slot number", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_TRAY_INDEX, Name: "dcgm_dev_platform_tray_index", Help: "The value for ECC platform tray index", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_HOST_ID, Name: "dcgm_dev_platform_host_id", Help: "The value for ECC platform host ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_PEER_TYPE, Name: "dcgm_dev_platform_peer_type", Help: "The value for ECC platform peer type", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_PLATFORM_MODULE_ID, Name: "dcgm_dev_platform_module_id", Help: "The value for ECC platform module ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_VOLTAGE_MVOLT, Name: "dcgm_dev_nvswitch_voltage_mvolt", Help: "The NVSwitch voltage in millivolts", Unit: "mV", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_CURRENT_IDDQ, Name: "dcgm_dev_nvswitch_current_iddq", Help: "The NVSwitch IDDQ current", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_CURRENT_IDDQ_REV, Name: "dcgm_dev_nvswitch_current_iddq_rev", Help: "The NVSwitch IDDQ current revision", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_CURRENT_IDDQ_DVDD, Name: "dcgm_dev_nvswitch_current_iddq_dvdd", Help: "The NVSwitch IDDQ current for DVDD", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_POWER_VDD, Name: "dcgm_dev_nvswitch_power_vdd", Help: "The NVSwitch VDD power consumption in watts", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_POWER_DVDD, Name: "dcgm_dev_nvswitch_power_dvdd", Help: "The NVSwitch DVDD power consumption in watts", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_POWER_HVDD, Name: "dcgm_dev_nvswitch_power_hvdd", Help: "The NVSwitch HVDD power consumption in watts", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX, Name: "dcgm_dev_nvswitch_link_throughput_tx", Help: "The NVSwitch Tx Throughput Counter for ports 0-17 in KB/s", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_RX, Name: "dcgm_dev_nvswitch_link_throughput_rx", Help: "The NVSwitch Rx Throughput Counter for ports 0-17 in KB/s", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS, Name: "dcgm_dev_nvswitch_link_fatal_errors", Help: "The number of fatal errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_NON_FATAL_ERRORS, Name: "dcgm_dev_nvswitch_link_non_fatal_errors", Help: "The number of non-fatal errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_REPLAY_ERRORS, Name: "dcgm_dev_nvswitch_link_replay_errors", Help: "The number of replay errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_RECOVERY_ERRORS, Name: "dcgm_dev_nvswitch_link_recovery_errors", Help: "The number of recovery errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_FLIT_ERRORS, Name: "dcgm_dev_nvswitch_link_flit_errors", Help: "The number of FLIT errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS, Name: "dcgm_dev_nvswitch_link_crc_errors", Help: "The number of CRC errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS, Name: "dcgm_dev_nvswitch_link_ecc_errors", Help: "The number of ECC errors for ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC0, Name: "dcgm_dev_nvswitch_link_latency_low_vc0", Help: "The value for Nvlink lane latency low lane0 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC1, Name: "dcgm_dev_nvswitch_link_latency_low_vc1", Help: "The value forNvlink lane latency low lane1 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC2, Name: "dcgm_dev_nvswitch_link_latency_low_vc2", Help: "The value for Nvlink lane latency low lane2 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC3, Name: "dcgm_dev_nvswitch_link_latency_low_vc3", Help: "The value for Nvlink lane latency low lane3 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC0, Name: "dcgm_dev_nvswitch_link_latency_medium_vc0", Help: "The value for Nvlink lane latency medium lane0 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC1, Name: "dcgm_dev_nvswitch_link_latency_medium_vc1", Help: "The value for Nvlink lane latency medium lane1 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC2, Name: "dcgm_dev_nvswitch_link_latency_medium_vc2", Help: "The value for Nvlink lane latency medium lane2 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC3, Name: "dcgm_dev_nvswitch_link_latency_medium_vc3", Help: "The value for Nvlink lane latency medium lane3 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC0, Name: "dcgm_dev_nvswitch_link_latency_high_vc0", Help: "The value for Nvlink lane latency high lane0 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC1, Name: "dcgm_dev_nvswitch_link_latency_high_vc1", Help: "The value for Nvlink lane latency high lane1 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC2, Name: "dcgm_dev_nvswitch_link_latency_high_vc2", Help: "The value for Nvlink lane latency high lane2 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC3, Name: "dcgm_dev_nvswitch_link_latency_high_vc3", Help: "The value for Nvlink lane latency high lane3 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC0, Name: "dcgm_dev_nvswitch_link_latency_panic_vc0", Help: "The value for Nvlink lane latency panic lane0 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC1, Name: "dcgm_dev_nvswitch_link_latency_panic_vc1", Help: "The value for Nvlink lane latency panic lane1 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC2, Name: "dcgm_dev_nvswitch_link_latency_panic_vc2", Help: "The value for Nvlink lane latency panic lane2 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC3, Name: "dcgm_dev_nvswitch_link_latency_panic_vc3", Help: "The value for Nvlink lane latency panic lane3 counter", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_COUNT_VC0, Name: "dcgm_dev_nvswitch_link_latency_count_vc0", Help: "The latency counter for virtual channel 0 on the NVSwitch link", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_COUNT_VC1, Name: "dcgm_dev_nvswitch_link_latency_count_vc1", Help: "The latency counter for virtual channel 1 on the NVSwitch link", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_COUNT_VC2, Name: "dcgm_dev_nvswitch_link_latency_count_vc2", Help: "The latency counter for virtual channel 2 on the NVSwitch link", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_COUNT_VC3, Name: "dcgm_dev_nvswitch_link_latency_count_vc3", Help: "The latency counter for virtual channel 3 on the NVSwitch link", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE0, Name: "dcgm_dev_nvswitch_link_crc_errors_lane0", Help: "The number of CRC errors on lane 0 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE1, Name: "dcgm_dev_nvswitch_link_crc_errors_lane1", Help: "The number of CRC errors on lane 1 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE2, Name: "dcgm_dev_nvswitch_link_crc_errors_lane2", Help: "The number of CRC errors on lane 2 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE3, Name: "dcgm_dev_nvswitch_link_crc_errors_lane3", Help: "The number of CRC errors on lane 3 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE0, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane0", Help: "The number of ECC errors on lane 0 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE1, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane1", Help: "The number of ECC errors on lane 1 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE2, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane2", Help: "The number of ECC errors on lane 2 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE3, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane3", Help: "The number of ECC errors on lane 3 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE4, Name: "dcgm_dev_nvswitch_link_crc_errors_lane4", Help: "The number of CRC errors on lane 4 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE5, Name: "dcgm_dev_nvswitch_link_crc_errors_lane5", Help: "The number of CRC errors on lane 5 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE6, Name: "dcgm_dev_nvswitch_link_crc_errors_lane6", Help: "The number of CRC errors on lane 6 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERRORS_LANE7, Name: "dcgm_dev_nvswitch_link_crc_errors_lane7", Help: "The number of CRC errors on lane 7 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE4, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane4", Help: "The number of ECC errors on lane 4 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE5, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane5", Help: "The number of ECC errors on lane 5 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE6, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane6", Help: "The number of ECC errors on lane 6 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERRORS_LANE7, Name: "dcgm_dev_nvswitch_link_ecc_errors_lane7", Help: "The number of ECC errors on lane 7 on ports 0-17", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0, Name: "dcgm_dev_nvlink_tx_bandwidth_l0", Help: "The transmit bandwidth for NVLink lane 0 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L1, Name: "dcgm_dev_nvlink_tx_bandwidth_l1", Help: "The transmit bandwidth for NVLink lane 1 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L2, Name: "dcgm_dev_nvlink_tx_bandwidth_l2", Help: "The transmit bandwidth for NVLink lane 2 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L3, Name: "dcgm_dev_nvlink_tx_bandwidth_l3", Help: "The transmit bandwidth for NVLink lane 3 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L4, Name: "dcgm_dev_nvlink_tx_bandwidth_l4", Help: "The transmit bandwidth for NVLink lane 4 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L5, Name: "dcgm_dev_nvlink_tx_bandwidth_l5", Help: "The transmit bandwidth for NVLink lane 5 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L6, Name: "dcgm_dev_nvlink_tx_bandwidth_l6", Help: "The transmit bandwidth for NVLink lane 6 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L7, Name: "dcgm_dev_nvlink_tx_bandwidth_l7", Help: "The transmit bandwidth for NVLink lane 7 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L8, Name: "dcgm_dev_nvlink_tx_bandwidth_l8", Help: "The transmit bandwidth for NVLink lane 8 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L9, Name: "dcgm_dev_nvlink_tx_bandwidth_l9", Help: "The transmit bandwidth for NVLink lane 9 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L10, Name: "dcgm_dev_nvlink_tx_bandwidth_l10", Help: "The transmit bandwidth for NVLink lane 10 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L11, Name: "dcgm_dev_nvlink_tx_bandwidth_l11", Help: "The transmit bandwidth for NVLink lane 11 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L12, Name: "dcgm_dev_nvlink_tx_bandwidth_l12", Help: "The NV Link TX Bandwidth Counter for Lane 12", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L13, Name: "dcgm_dev_nvlink_tx_bandwidth_l13", Help: "The NV Link TX Bandwidth Counter for Lane 13", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L14, Name: "dcgm_dev_nvlink_tx_bandwidth_l14", Help: "The NV Link TX Bandwidth Counter for Lane 14", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L15, Name: "dcgm_dev_nvlink_tx_bandwidth_l15", Help: "The NV Link TX Bandwidth Counter for Lane 15", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L16, Name: "dcgm_dev_nvlink_tx_bandwidth_l16", Help: "The NV Link TX Bandwidth Counter for Lane 16", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L17, Name: "dcgm_dev_nvlink_tx_bandwidth_l17", Help: "The NV Link TX Bandwidth Counter for Lane 17", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_TOTAL, Name: "dcgm_dev_nvlink_tx_bandwidth_total", Help: "The NV Link Bandwidth Counter total for all TX Lanes", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_FATAL_ERRORS, Name: "dcgm_dev_nvswitch_fatal_errors", Help: "The NVSwitch fatal error information. Note: value field indicates the specific SXid reported", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_NON_FATAL_ERRORS, Name: "dcgm_dev_nvswitch_non_fatal_errors", Help: "The NVSwitch non fatal error information.", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT, Name: "dcgm_dev_nvswitch_temperature_current", Help: "The NVSwitch current temperature.", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_TEMPERATURE_LIMIT_SLOWDOWN, Name: "dcgm_dev_nvswitch_temperature_limit_slowdown", Help: "The NVSwitch limit slowdown temperature", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_TEMPERATURE_LIMIT_SHUTDOWN, Name: "dcgm_dev_nvswitch_temperature_limit_shutdown", Help: "The NVSwitch limit shutdown temperature", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_THROUGHPUT_TX, Name: "dcgm_dev_nvswitch_throughput_tx", Help: "The NVSwitch throughput Tx", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_THROUGHPUT_RX, Name: "dcgm_dev_nvswitch_throughput_rx", Help: "The NVSwitch throughput Rx", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_PHYS_ID, Name: "dcgm_dev_nvswitch_phys_id", Help: "The NVSwitch physical ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED, Name: "dcgm_dev_nvswitch_reset_required", Help: "The NVSwitch reset required", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_ID, Name: "dcgm_dev_nvswitch_link_id", Help: "The NVSwitch link ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_PCIE_DOMAIN, Name: "dcgm_dev_nvswitch_pcie_domain", Help: "The NVSwitch PCIe domain", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_PCIE_BUS, Name: "dcgm_dev_nvswitch_pcie_bus", Help: "The NVSwitch PCIe bus", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_PCIE_DEVICE, Name: "dcgm_dev_nvswitch_pcie_device", Help: "The NVSwitch PCIe device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_PCIE_FUNCTION, Name: "dcgm_dev_nvswitch_pcie_function", Help: "The NVSwitch PCIe function", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_STATUS, Name: "dcgm_dev_nvswitch_link_status", Help: "The NVSwitch link status UNKNOWN:-1 OFF:0 SAFE:1 ACTIVE:2 ERROR:3", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_TYPE, Name: "dcgm_dev_nvswitch_link_type", Help: "The NVSwitch link type GPU/Switch", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DOMAIN, Name: "dcgm_dev_nvswitch_link_remote_pcie_domain", Help: "The NVSwitch remote PCIe domain", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_BUS, Name: "dcgm_dev_nvswitch_link_remote_pcie_bus", Help: "The NVSwitch remote PCIe bus", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DEVICE, Name: "dcgm_dev_nvswitch_link_remote_pcie_device", Help: "The NVSwitch remote PCIe device", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_FUNCTION, Name: "dcgm_dev_nvswitch_link_remote_pcie_function", Help: "The NVSwitch remote PCIe function", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_DEVICE_LINK_ID, Name: "dcgm_dev_nvswitch_link_device_link_id", Help: "The NVSwitch link device link ID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_LINK_DEVICE_LINK_SID, Name: "dcgm_dev_nvswitch_link_device_link_sid", Help: "The NVSwitch link device link SID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVSWITCH_DEVICE_UUID, Name: "dcgm_dev_nvswitch_device_uuid", Help: "The NVSwitch device UUID", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0, Name: "dcgm_dev_nvlink_rx_bandwidth_l0", Help: "The receive bandwidth for NVLink lane 0 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L1, Name: "dcgm_dev_nvlink_rx_bandwidth_l1", Help: "The receive bandwidth for NVLink lane 1 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L2, Name: "dcgm_dev_nvlink_rx_bandwidth_l2", Help: "The receive bandwidth for NVLink lane 2 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L3, Name: "dcgm_dev_nvlink_rx_bandwidth_l3", Help: "The receive bandwidth for NVLink lane 3 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L4, Name: "dcgm_dev_nvlink_rx_bandwidth_l4", Help: "The receive bandwidth for NVLink lane 4 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L5, Name: "dcgm_dev_nvlink_rx_bandwidth_l5", Help: "The receive bandwidth for NVLink lane 5 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L6, Name: "dcgm_dev_nvlink_rx_bandwidth_l6", Help: "The receive bandwidth for NVLink lane 6 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L7, Name: "dcgm_dev_nvlink_rx_bandwidth_l7", Help: "The receive bandwidth for NVLink lane 7 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L8, Name: "dcgm_dev_nvlink_rx_bandwidth_l8", Help: "The receive bandwidth for NVLink lane 8 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L9, Name: "dcgm_dev_nvlink_rx_bandwidth_l9", Help: "The receive bandwidth for NVLink lane 9 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L10, Name: "dcgm_dev_nvlink_rx_bandwidth_l10", Help: "The receive bandwidth for NVLink lane 10 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L11, Name: "dcgm_dev_nvlink_rx_bandwidth_l11", Help: "The receive bandwidth for NVLink lane 11 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L12, Name: "dcgm_dev_nvlink_rx_bandwidth_l12", Help: "The receive bandwidth for NVLink lane 12 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L13, Name: "dcgm_dev_nvlink_rx_bandwidth_l13", Help: "The receive bandwidth for NVLink lane 13 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L14, Name: "dcgm_dev_nvlink_rx_bandwidth_l14", Help: "The receive bandwidth for NVLink lane 14 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L15, Name: "dcgm_dev_nvlink_rx_bandwidth_l15", Help: "The receive bandwidth for NVLink lane 15 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L16, Name: "dcgm_dev_nvlink_rx_bandwidth_l16", Help: "The receive bandwidth for NVLink lane 16 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L17, Name: "dcgm_dev_nvlink_rx_bandwidth_l17", Help: "The receive bandwidth for NVLink lane 17 in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_TOTAL, Name: "dcgm_dev_nvlink_rx_bandwidth_total", Help: "The total receive bandwidth for all NVLink lanes in KB/s", Unit: "MiB", Type: MetricCounter},
+	{FieldID: DCGM_FI_PROF_GR_ENGINE_ACTIVE, Name: "dcgm_prof_gr_engine_active", Help: "The percentage of time the graphics engine was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_SM_ACTIVE, Name: "dcgm_prof_sm_active", Help: "The percentage of time the streaming multiprocessors (SM) were active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_SM_OCCUPANCY, Name: "dcgm_prof_sm_occupancy", Help: "The percentage of streaming multiprocessors (SM) warps residency", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_TENSOR_ACTIVE, Name: "dcgm_prof_pipe_tensor_active", Help: "The percentage of time the tensor (HMMA) pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_DRAM_ACTIVE, Name: "dcgm_prof_dram_active", Help: "The percentage of time the device memory interface was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_FP64_ACTIVE, Name: "dcgm_prof_pipe_fp64_active", Help: "The percentage of time the FP64 pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_FP32_ACTIVE, Name: "dcgm_prof_pipe_fp32_active", Help: "The percentage of time the FP32 pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_FP16_ACTIVE, Name: "dcgm_prof_pipe_fp16_active", Help: "The percentage of time the FP16 pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PCIE_TX_BYTES, Name: "dcgm_prof_pcie_tx_bytes", Help: "The number of bytes transmitted through PCIe TX (in bytes)", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PCIE_RX_BYTES, Name: "dcgm_prof_pcie_rx_bytes", Help: "The number of bytes received through PCIe RX (in bytes)", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_TX_BYTES, Name: "dcgm_prof_nvlink_tx_bytes", Help: "The number of bytes transmitted through NVLink TX (in bytes)", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_RX_BYTES, Name: "dcgm_prof_nvlink_rx_bytes", Help: "The number of bytes received through NVLink RX (in bytes)", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_TENSOR_IMMA_ACTIVE, Name: "dcgm_prof_pipe_tensor_imma_active", Help: "The percentage of time the IMMA tensor pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_TENSOR_HMMA_ACTIVE, Name: "dcgm_prof_pipe_tensor_hmma_active", Help: "The percentage of time the HMMA tensor pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_TENSOR_DFMA_ACTIVE, Name: "dcgm_prof_pipe_tensor_dfma_active", Help: "The percentage of time the DFMA tensor pipe was active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_PIPE_INT_ACTIVE, Name: "dcgm_prof_pipe_int_active", Help: "The ratio of cycles the integer pipe is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC0_ACTIVE, Name: "dcgm_prof_nvdec0_active", Help: "The ratio of cycles the NVDEC engine 0 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC1_ACTIVE, Name: "dcgm_prof_nvdec1_active", Help: "The ratio of cycles the NVDEC engine 1 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC2_ACTIVE, Name: "dcgm_prof_nvdec2_active", Help: "The ratio of cycles the NVDEC engine 2 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC3_ACTIVE, Name: "dcgm_prof_nvdec3_active", Help: "The ratio of cycles the NVDEC engine 3 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC4_ACTIVE, Name: "dcgm_prof_nvdec4_active", Help: "The ratio of cycles the NVDEC engine 4 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC5_ACTIVE, Name: "dcgm_prof_nvdec5_active", Help: "The ratio of cycles the NVDEC engine 5 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC6_ACTIVE, Name: "dcgm_prof_nvdec6_active", Help: "The ratio of cycles the NVDEC engine 6 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVDEC7_ACTIVE, Name: "dcgm_prof_nvdec7_active", Help: "The ratio of cycles the NVDEC engine 7 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG0_ACTIVE, Name: "dcgm_prof_nvjpg0_active", Help: "The ratio of cycles the NVJPG engine 0 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG1_ACTIVE, Name: "dcgm_prof_nvjpg1_active", Help: "The ratio of cycles the NVJPG engine 1 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG2_ACTIVE, Name: "dcgm_prof_nvjpg2_active", Help: "The ratio of cycles the NVJPG engine 2 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG3_ACTIVE, Name: "dcgm_prof_nvjpg3_active", Help: "The ratio of cycles the NVJPG engine 3 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG4_ACTIVE, Name: "dcgm_prof_nvjpg4_active", Help: "The ratio of cycles the NVJPG engine 4 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG5_ACTIVE, Name: "dcgm_prof_nvjpg5_active", Help: "The ratio of cycles the NVJPG engine 5 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG6_ACTIVE, Name: "dcgm_prof_nvjpg6_active", Help: "The ratio of cycles the NVJPG engine 6 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVJPG7_ACTIVE, Name: "dcgm_prof_nvjpg7_active", Help: "The ratio of cycles the NVJPG engine 7 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVOFA0_ACTIVE, Name: "dcgm_prof_nvofa0_active", Help: "The ratio of cycles the NVOFA engine 0 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVOFA1_ACTIVE, Name: "dcgm_prof_nvofa1_active", Help: "The ratio of cycles the NVOFA engine 1 is active", Unit: "ratio", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L0_TX_BYTES, Name: "dcgm_prof_nvlink_l0_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 0 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L0_RX_BYTES, Name: "dcgm_prof_nvlink_l0_rx_bytes", Help: "The number of bytes received through NVLink lane 0 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L1_TX_BYTES, Name: "dcgm_prof_nvlink_l1_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 1 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L1_RX_BYTES, Name: "dcgm_prof_nvlink_l1_rx_bytes", Help: "The number of bytes received through NVLink lane 1 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L2_TX_BYTES, Name: "dcgm_prof_nvlink_l2_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 2 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L2_RX_BYTES, Name: "dcgm_prof_nvlink_l2_rx_bytes", Help: "The number of bytes received through NVLink lane 2 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L3_TX_BYTES, Name: "dcgm_prof_nvlink_l3_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 3 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L3_RX_BYTES, Name: "dcgm_prof_nvlink_l3_rx_bytes", Help: "The number of bytes received through NVLink lane 3 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L4_TX_BYTES, Name: "dcgm_prof_nvlink_l4_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 4 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L4_RX_BYTES, Name: "dcgm_prof_nvlink_l4_rx_bytes", Help: "The number of bytes received through NVLink lane 4 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L5_TX_BYTES, Name: "dcgm_prof_nvlink_l5_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 5 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L5_RX_BYTES, Name: "dcgm_prof_nvlink_l5_rx_bytes", Help: "The number of bytes received through NVLink lane 5 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L6_TX_BYTES, Name: "dcgm_prof_nvlink_l6_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 6 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L6_RX_BYTES, Name: "dcgm_prof_nvlink_l6_rx_bytes", Help: "The number of bytes received through NVLink lane 6 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L7_TX_BYTES, Name: "dcgm_prof_nvlink_l7_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 7 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L7_RX_BYTES, Name: "dcgm_prof_nvlink_l7_rx_bytes", Help: "The number of bytes received through NVLink lane 7 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L8_TX_BYTES, Name: "dcgm_prof_nvlink_l8_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 8 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L8_RX_BYTES, Name: "dcgm_prof_nvlink_l8_rx_bytes", Help: "The number of bytes received through NVLink lane 8 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L9_TX_BYTES, Name: "dcgm_prof_nvlink_l9_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 9 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L9_RX_BYTES, Name: "dcgm_prof_nvlink_l9_rx_bytes", Help: "The number of bytes received through NVLink lane 9 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L10_TX_BYTES, Name: "dcgm_prof_nvlink_l10_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 10 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L10_RX_BYTES, Name: "dcgm_prof_nvlink_l10_rx_bytes", Help: "The number of bytes received through NVLink lane 10 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L11_TX_BYTES, Name: "dcgm_prof_nvlink_l11_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 11 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L11_RX_BYTES, Name: "dcgm_prof_nvlink_l11_rx_bytes", Help: "The number of bytes received through NVLink lane 11 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L12_TX_BYTES, Name: "dcgm_prof_nvlink_l12_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 12 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L12_RX_BYTES, Name: "dcgm_prof_nvlink_l12_rx_bytes", Help: "The number of bytes received through NVLink lane 12 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L13_TX_BYTES, Name: "dcgm_prof_nvlink_l13_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 13 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L13_RX_BYTES, Name: "dcgm_prof_nvlink_l13_rx_bytes", Help: "The number of bytes received through NVLink lane 13 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L14_TX_BYTES, Name: "dcgm_prof_nvlink_l14_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 14 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L14_RX_BYTES, Name: "dcgm_prof_nvlink_l14_rx_bytes", Help: "The number of bytes received through NVLink lane 14 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L15_TX_BYTES, Name: "dcgm_prof_nvlink_l15_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 15 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L15_RX_BYTES, Name: "dcgm_prof_nvlink_l15_rx_bytes", Help: "The number of bytes received through NVLink lane 15 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L16_TX_BYTES, Name: "dcgm_prof_nvlink_l16_tx_bytes", Help: "The number of bytes transmitted through NVLink lane 16 in KB/s", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L16_RX_BYTES, Name: "dcgm_prof_nvlink_l16_rx_bytes", Help: "The per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L17_TX_BYTES, Name: "dcgm_prof_nvlink_l17_tx_bytes", Help: "The per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_NVLINK_L17_RX_BYTES, Name: "dcgm_prof_nvlink_l17_rx_bytes", Help: "The per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_C2C_TX_ALL_BYTES, Name: "dcgm_prof_c2c_tx_all_bytes", Help: "C2C (Chip-to-Chip) interface metric", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_C2C_TX_DATA_BYTES, Name: "dcgm_prof_c2c_tx_data_bytes", Help: "C2C (Chip-to-Chip) interface metric", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_C2C_RX_ALL_BYTES, Name: "dcgm_prof_c2c_rx_all_bytes", Help: "C2C (Chip-to-Chip) interface metric", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_PROF_C2C_RX_DATA_BYTES, Name: "dcgm_prof_c2c_rx_data_bytes", Help: "C2C (Chip-to-Chip) interface metric", Unit: "B/s", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_UTIL_TOTAL, Name: "dcgm_dev_cpu_util_total", Help: "The total CPU utilization, total", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_UTIL_USER, Name: "dcgm_dev_cpu_util_user", Help: "The CPU utilization, user", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_UTIL_NICE, Name: "dcgm_dev_cpu_util_nice", Help: "The CPU utilization, nice", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_UTIL_SYS, Name: "dcgm_dev_cpu_util_sys", Help: "The CPU utilization, system time", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_UTIL_IRQ, Name: "dcgm_dev_cpu_util_irq", Help: "The CPU utilization, interrupt servicing", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_TEMP_CURRENT, Name: "dcgm_dev_cpu_temp_current", Help: "The current CPU temperature in degrees Celsius", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_TEMP_WARNING, Name: "dcgm_dev_cpu_temp_warning", Help: "The CPU temperature warning threshold in degrees Celsius", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_TEMP_CRITICAL, Name: "dcgm_dev_cpu_temp_critical", Help: "CPU Critical Temperature", Unit: "C", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_CLOCK_CURRENT, Name: "dcgm_dev_cpu_clock_current", Help: "The current CPU clock frequency in MHz", Unit: "MHz", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT, Name: "dcgm_dev_cpu_power_util_current", Help: "CPU power utilization", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_POWER_LIMIT, Name: "dcgm_dev_cpu_power_limit", Help: "The GPU power limit", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_SYSIO_POWER_UTIL_CURRENT, Name: "dcgm_dev_sysio_power_util_current", Help: "The SoC power utilization", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_MODULE_POWER_UTIL_CURRENT, Name: "dcgm_dev_module_power_util_current", Help: "The Module power utilization", Unit: "W", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CPU_VENDOR, Name: "dcgm_dev_cpu_vendor", Help: "The value for ECC DEV CPU Vendor", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CPU_MODEL, Name: "dcgm_dev_cpu_model", Help: "The value for ECC DEV CPU Model", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_TX_PACKETS, Name: "dcgm_dev_nvlink_count_tx_packets", Help: "The value for ECC DEV NVLink Count TX Packets", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_TX_BYTES, Name: "dcgm_dev_nvlink_count_tx_bytes", Help: "The value for ECC DEV NVLink Count TX Bytes", Unit: "B", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_PACKETS, Name: "dcgm_dev_nvlink_count_rx_packets", Help: "The value for ECC DEV NVLink Count RX Packets", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_BYTES, Name: "dcgm_dev_nvlink_count_rx_bytes", Help: "The value for ECC DEV NVLink Count RX Bytes", Unit: "B", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_MALFORMED_PACKET_ERRORS, Name: "dcgm_dev_nvlink_count_rx_malformed_packet_errors", Help: "The value for ECC DEV NVLink Count RX Malformed Packet Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_BUFFER_OVERRUN_ERRORS, Name: "dcgm_dev_nvlink_count_rx_buffer_overrun_errors", Help: "The value for ECC DEV NVLink Count RX Buffer Overrun Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_ERRORS, Name: "dcgm_dev_nvlink_count_rx_errors", Help: "The value for ECC DEV NVLink Count RX Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_REMOTE_ERRORS, Name: "dcgm_dev_nvlink_count_rx_remote_errors", Help: "The value for ECC DEV NVLink Count RX Remote Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_GENERAL_ERRORS, Name: "dcgm_dev_nvlink_count_rx_general_errors", Help: "The value for ECC DEV NVLink Count RX General Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_LOCAL_LINK_INTEGRITY_ERRORS, Name: "dcgm_dev_nvlink_count_local_link_integrity_errors", Help: "The value for ECC DEV NVLink Count Local Link Integrity Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_TX_DISCARDS, Name: "dcgm_dev_nvlink_count_tx_discards", Help: "The value for ECC DEV NVLink Count TX Discards", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_SUCCESSFUL_EVENTS, Name: "dcgm_dev_nvlink_count_link_recovery_successful_events", Help: "The value for ECC DEV NVLink Count Link Recovery Successful Events", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_FAILED_EVENTS, Name: "dcgm_dev_nvlink_count_link_recovery_failed_events", Help: "The value for ECC DEV NVLink Count Link Recovery Failed Events", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS, Name: "dcgm_dev_nvlink_count_link_recovery_events", Help: "The value for ECC DEV NVLink Count Link Recovery Events", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_RX_SYMBOL_ERRORS, Name: "dcgm_dev_nvlink_count_rx_symbol_errors", Help: "The value for ECC DEV NVLink Count RX Symbol Errors", Unit: "", Type: MetricCounter},
+	{FieldID: DCGM_FI_DEV_NVLINK_COUNT_SYMBOL_BER, Name: "dcgm_dev_nvlink_count_symbol_ber", Help: "The value for ECC DEV NVLink Count Symbol BER", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CONNECTX_HEALTH, Name: "dcgm_dev_connectx_health", Help: "A health state of ConnectX", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_WIDTH, Name: "dcgm_dev_connectx_active_pcie_link_width", Help: "The value of an active PCIe link width", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_SPEED, Name: "dcgm_dev_connectx_active_pcie_link_speed", Help: "The value of an active PCIe link speed", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CONNECTX_EXPECT_PCIE_LINK_WIDTH, Name: "dcgm_dev_connectx_expect_pcie_link_width", Help: "The value of an expected PCIe link width", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CONNECTX_EXPECT_PCIE_LINK_SPEED, Name: "dcgm_dev_connectx_expect_pcie_link_speed", Help: "The value of an expected PCIe link speed", Unit: "", Type: MetricGauge},
+	{FieldID: DCGM_FI_DEV_CONNECTX_CORRECTABLE_ERR_STATUS, Name: "dcgm_dev_connectx_correctable_err_status", Help: "The value of a correctable error status", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CONNECTX_CORRECTABLE_ERR_MASK, Name: "dcgm_dev_connectx_correctable_err_mask", Help: "The value of a correctable error mask", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CONNECTX_UNCORRECTABLE_ERR_STATUS, Name: "dcgm_dev_connectx_uncorrectable_err_status", Help: "The value of an uncorrectable error status", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CONNECTX_UNCORRECTABLE_ERR_MASK, Name: "dcgm_dev_connectx_uncorrectable_err_mask", Help: "The value of an uncorrectable error mask", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CONNECTX_UNCORRECTABLE_ERR_SEVERITY, Name: "dcgm_dev_connectx_uncorrectable_err_severity", Help: "The value of an uncorrectable error severity", Unit: "", Type: MetricInfo},
+	{FieldID: DCGM_FI_DEV_CONNECTX_DEVICE_TEMPERATURE, Name: "dcgm_dev_connectx_device_temperature", Help: "The value of a device temperature", Unit: "C", Type: MetricGauge},
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricMetas(t *testing.T) {
+	meta, ok := GetMetricMeta(DCGM_FI_DEV_GPU_TEMP)
+	require.True(t, ok)
+	assert.Equal(t, "dcgm_dev_gpu_temp", meta.Name)
+	assert.Equal(t, "C", meta.Unit)
+	assert.Equal(t, MetricGauge, meta.Type)
+
+	meta, ok = GetMetricMeta(DCGM_FI_DEV_ECC_DBE_VOL_TOTAL)
+	require.True(t, ok)
+	assert.Equal(t, MetricCounter, meta.Type)
+
+	meta, ok = GetMetricMeta(DCGM_FI_DEV_NAME)
+	require.True(t, ok)
+	assert.Equal(t, MetricInfo, meta.Type)
+
+	_, ok = GetMetricMeta(DCGM_FI_UNKNOWN)
+	assert.False(t, ok)
+
+	metas := MetricMetas()
+	require.NotEmpty(t, metas)
+	names := make(map[string]bool, len(metas))
+	for _, meta := range metas {
+		assert.Regexp(t, `^dcgm_[a-z0-9_]+$`, meta.Name)
+		assert.NotEmpty(t, meta.Help, meta.Name)
+		assert.False(t, names[meta.Name], "duplicate name %s", meta.Name)
+		names[meta.Name] = true
+	}
+
+	metas[0].Name = ""
+	assert.NotEmpty(t, MetricMetas()[0].Name)
+}
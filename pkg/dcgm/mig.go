@@ -8,6 +8,7 @@ import "C"
 
 import (
 	"fmt"
+	"slices"
 	"unsafe"
 )
 
@@ -114,10 +115,20 @@ type MigHierarchy_v2 struct {
 
 // GetGPUInstanceHierarchy retrieves the complete MIG hierarchy information
 func GetGPUInstanceHierarchy() (hierarchy MigHierarchy_v2, err error) {
+	return defaultClient.GetGPUInstanceHierarchy()
+}
+
+// GetGPUInstanceHierarchy retrieves the complete MIG hierarchy information
+func (c *Client) GetGPUInstanceHierarchy() (hierarchy MigHierarchy_v2, err error) {
+	if err = c.beginCall(); err != nil {
+		return
+	}
+	defer c.endCall()
+
 	var c_hierarchy C.dcgmMigHierarchy_v2
 	c_hierarchy.version = C.dcgmMigHierarchy_version2
 	ptr_hierarchy := (*C.dcgmMigHierarchy_v2)(unsafe.Pointer(&c_hierarchy))
-	result := C.dcgmGetGpuInstanceHierarchy(handle.handle, ptr_hierarchy)
+	result := C.dcgmGetGpuInstanceHierarchy(c.dcgmHandle(), ptr_hierarchy)
 
 	if err = errorString(result); err != nil {
 		return toMigHierarchy(c_hierarchy), fmt.Errorf("error retrieving DCGM MIG hierarchy: %s", err)
@@ -147,3 +158,57 @@ func toMigHierarchy(c_hierarchy C.dcgmMigHierarchy_v2) MigHierarchy_v2 {
 
 	return hierarchy
 }
+
+// Entities returns the GPU instances of the specified GPUs, or of all GPUs if none are specified,
+// followed by their compute instances if withComputeInstances is set, in the order of the hierarchy
+func (h MigHierarchy_v2) Entities(withComputeInstances bool, gpuIDs ...uint) []GroupEntityPair {
+	var instances, computeInstances []GroupEntityPair
+	selected := make(map[uint]bool)
+	for i := uint(0); i < h.Count && i < MAX_HIERARCHY_INFO; i++ {
+		info := h.EntityList[i]
+		if info.Entity.EntityGroupId != FE_GPU_I || info.Parent.EntityGroupId != FE_GPU {
+			continue
+		}
+		if len(gpuIDs) == 0 || slices.Contains(gpuIDs, info.Parent.EntityId) {
+			instances = append(instances, info.Entity)
+			selected[info.Entity.EntityId] = true
+		}
+	}
+	if !withComputeInstances {
+		return instances
+	}
+
+	for i := uint(0); i < h.Count && i < MAX_HIERARCHY_INFO; i++ {
+		info := h.EntityList[i]
+		if info.Entity.EntityGroupId == FE_GPU_CI && info.Parent.EntityGroupId == FE_GPU_I && selected[info.Parent.EntityId] {
+			computeInstances = append(computeInstances, info.Entity)
+		}
+	}
+	return append(instances, computeInstances...)
+}
+
+// NewMigGroup creates a group with the specified name holding the GPU instances of the specified
+// GPUs, or of all GPUs if none are specified, and, if withComputeInstances is set, all of their
+// compute instances. Health watches and fields can then be set on the group to monitor every MIG
+// slice separately; incidents and values are reported per instance.
+func NewMigGroup(groupName string, withComputeInstances bool, gpuIDs ...uint) (GroupHandle, error) {
+	return defaultClient.NewMigGroup(groupName, withComputeInstances, gpuIDs...)
+}
+
+// NewMigGroup creates a group with the specified name holding the GPU instances of the specified
+// GPUs, or of all GPUs if none are specified, and, if withComputeInstances is set, all of their
+// compute instances. Health watches and fields can then be set on the group to monitor every MIG
+// slice separately; incidents and values are reported per instance.
+func (c *Client) NewMigGroup(groupName string, withComputeInstances bool, gpuIDs ...uint) (GroupHandle, error) {
+	hierarchy, err := c.GetGPUInstanceHierarchy()
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	entities := hierarchy.Entities(withComputeInstances, gpuIDs...)
+	if len(entities) == 0 {
+		return GroupHandle{}, fmt.Errorf("no MIG instances found")
+	}
+
+	return c.createGroupWithEntities(GroupEmpty, groupName, entities)
+}
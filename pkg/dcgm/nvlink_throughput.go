@@ -0,0 +1,155 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"time"
+)
+
+// nvLinkMaxLinks is the number of links with a bandwidth counter on a GPU
+const nvLinkMaxLinks = C.DCGM_NVLINK_MAX_LINKS_PER_GPU
+
+// NvLinkTxBandwidthField returns the field of the TX bandwidth counter of link. The fields of
+// links 0 to 17 are consecutive.
+func NvLinkTxBandwidthField(link uint) (Short, error) {
+	if link >= nvLinkMaxLinks {
+		return 0, fmt.Errorf("invalid NVLink %d, GPUs have at most %d links", link, nvLinkMaxLinks)
+	}
+	return DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0 + Short(link), nil
+}
+
+// NvLinkRxBandwidthField returns the field of the RX bandwidth counter of link. The fields of
+// links 0 to 17 are consecutive.
+func NvLinkRxBandwidthField(link uint) (Short, error) {
+	if link >= nvLinkMaxLinks {
+		return 0, fmt.Errorf("invalid NVLink %d, GPUs have at most %d links", link, nvLinkMaxLinks)
+	}
+	return DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0 + Short(link), nil
+}
+
+// NvLinkCounters is a sample of the cumulative NVLink traffic counters of a GPU. Throughput is
+// computed from two samples with NvLinkThroughputBetween.
+type NvLinkCounters struct {
+	GPU  uint
+	Time time.Time
+	// TX and RX hold the traffic of each link in MiB, indexed by link. Links without a counter,
+	// e.g. because they do not exist on the GPU, are -1.
+	TX [nvLinkMaxLinks]int64
+	RX [nvLinkMaxLinks]int64
+}
+
+// NvLinkLinkThroughput is the throughput of one NVLink
+type NvLinkLinkThroughput struct {
+	Link uint
+	TX   float64 // bytes/s
+	RX   float64 // bytes/s
+}
+
+// NvLinkThroughput is the NVLink throughput of a GPU over the interval between two samples
+type NvLinkThroughput struct {
+	GPU      uint
+	Interval time.Duration
+	// Links holds the links with a counter in both samples, ordered by link
+	Links []NvLinkLinkThroughput
+	TX    float64 // bytes/s, sum of all links
+	RX    float64 // bytes/s, sum of all links
+}
+
+// GetNvLinkCounters samples the cumulative NVLink traffic counters of every link of the
+// specified GPU
+func GetNvLinkCounters(gpuID uint) (NvLinkCounters, error) {
+	return defaultClient.GetNvLinkCounters(gpuID)
+}
+
+// GetNvLinkCounters samples the cumulative NVLink traffic counters of every link of the
+// specified GPU
+func (c *Client) GetNvLinkCounters(gpuID uint) (NvLinkCounters, error) {
+	if err := c.beginCall(); err != nil {
+		return NvLinkCounters{}, err
+	}
+	defer c.endCall()
+
+	fields := make([]Short, 0, 2*nvLinkMaxLinks)
+	for link := uint(0); link < nvLinkMaxLinks; link++ {
+		fields = append(fields, DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0+Short(link), DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0+Short(link))
+	}
+
+	entities := []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}
+	values, err := c.sampleEntityFields("nvlinkCounters", entities, fields)
+	if err != nil {
+		return NvLinkCounters{}, fmt.Errorf("error getting NVLink counters: %s", err)
+	}
+
+	return toNvLinkCounters(gpuID, values), nil
+}
+
+func toNvLinkCounters(gpuID uint, values []FieldValue_v2) NvLinkCounters {
+	counters := NvLinkCounters{GPU: gpuID}
+	for link := range counters.TX {
+		counters.TX[link] = -1
+		counters.RX[link] = -1
+	}
+
+	var ts int64
+	for _, value := range values {
+		v, ok := value.Typed().AsInt64()
+		if !ok || v < 0 {
+			continue
+		}
+
+		switch {
+		case value.FieldID >= DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0 && value.FieldID < DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0+nvLinkMaxLinks:
+			counters.TX[value.FieldID-DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0] = v
+		case value.FieldID >= DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0 && value.FieldID < DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0+nvLinkMaxLinks:
+			counters.RX[value.FieldID-DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0] = v
+		default:
+			continue
+		}
+		ts = max(ts, value.TS)
+	}
+
+	if ts > 0 {
+		counters.Time = timestampUSECToTime(ts)
+	}
+	return counters
+}
+
+// NvLinkThroughputBetween returns the NVLink throughput of a GPU between two counter samples.
+// A counter that decreased was reset, e.g. by a driver reload, and its traffic is counted from
+// zero.
+func NvLinkThroughputBetween(prev, cur NvLinkCounters) (NvLinkThroughput, error) {
+	if prev.GPU != cur.GPU {
+		return NvLinkThroughput{}, fmt.Errorf("NVLink counters of GPU %d and GPU %d cannot be compared", prev.GPU, cur.GPU)
+	}
+	interval := cur.Time.Sub(prev.Time)
+	if interval <= 0 {
+		return NvLinkThroughput{}, fmt.Errorf("NVLink counters of GPU %d were not sampled in order", cur.GPU)
+	}
+
+	throughput := NvLinkThroughput{GPU: cur.GPU, Interval: interval}
+	for link := range cur.TX {
+		if prev.TX[link] < 0 || cur.TX[link] < 0 || prev.RX[link] < 0 || cur.RX[link] < 0 {
+			continue
+		}
+
+		rate := NvLinkLinkThroughput{
+			Link: uint(link),
+			TX:   counterRate(prev.TX[link], cur.TX[link], interval),
+			RX:   counterRate(prev.RX[link], cur.RX[link], interval),
+		}
+		throughput.Links = append(throughput.Links, rate)
+		throughput.TX += rate.TX
+		throughput.RX += rate.RX
+	}
+	return throughput, nil
+}
+
+// counterRate returns the rate in bytes/s of a counter in MiB
+func counterRate(prev, cur int64, interval time.Duration) float64 {
+	return float64(counterDelta(prev, cur)) * (1 << 20) / interval.Seconds()
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNvLinkThroughput(t *testing.T) {
+	field, err := NvLinkTxBandwidthField(17)
+	require.NoError(t, err)
+	assert.Equal(t, Short(DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L17), field)
+	field, err = NvLinkRxBandwidthField(3)
+	require.NoError(t, err)
+	assert.Equal(t, Short(DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L3), field)
+	_, err = NvLinkRxBandwidthField(18)
+	require.Error(t, err)
+
+	gpu := Entity{Group: FE_GPU, ID: 0}
+	prev := toNvLinkCounters(0, []FieldValue_v2{
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0, 100, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0, 200, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L1, 500, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L1, 500, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L2, DCGM_FT_INT64_NOT_SUPPORTED, 1_000_000),
+	})
+	assert.Equal(t, int64(100), prev.TX[0])
+	assert.Equal(t, int64(-1), prev.TX[2])
+	assert.Equal(t, timestampUSECToTime(1_000_000), prev.Time)
+
+	cur := toNvLinkCounters(0, []FieldValue_v2{
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L0, 300, 3_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L0, 600, 3_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_TX_BANDWIDTH_L1, 10, 3_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_NVLINK_RX_BANDWIDTH_L1, 500, 3_000_000),
+	})
+
+	throughput, err := NvLinkThroughputBetween(prev, cur)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, throughput.Interval)
+	assert.Equal(t, []NvLinkLinkThroughput{
+		{Link: 0, TX: 100 << 20, RX: 200 << 20},
+		{Link: 1, TX: 5 << 20, RX: 0},
+	}, throughput.Links)
+	assert.InDelta(t, float64(105<<20), throughput.TX, 0)
+	assert.InDelta(t, float64(200<<20), throughput.RX, 0)
+
+	_, err = NvLinkThroughputBetween(cur, prev)
+	require.Error(t, err)
+	cur.GPU = 1
+	_, err = NvLinkThroughputBetween(prev, cur)
+	require.Error(t, err)
+}
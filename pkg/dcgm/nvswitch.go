@@ -0,0 +1,156 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+)
+
+// NvSwitch contains information about an NVSwitch
+type NvSwitch struct {
+	// ID is the DCGM entity ID of the switch
+	ID uint
+	// PhysicalID is the physical ID of the switch
+	PhysicalID uint
+	// UUID is the UUID of the switch, if reported by the driver
+	UUID string
+	// Temperature is the current temperature in °C
+	Temperature int64
+	// FatalErrors is the SXid of the latest fatal error of the switch, 0 if there was none
+	FatalErrors int64
+	// NonFatalErrors is the SXid of the latest non-fatal error of the switch, 0 if there was none
+	NonFatalErrors int64
+	// ResetRequired is true if the switch must be reset to recover from an error
+	ResetRequired bool
+	// Links contains the state of every NVLink the switch supports
+	Links []NvLinkStatus
+}
+
+// LinksUp returns the number of NVLinks of the switch that are up
+func (s NvSwitch) LinksUp() int {
+	up := 0
+	for _, link := range s.Links {
+		if link.State == LS_UP {
+			up++
+		}
+	}
+	return up
+}
+
+// GetNvSwitchCount returns the number of NVSwitches in the system
+func GetNvSwitchCount() (uint, error) {
+	return defaultClient.GetNvSwitchCount()
+}
+
+// GetNvSwitchCount returns the number of NVSwitches in the system
+func (c *Client) GetNvSwitchCount() (uint, error) {
+	switches, err := c.GetNvSwitchIDs()
+	return uint(len(switches)), err
+}
+
+// GetNvSwitchIDs returns the entity IDs of all NVSwitches in the system
+func GetNvSwitchIDs() ([]uint, error) {
+	return defaultClient.GetNvSwitchIDs()
+}
+
+// GetNvSwitchIDs returns the entity IDs of all NVSwitches in the system
+func (c *Client) GetNvSwitchIDs() ([]uint, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getEntityGroupEntities(FE_SWITCH)
+}
+
+// GetNvSwitchInfo returns the status and NVLink states of all NVSwitches in the system
+func GetNvSwitchInfo() ([]NvSwitch, error) {
+	return defaultClient.GetNvSwitchInfo()
+}
+
+// GetNvSwitchInfo returns the status and NVLink states of all NVSwitches in the system
+func (c *Client) GetNvSwitchInfo() ([]NvSwitch, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getNvSwitchInfo()
+}
+
+func (c *Client) getNvSwitchInfo() ([]NvSwitch, error) {
+	ids, err := c.getEntityGroupEntities(FE_SWITCH)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []NvSwitch{}, nil
+	}
+
+	switches := make([]NvSwitch, len(ids))
+	index := make(map[uint]*NvSwitch, len(ids))
+	for i, id := range ids {
+		switches[i].ID = id
+		index[id] = &switches[i]
+	}
+
+	links, err := c.getNvLinkLinkStatus()
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		if link.ParentType != FE_SWITCH || link.State == LS_NOT_SUPPORTED {
+			continue
+		}
+		if sw, ok := index[link.ParentId]; ok {
+			sw.Links = append(sw.Links, link)
+		}
+	}
+
+	fields := []Short{
+		C.DCGM_FI_DEV_NVSWITCH_PHYS_ID,
+		C.DCGM_FI_DEV_NVSWITCH_DEVICE_UUID,
+		C.DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT,
+		C.DCGM_FI_DEV_NVSWITCH_FATAL_ERRORS,
+		C.DCGM_FI_DEV_NVSWITCH_NON_FATAL_ERRORS,
+		C.DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED,
+	}
+
+	entities := make([]GroupEntityPair, len(ids))
+	for i, id := range ids {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: id}
+	}
+
+	values, err := c.sampleEntityFields("nvSwitch", entities, fields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting NVSwitch fields: %s", err)
+	}
+
+	for _, value := range values {
+		sw, ok := index[value.EntityID]
+		if !ok || value.Status != C.DCGM_ST_OK {
+			continue
+		}
+
+		switch value.FieldID {
+		case C.DCGM_FI_DEV_NVSWITCH_PHYS_ID:
+			sw.PhysicalID = uint(value.Int64())
+		case C.DCGM_FI_DEV_NVSWITCH_DEVICE_UUID:
+			sw.UUID = value.String()
+		case C.DCGM_FI_DEV_NVSWITCH_TEMPERATURE_CURRENT:
+			sw.Temperature = value.Int64()
+		case C.DCGM_FI_DEV_NVSWITCH_FATAL_ERRORS:
+			sw.FatalErrors = value.Int64()
+		case C.DCGM_FI_DEV_NVSWITCH_NON_FATAL_ERRORS:
+			sw.NonFatalErrors = value.Int64()
+		case C.DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED:
+			sw.ResetRequired = value.Int64() != 0
+		}
+	}
+
+	return switches, nil
+}
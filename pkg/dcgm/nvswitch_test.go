@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNvSwitchLinksUp(t *testing.T) {
+	sw := NvSwitch{Links: []NvLinkStatus{
+		{ParentType: FE_SWITCH, State: LS_UP},
+		{ParentType: FE_SWITCH, State: LS_DOWN},
+		{ParentType: FE_SWITCH, State: LS_UP},
+		{ParentType: FE_SWITCH, State: LS_DISABLED},
+	}}
+	assert.Equal(t, 2, sw.LinksUp())
+}
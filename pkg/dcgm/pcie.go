@@ -0,0 +1,91 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// PCIeCounters is a sample of the PCIe traffic and replay counter of a GPU
+type PCIeCounters struct {
+	GPU  uint
+	Time time.Time
+	// TX and RX are the throughput NVML measured over its last sampling window, in bytes/s. They
+	// are NaN if the GPU does not report them. DCGM_FI_PROF_PCIE_TX_BYTES and
+	// DCGM_FI_PROF_PCIE_RX_BYTES, watched with WatchProfilingFields, average over the whole update
+	// interval instead.
+	TX float64
+	RX float64
+	// Replays is the cumulative number of PCIe replays, or -1 if the GPU does not report it
+	Replays int64
+}
+
+// GetPCIeCounters samples the PCIe throughput and replay counter of the specified GPU
+func GetPCIeCounters(gpuID uint) (PCIeCounters, error) {
+	return defaultClient.GetPCIeCounters(gpuID)
+}
+
+// GetPCIeCounters samples the PCIe throughput and replay counter of the specified GPU
+func (c *Client) GetPCIeCounters(gpuID uint) (PCIeCounters, error) {
+	if err := c.beginCall(); err != nil {
+		return PCIeCounters{}, err
+	}
+	defer c.endCall()
+
+	fields := []Short{DCGM_FI_DEV_PCIE_TX_THROUGHPUT, DCGM_FI_DEV_PCIE_RX_THROUGHPUT, DCGM_FI_DEV_PCIE_REPLAY_COUNTER}
+
+	entities := []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}
+	values, err := c.sampleEntityFields("pcieCounters", entities, fields)
+	if err != nil {
+		return PCIeCounters{}, fmt.Errorf("error getting PCIe counters: %s", err)
+	}
+
+	return toPCIeCounters(gpuID, values), nil
+}
+
+func toPCIeCounters(gpuID uint, values []FieldValue_v2) PCIeCounters {
+	counters := PCIeCounters{GPU: gpuID, TX: math.NaN(), RX: math.NaN(), Replays: -1}
+
+	var ts int64
+	for _, value := range values {
+		v, ok := value.Typed().AsInt64()
+		if !ok || v < 0 {
+			continue
+		}
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_PCIE_TX_THROUGHPUT:
+			// NVML reports the throughput in KB/s
+			counters.TX = float64(v) * 1024
+		case DCGM_FI_DEV_PCIE_RX_THROUGHPUT:
+			counters.RX = float64(v) * 1024
+		case DCGM_FI_DEV_PCIE_REPLAY_COUNTER:
+			counters.Replays = v
+		default:
+			continue
+		}
+		ts = max(ts, value.TS)
+	}
+
+	if ts > 0 {
+		counters.Time = timestampUSECToTime(ts)
+	}
+	return counters
+}
+
+// PCIeReplayRate returns the rate of PCIe replays per second of a GPU between two counter
+// samples. A counter that decreased was reset and its replays are counted from zero.
+func PCIeReplayRate(prev, cur PCIeCounters) (float64, error) {
+	if prev.GPU != cur.GPU {
+		return 0, fmt.Errorf("PCIe counters of GPU %d and GPU %d cannot be compared", prev.GPU, cur.GPU)
+	}
+	if prev.Replays < 0 || cur.Replays < 0 {
+		return 0, fmt.Errorf("PCIe replay counter of GPU %d is not available", cur.GPU)
+	}
+	interval := cur.Time.Sub(prev.Time)
+	if interval <= 0 {
+		return 0, fmt.Errorf("PCIe counters of GPU %d were not sampled in order", cur.GPU)
+	}
+
+	return float64(counterDelta(prev.Replays, cur.Replays)) / interval.Seconds(), nil
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPCIeCounters(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+
+	blank := toPCIeCounters(0, []FieldValue_v2{fakeFieldValue(gpu, DCGM_FI_DEV_PCIE_TX_THROUGHPUT, DCGM_FT_INT64_BLANK, 1)})
+	assert.True(t, math.IsNaN(blank.TX))
+	assert.True(t, math.IsNaN(blank.RX))
+	assert.Equal(t, int64(-1), blank.Replays)
+
+	prev := toPCIeCounters(0, []FieldValue_v2{
+		fakeFieldValue(gpu, DCGM_FI_DEV_PCIE_TX_THROUGHPUT, 2, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_PCIE_RX_THROUGHPUT, 4, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_PCIE_REPLAY_COUNTER, 10, 1_000_000),
+	})
+	assert.InDelta(t, 2048, prev.TX, 0)
+	assert.InDelta(t, 4096, prev.RX, 0)
+	assert.Equal(t, int64(10), prev.Replays)
+
+	cur := toPCIeCounters(0, []FieldValue_v2{fakeFieldValue(gpu, DCGM_FI_DEV_PCIE_REPLAY_COUNTER, 30, 5_000_000)})
+	rate, err := PCIeReplayRate(prev, cur)
+	require.NoError(t, err)
+	assert.InDelta(t, 5, rate, 0)
+
+	reset := toPCIeCounters(0, []FieldValue_v2{fakeFieldValue(gpu, DCGM_FI_DEV_PCIE_REPLAY_COUNTER, 8, 9_000_000)})
+	rate, err = PCIeReplayRate(cur, reset)
+	require.NoError(t, err)
+	assert.InDelta(t, 2, rate, 0)
+
+	_, err = PCIeReplayRate(prev, blank)
+	require.Error(t, err)
+}
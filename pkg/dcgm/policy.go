@@ -5,7 +5,7 @@ package dcgm
 #include "dcgm_structs.h"
 
 // wrapper for go callback function
-extern int violationNotify(void* p);
+extern int violationNotify(void* p, uint64_t userData);
 */
 import "C"
 
@@ -14,6 +14,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"log"
+	"runtime/cgo"
 	"sync"
 	"time"
 	"unsafe"
@@ -124,29 +125,20 @@ type XidPolicyCondition struct {
 }
 
 var (
-	policyChanOnce sync.Once
-	policyMapOnce  sync.Once
-
-	// callbacks maps PolicyViolation channels with policy
-	// captures C callback() value for each violation condition
-	callbacks map[string]chan PolicyViolation
+	policyMapOnce sync.Once
 
 	// paramMap maps C.dcgmPolicy_t.parms index and limits
 	// to be used in setPolicy() for setting user selected policies
 	paramMap map[policyIndex]policyConditionParam
 )
 
-func makePolicyChannels() {
-	policyChanOnce.Do(func() {
-		callbacks = make(map[string]chan PolicyViolation)
-		callbacks["dbe"] = make(chan PolicyViolation, 1)
-		callbacks["pcie"] = make(chan PolicyViolation, 1)
-		callbacks["maxrtpg"] = make(chan PolicyViolation, 1)
-		callbacks["thermal"] = make(chan PolicyViolation, 1)
-		callbacks["power"] = make(chan PolicyViolation, 1)
-		callbacks["nvlink"] = make(chan PolicyViolation, 1)
-		callbacks["xid"] = make(chan PolicyViolation, 1)
-	})
+// policyRegistration captures the C callback() values of a single registerPolicy call. It is
+// passed to the callback as the userData of dcgmPolicyRegister_v2, through a cgo.Handle, so that
+// the violations of every client and registration are delivered to their own channel.
+type policyRegistration struct {
+	violations chan PolicyViolation
+	// done is closed once the registration stops listening, releasing callbacks in progress
+	done chan struct{}
 }
 
 func makePolicyParmsMap() {
@@ -201,7 +193,7 @@ func makePolicyParmsMap() {
 // ViolationRegistration is a go callback function for dcgmPolicyRegister() wrapped in C.violationNotify()
 //
 //export ViolationRegistration
-func ViolationRegistration(data unsafe.Pointer) int {
+func ViolationRegistration(data unsafe.Pointer, userData C.uint64_t) int {
 	var con policyCondition
 	var timestamp time.Time
 	var val any
@@ -272,26 +264,15 @@ func ViolationRegistration(data unsafe.Pointer) int {
 		GpuID:     gpuID,
 	}
 
-	switch con {
-	case DbePolicy:
-		callbacks["dbe"] <- err
-	case PCIePolicy:
-		callbacks["pcie"] <- err
-	case MaxRtPgPolicy:
-		callbacks["maxrtpg"] <- err
-	case ThermalPolicy:
-		callbacks["thermal"] <- err
-	case PowerPolicy:
-		callbacks["power"] <- err
-	case NvlinkPolicy:
-		callbacks["nvlink"] <- err
-	case XidPolicy:
-		callbacks["xid"] <- err
+	registration := cgo.Handle(userData).Value().(*policyRegistration)
+	select {
+	case registration.violations <- err:
+	case <-registration.done:
 	}
 	return 0
 }
 
-func setPolicy(groupID GroupHandle, condition C.dcgmPolicyCondition_t, paramList []policyIndex) (err error) {
+func (c *Client) setPolicy(groupID GroupHandle, condition C.dcgmPolicyCondition_t, paramList []policyIndex) (err error) {
 	var policy C.dcgmPolicy_t
 	policy.version = makeVersion1(unsafe.Sizeof(policy))
 	policy.mode = C.dcgmPolicyMode_t(C.DCGM_OPERATION_MODE_AUTO)
@@ -319,7 +300,7 @@ func setPolicy(groupID GroupHandle, condition C.dcgmPolicyCondition_t, paramList
 
 	var statusHandle C.dcgmStatus_t
 
-	result := C.dcgmPolicySet(handle.handle, groupID.handle, &policy, statusHandle)
+	result := C.dcgmPolicySet(c.dcgmHandle(), c.groupHandle(groupID), &policy, statusHandle)
 	if err = errorString(result); err != nil {
 		return fmt.Errorf("error setting policies: %s", err)
 	}
@@ -329,15 +310,14 @@ func setPolicy(groupID GroupHandle, condition C.dcgmPolicyCondition_t, paramList
 	return
 }
 
-func registerPolicy(ctx context.Context, groupID GroupHandle, typ ...policyCondition) (<-chan PolicyViolation, error) {
+func (c *Client) registerPolicy(ctx context.Context, groupID GroupHandle, typ ...policyCondition) (<-chan PolicyViolation, error) {
 	var err error
 	// init policy globals for internal API
-	makePolicyChannels()
 	makePolicyParmsMap()
 
 	// make a list of policy conditions for setting their parameters
 	paramKeys := make([]policyIndex, len(typ))
-	// get all conditions to be set in setPolicy()
+	// get all conditions to be set in c.setPolicy()
 	var condition C.dcgmPolicyCondition_t = 0
 
 	for i, t := range typ {
@@ -366,14 +346,21 @@ func registerPolicy(ctx context.Context, groupID GroupHandle, typ ...policyCondi
 		}
 	}
 
-	err = setPolicy(groupID, condition, paramKeys)
+	err = c.setPolicy(groupID, condition, paramKeys)
 	if err != nil {
 		return nil, err
 	}
 
-	result := C.dcgmPolicyRegister_v2(handle.handle, groupID.handle, condition, C.fpRecvUpdates(C.violationNotify), C.ulong(0))
+	registration := &policyRegistration{
+		violations: make(chan PolicyViolation, len(typ)),
+		done:       make(chan struct{}),
+	}
+	handle := cgo.NewHandle(registration)
+
+	result := C.dcgmPolicyRegister_v2(c.dcgmHandle(), c.groupHandle(groupID), condition, C.fpRecvUpdates(C.violationNotify), C.uint64_t(handle))
 
 	if err = errorString(result); err != nil {
+		handle.Delete()
 		return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
 
@@ -385,25 +372,20 @@ func registerPolicy(ctx context.Context, groupID GroupHandle, typ ...policyCondi
 		defer func() {
 			log.Println("unregister policy violation...")
 			close(violation)
-			unregisterPolicy(groupID, condition)
+			close(registration.done)
+			c.unregisterPolicy(groupID, condition)
+			// no callback of the registration runs once it is unregistered
+			handle.Delete()
 		}()
 
 		for {
 			select {
-			case dbe := <-callbacks["dbe"]:
-				violation <- dbe
-			case pcie := <-callbacks["pcie"]:
-				violation <- pcie
-			case maxrtpg := <-callbacks["maxrtpg"]:
-				violation <- maxrtpg
-			case thermal := <-callbacks["thermal"]:
-				violation <- thermal
-			case power := <-callbacks["power"]:
-				violation <- power
-			case nvlink := <-callbacks["nvlink"]:
-				violation <- nvlink
-			case xid := <-callbacks["xid"]:
-				violation <- xid
+			case v := <-registration.violations:
+				select {
+				case violation <- v:
+				case <-ctx.Done():
+					return
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -413,8 +395,8 @@ func registerPolicy(ctx context.Context, groupID GroupHandle, typ ...policyCondi
 	return violation, err
 }
 
-func unregisterPolicy(groupID GroupHandle, condition C.dcgmPolicyCondition_t) {
-	result := C.dcgmPolicyUnregister(handle.handle, groupID.handle, condition)
+func (c *Client) unregisterPolicy(groupID GroupHandle, condition C.dcgmPolicyCondition_t) {
+	result := C.dcgmPolicyUnregister(c.dcgmHandle(), c.groupHandle(groupID), condition)
 
 	if err := errorString(result); err != nil {
 		log.Println(fmt.Errorf("error unregistering policy: %s", err))
@@ -0,0 +1,172 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// powerFieldWatts is the number of watts in one unit of each power field
+var powerFieldWatts = map[Short]float64{
+	DCGM_FI_DEV_POWER_USAGE:          1,
+	DCGM_FI_DEV_POWER_USAGE_INSTANT:  1,
+	DCGM_FI_DEV_POWER_MGMT_LIMIT:     1,
+	DCGM_FI_DEV_ENFORCED_POWER_LIMIT: 1,
+
+	DCGM_FI_DEV_CPU_POWER_UTIL_CURRENT:    1,
+	DCGM_FI_DEV_CPU_POWER_LIMIT:           1,
+	DCGM_FI_DEV_SYSIO_POWER_UTIL_CURRENT:  1,
+	DCGM_FI_DEV_MODULE_POWER_UTIL_CURRENT: 1,
+}
+
+// energyFieldJoules is the number of joules in one unit of each energy field
+var energyFieldJoules = map[Short]float64{
+	DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION: 1e-3, // mJ
+}
+
+// Watts returns the value of a power field in watts. It returns false for other fields and for
+// blank or failed values.
+func (fv FieldValue_v2) Watts() (float64, bool) {
+	scale, ok := powerFieldWatts[fv.FieldID]
+	if !ok {
+		return 0, false
+	}
+	return scaledValue(fv, scale)
+}
+
+// Joules returns the value of an energy field in joules. It returns false for other fields and
+// for blank or failed values.
+func (fv FieldValue_v2) Joules() (float64, bool) {
+	scale, ok := energyFieldJoules[fv.FieldID]
+	if !ok {
+		return 0, false
+	}
+	return scaledValue(fv, scale)
+}
+
+// scaledValue returns an integer or double value multiplied by scale
+func scaledValue(fv FieldValue_v2, scale float64) (float64, bool) {
+	v := fv.Typed()
+	if f, ok := v.AsFloat64(); ok {
+		return f * scale, true
+	}
+	if i, ok := v.AsInt64(); ok {
+		return float64(i) * scale, true
+	}
+	return 0, false
+}
+
+// PowerReading is a sample of the power draw, power limits and energy counter of a GPU. Values
+// the GPU does not report are NaN.
+type PowerReading struct {
+	GPU  uint
+	Time time.Time
+	// Power is the power draw averaged by the driver over its last sampling window, in W
+	Power float64
+	// InstantPower is the instantaneous power draw in W
+	InstantPower float64
+	// PowerLimit is the power limit set on the GPU in W
+	PowerLimit float64
+	// EnforcedPowerLimit is the power limit the driver enforces after all limiters, in W
+	EnforcedPowerLimit float64
+	// Energy is the energy consumed since the driver was loaded, in J
+	Energy float64
+}
+
+var powerReadingFields = []Short{
+	DCGM_FI_DEV_POWER_USAGE,
+	DCGM_FI_DEV_POWER_USAGE_INSTANT,
+	DCGM_FI_DEV_POWER_MGMT_LIMIT,
+	DCGM_FI_DEV_ENFORCED_POWER_LIMIT,
+	DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION,
+}
+
+// GetPowerReading samples the power draw, power limits and energy counter of the specified GPU
+func GetPowerReading(gpuID uint) (PowerReading, error) {
+	return defaultClient.GetPowerReading(gpuID)
+}
+
+// GetPowerReading samples the power draw, power limits and energy counter of the specified GPU
+func (c *Client) GetPowerReading(gpuID uint) (PowerReading, error) {
+	if err := c.beginCall(); err != nil {
+		return PowerReading{}, err
+	}
+	defer c.endCall()
+
+	entities := []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}
+	values, err := c.sampleEntityFields("powerReading", entities, powerReadingFields)
+	if err != nil {
+		return PowerReading{}, fmt.Errorf("error getting power reading: %s", err)
+	}
+
+	return toPowerReading(gpuID, values), nil
+}
+
+func toPowerReading(gpuID uint, values []FieldValue_v2) PowerReading {
+	nan := math.NaN()
+	reading := PowerReading{GPU: gpuID, Power: nan, InstantPower: nan, PowerLimit: nan, EnforcedPowerLimit: nan, Energy: nan}
+
+	var ts int64
+	for _, value := range values {
+		var (
+			v  float64
+			ok bool
+		)
+		if value.FieldID == DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION {
+			v, ok = value.Joules()
+		} else {
+			v, ok = value.Watts()
+		}
+		if !ok {
+			continue
+		}
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_POWER_USAGE:
+			reading.Power = v
+		case DCGM_FI_DEV_POWER_USAGE_INSTANT:
+			reading.InstantPower = v
+		case DCGM_FI_DEV_POWER_MGMT_LIMIT:
+			reading.PowerLimit = v
+		case DCGM_FI_DEV_ENFORCED_POWER_LIMIT:
+			reading.EnforcedPowerLimit = v
+		case DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION:
+			reading.Energy = v
+		}
+		ts = max(ts, value.TS)
+	}
+
+	if ts > 0 {
+		reading.Time = timestampUSECToTime(ts)
+	}
+	return reading
+}
+
+// EnergyBetween returns the energy in J a GPU consumed between two readings. An energy counter
+// that decreased was reset by a driver reload and counts from zero.
+func EnergyBetween(prev, cur PowerReading) (float64, error) {
+	if prev.GPU != cur.GPU {
+		return 0, fmt.Errorf("power readings of GPU %d and GPU %d cannot be compared", prev.GPU, cur.GPU)
+	}
+	if math.IsNaN(prev.Energy) || math.IsNaN(cur.Energy) {
+		return 0, fmt.Errorf("energy counter of GPU %d is not available", cur.GPU)
+	}
+	if !cur.Time.After(prev.Time) {
+		return 0, fmt.Errorf("power readings of GPU %d were not sampled in order", cur.GPU)
+	}
+
+	if cur.Energy < prev.Energy {
+		return cur.Energy, nil
+	}
+	return cur.Energy - prev.Energy, nil
+}
+
+// AveragePower returns the average power draw in W of a GPU between two readings, computed from
+// the energy counter. Unlike Power, it does not miss spikes between samples.
+func AveragePower(prev, cur PowerReading) (float64, error) {
+	energy, err := EnergyBetween(prev, cur)
+	if err != nil {
+		return 0, err
+	}
+	return energy / cur.Time.Sub(prev.Time).Seconds(), nil
+}
@@ -0,0 +1,223 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// PowerProfile is a workload power profile, a validated set of power settings tuned for a kind of workload
+type PowerProfile uint
+
+const (
+	// PowerProfileMaxP maximizes performance
+	PowerProfileMaxP PowerProfile = C.DCGM_POWER_PROFILE_MAX_P
+	// PowerProfileMaxQ maximizes efficiency
+	PowerProfileMaxQ PowerProfile = C.DCGM_POWER_PROFILE_MAX_Q
+	// PowerProfileCompute suits compute-bound workloads
+	PowerProfileCompute PowerProfile = C.DCGM_POWER_PROFILE_COMPUTE
+	// PowerProfileMemoryBound suits memory-bound workloads
+	PowerProfileMemoryBound PowerProfile = C.DCGM_POWER_PROFILE_MEMORY_BOUND
+	// PowerProfileNetwork suits network-bound workloads
+	PowerProfileNetwork PowerProfile = C.DCGM_POWER_PROFILE_NETWORK
+	// PowerProfileBalanced balances performance and power
+	PowerProfileBalanced PowerProfile = C.DCGM_POWER_PROFILE_BALANCED
+	// PowerProfileLLMInference suits LLM inference
+	PowerProfileLLMInference PowerProfile = C.DCGM_POWER_PROFILE_LLM_INFERENCE
+	// PowerProfileLLMTraining suits LLM training
+	PowerProfileLLMTraining PowerProfile = C.DCGM_POWER_PROFILE_LLM_TRAINING
+	// PowerProfileRBM is the RBM profile
+	PowerProfileRBM PowerProfile = C.DCGM_POWER_PROFILE_RBM
+	// PowerProfileDCPCIe is the DCPCIe profile
+	PowerProfileDCPCIe PowerProfile = C.DCGM_POWER_PROFILE_DCPCIE
+	// PowerProfileHMMASparse suits sparse matrix multiplication
+	PowerProfileHMMASparse PowerProfile = C.DCGM_POWER_PROFILE_HMMA_SPARSE
+	// PowerProfileHMMADense suits dense matrix multiplication
+	PowerProfileHMMADense PowerProfile = C.DCGM_POWER_PROFILE_HMMA_DENSE
+	// PowerProfileSyncBalanced balances power across synchronized GPUs
+	PowerProfileSyncBalanced PowerProfile = C.DCGM_POWER_PROFILE_SYNC_BALANCED
+	// PowerProfileHPC suits HPC workloads
+	PowerProfileHPC PowerProfile = C.DCGM_POWER_PROFILE_HPC
+	// PowerProfileMIG suits MIG partitioned GPUs
+	PowerProfileMIG PowerProfile = C.DCGM_POWER_PROFILE_MIG
+)
+
+func (p PowerProfile) String() string {
+	switch p {
+	case PowerProfileMaxP:
+		return "Max-P"
+	case PowerProfileMaxQ:
+		return "Max-Q"
+	case PowerProfileCompute:
+		return "Compute"
+	case PowerProfileMemoryBound:
+		return "Memory Bound"
+	case PowerProfileNetwork:
+		return "Network"
+	case PowerProfileBalanced:
+		return "Balanced"
+	case PowerProfileLLMInference:
+		return "LLM Inference"
+	case PowerProfileLLMTraining:
+		return "LLM Training"
+	case PowerProfileRBM:
+		return "RBM"
+	case PowerProfileDCPCIe:
+		return "DCPCIe"
+	case PowerProfileHMMASparse:
+		return "HMMA Sparse"
+	case PowerProfileHMMADense:
+		return "HMMA Dense"
+	case PowerProfileSyncBalanced:
+		return "Sync Balanced"
+	case PowerProfileHPC:
+		return "HPC"
+	case PowerProfileMIG:
+		return "MIG"
+	}
+	return fmt.Sprintf("Profile %d", uint(p))
+}
+
+// PowerProfileInfo describes a workload power profile supported by a GPU
+type PowerProfileInfo struct {
+	Profile  PowerProfile
+	Priority uint
+	// Conflicts lists the profiles that cannot be requested together with this one
+	Conflicts []PowerProfile
+}
+
+// WorkloadPowerProfiles contains the workload power profiles of a GPU and their state
+type WorkloadPowerProfiles struct {
+	Supported []PowerProfileInfo
+	Valid     []PowerProfile
+	Requested []PowerProfile
+	Enforced  []PowerProfile
+}
+
+// powerProfileMask is the bitmask of profiles DCGM uses in its structs
+type powerProfileMask [C.DCGM_POWER_PROFILE_ARRAY_SIZE]uint32
+
+func (m powerProfileMask) profiles() []PowerProfile {
+	profiles := []PowerProfile{}
+	for i, word := range m {
+		for bit := 0; bit < C.DCGM_POWER_PROFILE_MASK_BITS_PER_ELEM; bit++ {
+			if word&(1<<bit) != 0 {
+				profiles = append(profiles, PowerProfile(i*C.DCGM_POWER_PROFILE_MASK_BITS_PER_ELEM+bit))
+			}
+		}
+	}
+	return profiles
+}
+
+func toPowerProfileMask(profiles []PowerProfile) (powerProfileMask, error) {
+	var m powerProfileMask
+	for _, p := range profiles {
+		if p >= C.DCGM_POWER_PROFILE_ARRAY_SIZE*C.DCGM_POWER_PROFILE_MASK_BITS_PER_ELEM {
+			return m, fmt.Errorf("invalid workload power profile %d", uint(p))
+		}
+		m[p/C.DCGM_POWER_PROFILE_MASK_BITS_PER_ELEM] |= 1 << (p % C.DCGM_POWER_PROFILE_MASK_BITS_PER_ELEM)
+	}
+	return m, nil
+}
+
+func fromCPowerProfileMask(c [C.DCGM_POWER_PROFILE_ARRAY_SIZE]C.uint) powerProfileMask {
+	var m powerProfileMask
+	for i := range m {
+		m[i] = uint32(c[i])
+	}
+	return m
+}
+
+// GetWorkloadPowerProfiles returns the workload power profiles supported by the specified GPU and
+// which of them are currently requested and enforced
+func GetWorkloadPowerProfiles(gpuID uint) (WorkloadPowerProfiles, error) {
+	return defaultClient.GetWorkloadPowerProfiles(gpuID)
+}
+
+// GetWorkloadPowerProfiles returns the workload power profiles supported by the specified GPU and
+// which of them are currently requested and enforced
+func (c *Client) GetWorkloadPowerProfiles(gpuID uint) (WorkloadPowerProfiles, error) {
+	if err := c.beginCall(); err != nil {
+		return WorkloadPowerProfiles{}, err
+	}
+	defer c.endCall()
+
+	var info C.dcgmWorkloadPowerProfileProfilesInfo_v1
+	info.version = makeVersion1(unsafe.Sizeof(info))
+
+	var status C.dcgmDeviceWorkloadPowerProfilesStatus_v1
+	status.version = makeVersion1(unsafe.Sizeof(status))
+
+	result := C.dcgmGetDeviceWorkloadPowerProfileInfo(c.dcgmHandle(), C.uint(gpuID), &info, &status)
+	if err := errorString(result); err != nil {
+		return WorkloadPowerProfiles{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	count := min(int(info.profileCount), int(C.DCGM_POWER_PROFILE_MAX_NUM))
+	profiles := WorkloadPowerProfiles{
+		Supported: make([]PowerProfileInfo, count),
+		Valid:     fromCPowerProfileMask(status.profileMask).profiles(),
+		Requested: fromCPowerProfileMask(status.requestedProfileMask).profiles(),
+		Enforced:  fromCPowerProfileMask(status.enforcedProfileMask).profiles(),
+	}
+
+	for i := range profiles.Supported {
+		profile := info.workloadPowerProfile[i]
+		profiles.Supported[i] = PowerProfileInfo{
+			Profile:   PowerProfile(profile.profileId),
+			Priority:  uint(profile.priority),
+			Conflicts: fromCPowerProfileMask(profile.conflictingMask).profiles(),
+		}
+	}
+
+	return profiles, nil
+}
+
+// SetWorkloadPowerProfiles requests exactly the given workload power profiles on all GPUs of the
+// group, replacing any profiles requested before. An empty list clears the requested profiles.
+// Other settings of the GPUs are left unchanged.
+func SetWorkloadPowerProfiles(groupID GroupHandle, profiles []PowerProfile) error {
+	return defaultClient.SetWorkloadPowerProfiles(groupID, profiles)
+}
+
+// SetWorkloadPowerProfiles requests exactly the given workload power profiles on all GPUs of the
+// group, replacing any profiles requested before. An empty list clears the requested profiles.
+// Other settings of the GPUs are left unchanged.
+func (c *Client) SetWorkloadPowerProfiles(groupID GroupHandle, profiles []PowerProfile) error {
+	mask, err := toPowerProfileMask(profiles)
+	if err != nil {
+		return err
+	}
+
+	if err = c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	var config C.dcgmConfig_v2
+	config.version = makeVersion2(unsafe.Sizeof(config))
+	config.gpuId = C.DCGM_INT32_BLANK
+	config.eccMode = C.DCGM_INT32_BLANK
+	config.computeMode = C.DCGM_INT32_BLANK
+	config.perfState.syncBoost = C.DCGM_INT32_BLANK
+	config.perfState.targetClocks.version = C.dcgmClockSet_version1
+	config.perfState.targetClocks.memClock = C.DCGM_INT32_BLANK
+	config.perfState.targetClocks.smClock = C.DCGM_INT32_BLANK
+	config.powerLimit._type = C.DCGM_CONFIG_POWER_CAP_INDIVIDUAL
+	config.powerLimit.val = C.DCGM_INT32_BLANK
+	for i := range mask {
+		config.workloadPowerProfiles[i] = C.uint(mask[i])
+	}
+
+	result := C.dcgmConfigSet(c.dcgmHandle(), c.groupHandle(groupID), &config, C.dcgmStatus_t(0))
+	if err = errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return nil
+}
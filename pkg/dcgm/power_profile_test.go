@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPowerProfileMask(t *testing.T) {
+	profiles := []PowerProfile{PowerProfileMaxQ, PowerProfileLLMInference, PowerProfile(40)}
+
+	mask, err := toPowerProfileMask(profiles)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1<<1|1<<6), mask[0])
+	assert.Equal(t, uint32(1<<8), mask[1])
+	assert.Equal(t, profiles, mask.profiles())
+
+	_, err = toPowerProfileMask([]PowerProfile{PowerProfile(256)})
+	require.Error(t, err)
+
+	assert.Equal(t, "LLM Training", PowerProfileLLMTraining.String())
+}
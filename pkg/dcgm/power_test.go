@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPowerReading(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+
+	watts, ok := fakeFloat64FieldValue(gpu, DCGM_FI_DEV_POWER_USAGE, 250.5, 0).Watts()
+	require.True(t, ok)
+	assert.InDelta(t, 250.5, watts, 0)
+	_, ok = fakeFloat64FieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 1, 0).Watts()
+	assert.False(t, ok)
+	joules, ok := fakeFieldValue(gpu, DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION, 1500, 0).Joules()
+	require.True(t, ok)
+	assert.InDelta(t, 1.5, joules, 0)
+
+	prev := toPowerReading(0, []FieldValue_v2{
+		fakeFloat64FieldValue(gpu, DCGM_FI_DEV_POWER_USAGE, 300, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_ENFORCED_POWER_LIMIT, 700, 1_000_000),
+		fakeFieldValue(gpu, DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION, 1_000_000, 1_000_000),
+		fakeFloat64FieldValue(gpu, DCGM_FI_DEV_POWER_USAGE_INSTANT, DCGM_FT_FP64_NOT_SUPPORTED, 1_000_000),
+	})
+	assert.InDelta(t, 300, prev.Power, 0)
+	assert.InDelta(t, 700, prev.EnforcedPowerLimit, 0)
+	assert.InDelta(t, 1000, prev.Energy, 0)
+	assert.True(t, math.IsNaN(prev.InstantPower))
+	assert.True(t, math.IsNaN(prev.PowerLimit))
+
+	cur := toPowerReading(0, []FieldValue_v2{fakeFieldValue(gpu, DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION, 3_000_000, 5_000_000)})
+	energy, err := EnergyBetween(prev, cur)
+	require.NoError(t, err)
+	assert.InDelta(t, 2000, energy, 0)
+	power, err := AveragePower(prev, cur)
+	require.NoError(t, err)
+	assert.InDelta(t, 500, power, 0)
+
+	reset := toPowerReading(0, []FieldValue_v2{fakeFieldValue(gpu, DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION, 400_000, 6_000_000)})
+	energy, err = EnergyBetween(cur, reset)
+	require.NoError(t, err)
+	assert.InDelta(t, 400, energy, 0)
+
+	_, err = EnergyBetween(cur, prev)
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package dcgm
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	capSysAdmin = 21
+
+	nvidiaCtlDevice     = "/dev/nvidiactl"
+	nvidiaDriverParams  = "/proc/driver/nvidia/params"
+	procSelfStatus      = "/proc/self/status"
+	profilingAdminParam = "RmProfilingAdminOnly"
+)
+
+// PrivilegeReport describes what the current process is allowed to do with DCGM
+type PrivilegeReport struct {
+	// Root is true if the process runs with effective UID 0
+	Root bool
+	// SysAdmin is true if the process holds CAP_SYS_ADMIN
+	SysAdmin bool
+	// DeviceAccess is true if the process can open the NVIDIA control device read-write
+	DeviceAccess bool
+	// ProfilingAdminOnly is true if the driver restricts profiling counters to administrators
+	ProfilingAdminOnly bool
+
+	// EmbeddedMode is true if an embedded hostengine can be started
+	EmbeddedMode bool
+	// ConfigChanges is true if settings such as power limits and policies can be changed
+	ConfigChanges bool
+	// Profiling is true if DCP profiling metrics can be collected
+	Profiling bool
+
+	// Guidance lists what is missing for the operations that are not allowed
+	Guidance []string
+}
+
+// ReadOnly reports whether the process is limited to read-only monitoring
+func (r PrivilegeReport) ReadOnly() bool {
+	return !r.ConfigChanges
+}
+
+// CheckPrivileges inspects the privileges of the current process so that tools can fall back to
+// read-only monitoring instead of failing with DCGM errors. Privileges only matter for Embedded
+// mode; in Standalone mode they are determined by the nv-hostengine process.
+func CheckPrivileges() PrivilegeReport {
+	return checkPrivileges(os.Geteuid(), os.ReadFile, func(path string) bool {
+		return syscall.Access(path, 0x6) == nil // R_OK | W_OK
+	})
+}
+
+func checkPrivileges(euid int, readFile func(string) ([]byte, error), canReadWrite func(string) bool) PrivilegeReport {
+	report := PrivilegeReport{
+		Root:         euid == 0,
+		DeviceAccess: canReadWrite(nvidiaCtlDevice),
+	}
+
+	if status, err := readFile(procSelfStatus); err == nil {
+		report.SysAdmin = hasCapability(status, capSysAdmin)
+	}
+	if params, err := readFile(nvidiaDriverParams); err == nil {
+		report.ProfilingAdminOnly = driverParam(params, profilingAdminParam) != "0"
+	}
+
+	admin := report.Root || report.SysAdmin
+
+	report.EmbeddedMode = report.DeviceAccess
+	report.ConfigChanges = report.DeviceAccess && report.Root
+	report.Profiling = report.DeviceAccess && (admin || !report.ProfilingAdminOnly)
+
+	if !report.DeviceAccess {
+		report.Guidance = append(report.Guidance,
+			"cannot open "+nvidiaCtlDevice+": run with access to the NVIDIA devices or connect to an nv-hostengine in Standalone mode")
+	}
+	if !report.Root {
+		report.Guidance = append(report.Guidance,
+			"not running as root: changing GPU configuration and policies requires root or an nv-hostengine running as root")
+	}
+	if report.ProfilingAdminOnly && !admin {
+		report.Guidance = append(report.Guidance,
+			"profiling is restricted to administrators: grant CAP_SYS_ADMIN or set NVreg_RestrictProfilingToAdminUsers=0")
+	}
+
+	return report
+}
+
+// hasCapability reports whether the CapEff line of a /proc/<pid>/status file includes capability bit
+func hasCapability(status []byte, bit uint) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(status))
+	for scanner.Scan() {
+		value, found := strings.CutPrefix(scanner.Text(), "CapEff:")
+		if !found {
+			continue
+		}
+		caps, err := strconv.ParseUint(strings.TrimSpace(value), 16, 64)
+		if err != nil {
+			return false
+		}
+		return caps&(1<<bit) != 0
+	}
+	return false
+}
+
+// driverParam returns the value of key in the NVIDIA driver params file, or "" if it is absent
+func driverParam(params []byte, key string) string {
+	scanner := bufio.NewScanner(bytes.NewReader(params))
+	for scanner.Scan() {
+		name, value, found := strings.Cut(scanner.Text(), ":")
+		if found && strings.TrimSpace(name) == key {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPrivileges(t *testing.T) {
+	files := map[string]string{
+		procSelfStatus:     "Name:\ttest\nCapEff:\t0000000000000000\n",
+		nvidiaDriverParams: "ResmanDebugLevel: 4294967295\nRmProfilingAdminOnly: 1\n",
+	}
+	readFile := func(path string) ([]byte, error) {
+		if content, ok := files[path]; ok {
+			return []byte(content), nil
+		}
+		return nil, os.ErrNotExist
+	}
+	deviceAccess := func(string) bool { return true }
+
+	report := checkPrivileges(1000, readFile, deviceAccess)
+	assert.False(t, report.Root)
+	assert.False(t, report.SysAdmin)
+	assert.True(t, report.ProfilingAdminOnly)
+	assert.True(t, report.EmbeddedMode)
+	assert.False(t, report.Profiling)
+	assert.True(t, report.ReadOnly())
+	assert.Len(t, report.Guidance, 2)
+
+	files[procSelfStatus] = "CapEff:\t0000000000200000\n"
+	report = checkPrivileges(1000, readFile, deviceAccess)
+	assert.True(t, report.SysAdmin)
+	assert.True(t, report.Profiling)
+
+	report = checkPrivileges(0, readFile, deviceAccess)
+	assert.True(t, report.Root)
+	assert.False(t, report.ReadOnly())
+	assert.Empty(t, report.Guidance)
+
+	report = checkPrivileges(0, readFile, func(string) bool { return false })
+	assert.False(t, report.EmbeddedMode)
+	assert.True(t, report.ReadOnly())
+}
@@ -93,46 +93,57 @@ type ProcessInfo struct {
 // WatchPidFieldsEx is the same as WatchPidFields, but allows for modifying the update frequency, max samples, max
 // sample age, and the GPUs on which to enable watches.
 func WatchPidFieldsEx(updateFreq, maxKeepAge time.Duration, maxKeepSamples int, gpus ...uint) (GroupHandle, error) {
-	return watchPidFields(updateFreq, maxKeepAge, maxKeepSamples, gpus...)
+	return defaultClient.WatchPidFieldsEx(updateFreq, maxKeepAge, maxKeepSamples, gpus...)
 }
 
-func watchPidFields(updateFreq, maxKeepAge time.Duration, maxKeepSamples int, gpus ...uint) (groupId GroupHandle, err error) {
+// WatchPidFieldsEx is the same as WatchPidFields, but allows for modifying the update frequency, max samples, max
+// sample age, and the GPUs on which to enable watches.
+func (c *Client) WatchPidFieldsEx(updateFreq, maxKeepAge time.Duration, maxKeepSamples int, gpus ...uint) (GroupHandle, error) {
+	if err := c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer c.endCall()
+
+	return c.watchPidFields(updateFreq, maxKeepAge, maxKeepSamples, gpus...)
+}
+
+func (c *Client) watchPidFields(updateFreq, maxKeepAge time.Duration, maxKeepSamples int, gpus ...uint) (groupId GroupHandle, err error) {
 	groupName := fmt.Sprintf("watchPids%d", rand.Uint64())
-	group, err := CreateGroup(groupName)
+	group, err := c.CreateGroup(groupName)
 	if err != nil {
 		return
 	}
 	numGpus := len(gpus)
 
 	if numGpus == 0 {
-		gpus, err = getSupportedDevices()
+		gpus, err = c.getSupportedDevices()
 		if err != nil {
 			return
 		}
 	}
 
 	for _, gpu := range gpus {
-		err = AddToGroup(group, gpu)
+		err = c.AddToGroup(group, gpu)
 		if err != nil {
 			return
 		}
 	}
 
-	result := C.dcgmWatchPidFields(handle.handle, group.handle, C.longlong(updateFreq.Microseconds()), C.double(maxKeepAge.Seconds()), C.int(maxKeepSamples))
+	result := C.dcgmWatchPidFields(c.dcgmHandle(), c.groupHandle(group), C.longlong(updateFreq.Microseconds()), C.double(maxKeepAge.Seconds()), C.int(maxKeepSamples))
 
 	if err = errorString(result); err != nil {
 		return groupId, &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
-	_ = UpdateAllFields()
+	_ = c.UpdateAllFields()
 	return group, nil
 }
 
-func getProcessInfo(groupID GroupHandle, pid uint) (processInfo []ProcessInfo, err error) {
+func (c *Client) getProcessInfo(groupID GroupHandle, pid uint) (processInfo []ProcessInfo, err error) {
 	var pidInfo C.dcgmPidInfo_t
 	pidInfo.version = makeVersion2(unsafe.Sizeof(pidInfo))
 	pidInfo.pid = C.uint(pid)
 
-	result := C.dcgmGetPidInfo(handle.handle, groupID.handle, &pidInfo)
+	result := C.dcgmGetPidInfo(c.dcgmHandle(), c.groupHandle(groupID), &pidInfo)
 
 	if err = errorString(result); err != nil {
 		return processInfo, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -0,0 +1,221 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// processUtilizationKeepAge is how long DCGM keeps the samples of a ProcessUtilizationSampler.
+// Windows longer than this only cover their last part.
+const processUtilizationKeepAge = time.Hour
+
+// ProcessUtilization is the utilization of a GPU by one process over a sampling window
+type ProcessUtilization struct {
+	GPU uint
+	PID uint
+	// Graphics is set for graphics processes, compute processes are reported otherwise
+	Graphics bool
+	SMUtil   float64 // %
+	MemUtil  float64 // %
+}
+
+// ProcessUtilizationSample holds the utilization of every process that used a GPU between
+// Start and End
+type ProcessUtilizationSample struct {
+	Start     time.Time
+	End       time.Time
+	Processes []ProcessUtilization
+}
+
+// ProcessUtilizationSampler attributes the utilization of a set of GPUs to the processes using
+// them. It records the utilization with a DCGM job, which is restarted on every Sample so each
+// sample covers the window since the previous one.
+type ProcessUtilizationSampler struct {
+	client *Client
+	group  GroupHandle
+
+	mu    sync.Mutex
+	job   string
+	start time.Time
+}
+
+// NewProcessUtilizationSampler starts recording the per-process utilization of gpus, or of all
+// supported GPUs if none are given. updateFreq is how often DCGM samples the utilization. The
+// hostengine must run as root or accounting mode must be enabled on the GPUs.
+func NewProcessUtilizationSampler(updateFreq time.Duration, gpus ...uint) (*ProcessUtilizationSampler, error) {
+	return defaultClient.NewProcessUtilizationSampler(updateFreq, gpus...)
+}
+
+// NewProcessUtilizationSampler starts recording the per-process utilization of gpus, or of all
+// supported GPUs if none are given. updateFreq is how often DCGM samples the utilization. The
+// hostengine must run as root or accounting mode must be enabled on the GPUs.
+func (c *Client) NewProcessUtilizationSampler(updateFreq time.Duration, gpus ...uint) (*ProcessUtilizationSampler, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	if updateFreq <= 0 {
+		return nil, fmt.Errorf("invalid update frequency %s", updateFreq)
+	}
+
+	var err error
+	if len(gpus) == 0 {
+		if gpus, err = c.getSupportedDevices(); err != nil {
+			return nil, err
+		}
+	}
+
+	group, err := c.CreateGroup(fmt.Sprintf("processUtilization%d", rand.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ProcessUtilizationSampler{client: c, group: group}
+	for _, gpu := range gpus {
+		if err = c.AddToGroup(group, gpu); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		result := C.dcgmWatchJobFields(c.dcgmHandle(), c.groupHandle(group), C.longlong(updateFreq.Microseconds()),
+			C.double(processUtilizationKeepAge.Seconds()), C.int(0))
+		if result != C.DCGM_ST_OK {
+			err = &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+	}
+	if err == nil {
+		err = s.startJob()
+	}
+	if err != nil {
+		_ = c.DestroyGroup(group)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Sample returns the utilization of every process since the previous sample, or since the
+// sampler was created, and starts a new window
+func (s *ProcessUtilizationSampler) Sample() (ProcessUtilizationSample, error) {
+	if err := s.client.beginCall(); err != nil {
+		return ProcessUtilizationSample{}, err
+	}
+	defer s.client.endCall()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.job == "" {
+		return ProcessUtilizationSample{}, fmt.Errorf("process utilization sampler is closed")
+	}
+
+	var info C.dcgmJobInfo_t
+	info.version = makeVersion3(unsafe.Sizeof(info))
+
+	result := s.withJobID(func(job *C.char) C.dcgmReturn_t {
+		if result := C.dcgmJobStopStats(s.client.dcgmHandle(), job); result != C.DCGM_ST_OK {
+			return result
+		}
+		return C.dcgmJobGetStats(s.client.dcgmHandle(), job, &info)
+	})
+	if result != C.DCGM_ST_OK {
+		return ProcessUtilizationSample{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	sample := ProcessUtilizationSample{Start: s.start, End: time.Now(), Processes: toProcessUtilizations(&info)}
+
+	s.removeJob()
+	if err := s.startJob(); err != nil {
+		return sample, fmt.Errorf("error starting next sampling window: %w", err)
+	}
+	return sample, nil
+}
+
+// Close stops recording and releases the DCGM job and group
+func (s *ProcessUtilizationSampler) Close() error {
+	if err := s.client.beginCall(); err != nil {
+		return err
+	}
+	defer s.client.endCall()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.job == "" {
+		return nil
+	}
+	s.withJobID(func(job *C.char) C.dcgmReturn_t {
+		return C.dcgmJobStopStats(s.client.dcgmHandle(), job)
+	})
+	s.removeJob()
+	return s.client.DestroyGroup(s.group)
+}
+
+// startJob starts recording a new window under a fresh job ID. The caller must hold s.mu, except
+// while the sampler is being created.
+func (s *ProcessUtilizationSampler) startJob() error {
+	s.job = fmt.Sprintf("processUtilization%d", rand.Uint64())
+	s.start = time.Now()
+
+	result := s.withJobID(func(job *C.char) C.dcgmReturn_t {
+		return C.dcgmJobStartStats(s.client.dcgmHandle(), s.client.groupHandle(s.group), job)
+	})
+	if result != C.DCGM_ST_OK {
+		s.job = ""
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+	return nil
+}
+
+// removeJob forgets the current job. The caller must hold s.mu.
+func (s *ProcessUtilizationSampler) removeJob() {
+	s.withJobID(func(job *C.char) C.dcgmReturn_t {
+		return C.dcgmJobRemove(s.client.dcgmHandle(), job)
+	})
+	s.job = ""
+}
+
+// withJobID calls f with the current job ID as a C string
+func (s *ProcessUtilizationSampler) withJobID(f func(job *C.char) C.dcgmReturn_t) C.dcgmReturn_t {
+	// the API takes the job ID as char[64]
+	var job [64]C.char
+	for i := 0; i < len(s.job) && i < len(job)-1; i++ {
+		job[i] = C.char(s.job[i])
+	}
+	return f(&job[0])
+}
+
+func toProcessUtilizations(info *C.dcgmJobInfo_t) []ProcessUtilization {
+	processes := []ProcessUtilization{}
+	for _, gpu := range info.gpus[:min(int(info.numGpus), len(info.gpus))] {
+		processes = appendProcessUtilizations(processes, uint(gpu.gpuId), false, gpu.computePidInfo[:], int(gpu.numComputePids))
+		processes = appendProcessUtilizations(processes, uint(gpu.gpuId), true, gpu.graphicsPidInfo[:], int(gpu.numGraphicsPids))
+	}
+	return processes
+}
+
+func appendProcessUtilizations(processes []ProcessUtilization, gpu uint, graphics bool, infos []C.dcgmProcessUtilInfo_t, count int) []ProcessUtilization {
+	for _, info := range infos[:max(min(count, len(infos)), 0)] {
+		if info.pid == 0 {
+			continue
+		}
+		processes = append(processes, ProcessUtilization{
+			GPU:      gpu,
+			PID:      uint(info.pid),
+			Graphics: graphics,
+			SMUtil:   float64(info.smUtil),
+			MemUtil:  float64(info.memUtil),
+		})
+	}
+	return processes
+}
@@ -7,6 +7,8 @@ package dcgm
 import "C"
 
 import (
+	"fmt"
+	"slices"
 	"unsafe"
 )
 
@@ -17,7 +19,7 @@ type MetricGroup struct {
 	FieldIds []uint
 }
 
-func getSupportedMetricGroups(gpuID uint) ([]MetricGroup, error) {
+func (c *Client) getSupportedMetricGroups(gpuID uint) ([]MetricGroup, error) {
 	var (
 		groupInfo C.dcgmProfGetMetricGroups_t
 		err       error
@@ -28,7 +30,7 @@ func getSupportedMetricGroups(gpuID uint) ([]MetricGroup, error) {
 
 	groupInfo.gpuId = C.uint(gpuID)
 
-	result := C.dcgmProfGetSupportedMetricGroups(handle.handle, &groupInfo)
+	result := C.dcgmProfGetSupportedMetricGroups(c.dcgmHandle(), &groupInfo)
 
 	if err = errorString(result); err != nil {
 		return nil, &Error{msg: C.GoString(C.errorString(result)), Code: result}
@@ -51,3 +53,107 @@ func getSupportedMetricGroups(gpuID uint) ([]MetricGroup, error) {
 
 	return groups, nil
 }
+
+// Contains reports whether the metric group includes the field
+func (g MetricGroup) Contains(fieldID Short) bool {
+	return slices.Contains(g.FieldIds, uint(fieldID))
+}
+
+// SupportedProfilingFields returns the profiling fields of all metric groups, sorted and without
+// duplicates
+func SupportedProfilingFields(groups []MetricGroup) []Short {
+	var fields []Short
+	for _, group := range groups {
+		for _, fieldID := range group.FieldIds {
+			fields = append(fields, Short(fieldID))
+		}
+	}
+	slices.Sort(fields)
+	return slices.Compact(fields)
+}
+
+// SelectMetricGroups returns the metric groups that must be active to watch all fields at the
+// same time. The hardware can only collect one metric group per major ID at once, so fields
+// that are only available in different groups with the same major ID cannot be watched
+// together; an error is returned for them, as for fields no group supports.
+func SelectMetricGroups(groups []MetricGroup, fields []Short) ([]MetricGroup, error) {
+	// candidates holds, per major ID, the groups that can still provide every field seen so far
+	candidates := make(map[uint][]MetricGroup)
+	var majors []uint
+
+	for _, fieldID := range fields {
+		var majorsWithField []uint
+		for _, group := range groups {
+			if group.Contains(fieldID) && !slices.Contains(majorsWithField, group.Major) {
+				majorsWithField = append(majorsWithField, group.Major)
+			}
+		}
+		if len(majorsWithField) == 0 {
+			return nil, fmt.Errorf("profiling field %d is not supported", fieldID)
+		}
+
+		// prefer the major IDs already in use, so that fields share groups where possible
+		slices.SortStableFunc(majorsWithField, func(a, b uint) int {
+			_, aUsed := candidates[a]
+			_, bUsed := candidates[b]
+			switch {
+			case aUsed && !bUsed:
+				return -1
+			case bUsed && !aUsed:
+				return 1
+			}
+			return 0
+		})
+
+		placed := false
+		for _, major := range majorsWithField {
+			current, used := candidates[major]
+			if !used {
+				for _, group := range groups {
+					if group.Major == major {
+						current = append(current, group)
+					}
+				}
+			}
+
+			remaining := slices.DeleteFunc(slices.Clone(current), func(g MetricGroup) bool { return !g.Contains(fieldID) })
+			if len(remaining) == 0 {
+				continue
+			}
+
+			if !used {
+				majors = append(majors, major)
+			}
+			candidates[major] = remaining
+			placed = true
+			break
+		}
+		if !placed {
+			return nil, fmt.Errorf("profiling field %d cannot be watched together with the other fields", fieldID)
+		}
+	}
+
+	selected := make([]MetricGroup, 0, len(majors))
+	for _, major := range majors {
+		selected = append(selected, candidates[major][0])
+	}
+	return selected, nil
+}
+
+// metricGroupsForFields returns a metric group for every field, without requiring the groups to
+// be collectable at the same time. Each group is returned once.
+func metricGroupsForFields(groups []MetricGroup, fields []Short) ([]MetricGroup, error) {
+	var selected []MetricGroup
+	for _, fieldID := range fields {
+		if slices.ContainsFunc(selected, func(g MetricGroup) bool { return g.Contains(fieldID) }) {
+			continue
+		}
+
+		i := slices.IndexFunc(groups, func(g MetricGroup) bool { return g.Contains(fieldID) })
+		if i < 0 {
+			return nil, fmt.Errorf("profiling field %d is not supported", fieldID)
+		}
+		selected = append(selected, groups[i])
+	}
+	return selected, nil
+}
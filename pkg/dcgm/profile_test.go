@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectMetricGroups(t *testing.T) {
+	groups := []MetricGroup{
+		{Major: 0, Minor: 0, FieldIds: []uint{1001, 1002, 1003}},
+		{Major: 0, Minor: 1, FieldIds: []uint{1001, 1004}},
+		{Major: 1, Minor: 0, FieldIds: []uint{1009, 1010}},
+		{Major: 2, Minor: 0, FieldIds: []uint{1011, 1012}},
+	}
+
+	assert.Equal(t, []Short{1001, 1002, 1003, 1004, 1009, 1010, 1011, 1012}, SupportedProfilingFields(groups))
+
+	selected, err := SelectMetricGroups(groups, []Short{1001, 1004, 1009})
+	require.NoError(t, err)
+	assert.Equal(t, []MetricGroup{groups[1], groups[2]}, selected)
+
+	selected, err = SelectMetricGroups(groups, []Short{1001, 1002, 1011})
+	require.NoError(t, err)
+	assert.Equal(t, []MetricGroup{groups[0], groups[3]}, selected)
+
+	_, err = SelectMetricGroups(groups, []Short{1002, 1004})
+	require.Error(t, err)
+
+	_, err = SelectMetricGroups(groups, []Short{1005})
+	require.Error(t, err)
+
+	selected, err = SelectMetricGroups(groups, nil)
+	require.NoError(t, err)
+	assert.Empty(t, selected)
+
+	selected, err = metricGroupsForFields(groups, []Short{1002, 1004, 1001, 1010})
+	require.NoError(t, err)
+	assert.Equal(t, []MetricGroup{groups[0], groups[1], groups[2]}, selected)
+
+	_, err = metricGroupsForFields(groups, []Short{1005})
+	require.Error(t, err)
+}
+
+func TestWatchProfilingFieldsInvalid(t *testing.T) {
+	c := &Client{closing: true}
+
+	_, err := c.WatchProfilingFields(nil, []Short{DCGM_FI_PROF_SM_ACTIVE}, DefaultWatchOptions())
+	require.Error(t, err)
+
+	_, err = c.WatchProfilingFields([]uint{0}, []Short{DCGM_FI_DEV_GPU_TEMP}, DefaultWatchOptions())
+	require.Error(t, err)
+
+	_, err = c.WatchProfilingFields([]uint{0}, []Short{DCGM_FI_PROF_SM_ACTIVE}, WatchOptions{})
+	require.Error(t, err)
+
+	_, err = c.WatchProfilingFields([]uint{0}, []Short{DCGM_FI_PROF_SM_ACTIVE}, DefaultWatchOptions())
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,216 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"unsafe"
+)
+
+// ProfilingWatch is a watch of profiling fields on a set of GPUs. Close it to release the metric
+// groups it holds, so that other profiling tools and watches can use the hardware counters.
+type ProfilingWatch struct {
+	client     *Client
+	Group      GroupHandle
+	FieldGroup FieldHandle
+	// MetricGroups are the metric groups the watch activates on each GPU, keyed by GPU ID
+	MetricGroups map[uint][]MetricGroup
+	// Multiplexed is set if the metric groups are collected in turns rather than all at once
+	Multiplexed bool
+}
+
+// WatchProfilingFields starts watching profiling (DCGM_FI_PROF_*) fields on the GPUs. Before the
+// watch is set up the profiling module is checked and, on every GPU, the fields are matched to the
+// metric groups that must be active to collect them, so that unsupported fields and fields that
+// cannot be collected at the same time are reported with a clear error.
+func WatchProfilingFields(gpuIDs []uint, fields []Short, opts WatchOptions) (*ProfilingWatch, error) {
+	return defaultClient.WatchProfilingFields(gpuIDs, fields, opts)
+}
+
+// WatchProfilingFields starts watching profiling (DCGM_FI_PROF_*) fields on the GPUs. Before the
+// watch is set up the profiling module is checked and, on every GPU, the fields are matched to the
+// metric groups that must be active to collect them, so that unsupported fields and fields that
+// cannot be collected at the same time are reported with a clear error.
+func (c *Client) WatchProfilingFields(gpuIDs []uint, fields []Short, opts WatchOptions) (*ProfilingWatch, error) {
+	return c.watchProfilingFields(gpuIDs, fields, opts, false)
+}
+
+// WatchProfilingFieldsMultiplexed is like WatchProfilingFields, but also accepts fields from
+// metric groups that cannot be collected at the same time. The hostengine then has to rotate
+// through the metric groups, so each field is only collected part of the time and its values are
+// sampled estimates. Hostengines that cannot multiplex metric groups fail the watch, usually with
+// DCGM_ST_PROFILING_MULTI_PASS.
+func WatchProfilingFieldsMultiplexed(gpuIDs []uint, fields []Short, opts WatchOptions) (*ProfilingWatch, error) {
+	return defaultClient.WatchProfilingFieldsMultiplexed(gpuIDs, fields, opts)
+}
+
+// WatchProfilingFieldsMultiplexed is like WatchProfilingFields, but also accepts fields from
+// metric groups that cannot be collected at the same time. The hostengine then has to rotate
+// through the metric groups, so each field is only collected part of the time and its values are
+// sampled estimates. Hostengines that cannot multiplex metric groups fail the watch, usually with
+// DCGM_ST_PROFILING_MULTI_PASS.
+func (c *Client) WatchProfilingFieldsMultiplexed(gpuIDs []uint, fields []Short, opts WatchOptions) (*ProfilingWatch, error) {
+	return c.watchProfilingFields(gpuIDs, fields, opts, true)
+}
+
+func (c *Client) watchProfilingFields(gpuIDs []uint, fields []Short, opts WatchOptions, multiplexed bool) (*ProfilingWatch, error) {
+	if len(gpuIDs) == 0 || len(fields) == 0 {
+		return nil, errors.New("no GPUs or fields given")
+	}
+	for _, field := range fields {
+		if !isProfilingField(field) {
+			return nil, fmt.Errorf("field %d is not a profiling field", field)
+		}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	if err := c.checkProfilingModule(); err != nil {
+		return nil, err
+	}
+
+	selectGroups := SelectMetricGroups
+	if multiplexed {
+		selectGroups = metricGroupsForFields
+	}
+
+	watch := &ProfilingWatch{client: c, Multiplexed: multiplexed, MetricGroups: make(map[uint][]MetricGroup, len(gpuIDs))}
+	for _, gpu := range gpuIDs {
+		groups, err := c.getSupportedMetricGroups(gpu)
+		if err != nil {
+			return nil, fmt.Errorf("error getting metric groups of GPU %d: %w", gpu, err)
+		}
+		if watch.MetricGroups[gpu], err = selectGroups(groups, fields); err != nil {
+			return nil, fmt.Errorf("GPU %d: %w", gpu, err)
+		}
+	}
+
+	suffix := rand.Uint64()
+
+	var err error
+	if watch.FieldGroup, err = c.FieldGroupCreate(fmt.Sprintf("profilingFields%d", suffix), fields); err != nil {
+		return nil, err
+	}
+	if watch.Group, err = c.CreateGroup(fmt.Sprintf("profiling%d", suffix)); err != nil {
+		_ = c.FieldGroupDestroy(watch.FieldGroup)
+		return nil, err
+	}
+
+	for _, gpu := range gpuIDs {
+		if err = c.AddToGroup(watch.Group, gpu); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = c.WatchFieldsWithOptions(watch.FieldGroup, watch.Group, opts)
+	}
+	if err != nil {
+		_ = c.DestroyGroup(watch.Group)
+		_ = c.FieldGroupDestroy(watch.FieldGroup)
+		return nil, err
+	}
+
+	return watch, nil
+}
+
+// LatestValues returns the latest values of the watched fields on every watched GPU
+func (w *ProfilingWatch) LatestValues() ([]FieldValue_v2, error) {
+	info, err := w.client.FieldGroupGetInfo(w.FieldGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]GroupEntityPair, 0, len(w.MetricGroups))
+	for gpu := range w.MetricGroups {
+		entities = append(entities, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu})
+	}
+
+	return w.client.EntitiesGetLatestValues(entities, info.Fields, 0)
+}
+
+// Close stops the watch and destroys its group and field group
+func (w *ProfilingWatch) Close() error {
+	return errors.Join(
+		w.client.UnwatchFields(w.Group, w.FieldGroup),
+		w.client.DestroyGroup(w.Group),
+		w.client.FieldGroupDestroy(w.FieldGroup),
+	)
+}
+
+// PauseProfiling stops the collection of profiling metrics so that tools that need the hardware
+// counters, such as Nsight Compute, can run. Profiling fields are blank until ResumeProfiling.
+func PauseProfiling() error {
+	return defaultClient.PauseProfiling()
+}
+
+// PauseProfiling stops the collection of profiling metrics so that tools that need the hardware
+// counters, such as Nsight Compute, can run. Profiling fields are blank until ResumeProfiling.
+func (c *Client) PauseProfiling() error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	result := C.dcgmProfPause(c.dcgmHandle())
+	if err := errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+	return nil
+}
+
+// ResumeProfiling resumes the collection of profiling metrics paused by PauseProfiling
+func ResumeProfiling() error {
+	return defaultClient.ResumeProfiling()
+}
+
+// ResumeProfiling resumes the collection of profiling metrics paused by PauseProfiling
+func (c *Client) ResumeProfiling() error {
+	if err := c.beginCall(); err != nil {
+		return err
+	}
+	defer c.endCall()
+
+	result := C.dcgmProfResume(c.dcgmHandle())
+	if err := errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+	return nil
+}
+
+// checkProfilingModule returns ErrProfilingUnavailable if the profiling module cannot be loaded.
+// The module is loaded lazily, so a module that is not loaded yet is fine.
+func (c *Client) checkProfilingModule() error {
+	var statuses C.dcgmModuleGetStatuses_t
+	statuses.version = makeVersion1(unsafe.Sizeof(statuses))
+
+	result := C.dcgmModuleGetStatuses(c.dcgmHandle(), &statuses)
+	if err := errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	count := min(int(statuses.numStatuses), int(C.DCGM_MODULE_STATUSES_CAPACITY))
+	for i := 0; i < count; i++ {
+		if statuses.statuses[i].id != C.DcgmModuleIdProfiling {
+			continue
+		}
+		switch statuses.statuses[i].status {
+		case C.DcgmModuleStatusDenylisted:
+			return fmt.Errorf("%w: module is denylisted", ErrProfilingUnavailable)
+		case C.DcgmModuleStatusFailed:
+			return fmt.Errorf("%w: module failed to load", ErrProfilingUnavailable)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,506 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"log"
+	"maps"
+	"slices"
+	"time"
+	"unsafe"
+)
+
+const (
+	defaultReconnectCheckInterval  = 5 * time.Second
+	defaultReconnectInitialBackoff = time.Second
+	defaultReconnectMaxBackoff     = 30 * time.Second
+)
+
+// firstTrackedHandle is the first handle returned for a group or field group tracked for
+// auto-reconnect. Hostengine handles are 32-bit, so the handles the client hands out never
+// collide with them, whatever handles the hostengine assigns to the groups it re-creates.
+const firstTrackedHandle = 1 << 32
+
+// ReconnectOptions configures automatic reconnection of a standalone client
+type ReconnectOptions struct {
+	// CheckInterval is how often the connection to nv-hostengine is verified. Defaults to 5s.
+	CheckInterval time.Duration
+	// InitialBackoff is the delay before the first reconnection attempt. Defaults to 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between reconnection attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+type registeredGroup struct {
+	groupType C.dcgmGroupType_t
+	name      string
+	entities  []GroupEntityPair
+	current   C.dcgmGpuGrp_t
+}
+
+type registeredFieldGroup struct {
+	name    string
+	fields  []Short
+	current C.dcgmFieldGrp_t
+}
+
+type registeredWatch struct {
+	group          GroupHandle
+	fieldGroup     FieldHandle
+	updateFreq     int64
+	maxKeepAge     float64
+	maxKeepSamples int32
+}
+
+// reconnectState records the groups, field groups and watches created through a client,
+// keyed by the handles returned to the caller, so that they can be replayed after a reconnect.
+// The handles are allocated by the client in creation order, starting at firstTrackedHandle.
+type reconnectState struct {
+	opts        ReconnectOptions
+	groups      map[C.dcgmGpuGrp_t]*registeredGroup
+	fieldGroups map[C.dcgmFieldGrp_t]*registeredFieldGroup
+	watches     []registeredWatch
+	nextHandle  uintptr
+	stop        chan struct{}
+}
+
+func newReconnectState(opts ReconnectOptions) *reconnectState {
+	return &reconnectState{
+		opts:        opts,
+		groups:      make(map[C.dcgmGpuGrp_t]*registeredGroup),
+		fieldGroups: make(map[C.dcgmFieldGrp_t]*registeredFieldGroup),
+		nextHandle:  firstTrackedHandle,
+		stop:        make(chan struct{}),
+	}
+}
+
+// newHandle returns the handle to give out for the next tracked group or field group
+func (s *reconnectState) newHandle() uintptr {
+	s.nextHandle++
+	return s.nextHandle - 1
+}
+
+// EnableAutoReconnect makes a client connected to nv-hostengine re-establish its connection when
+// the hostengine restarts. Groups, field groups and field watches created through this client after
+// the call are re-created on the new connection, in the order they were created, and the handles
+// previously returned for them keep working. Groups and field groups that still exist, e.g. because
+// the client connected with PersistAfterDisconnect, are reused. The same state is replayed when a
+// supervised child hostengine is restarted.
+func (c *Client) EnableAutoReconnect(opts ReconnectOptions) error {
+	if c.mode == Embedded {
+		return errors.New("auto-reconnect is not supported for embedded hostengines")
+	}
+
+	if opts.CheckInterval <= 0 {
+		opts.CheckInterval = defaultReconnectCheckInterval
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultReconnectInitialBackoff
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = max(defaultReconnectMaxBackoff, opts.InitialBackoff)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect != nil {
+		return errors.New("auto-reconnect is already enabled")
+	}
+
+	c.reconnect = newReconnectState(opts)
+
+	go c.monitorConnection(c.reconnect)
+
+	return nil
+}
+
+// disableAutoReconnect stops the connection monitor, if any.
+func (c *Client) disableAutoReconnect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect != nil {
+		close(c.reconnect.stop)
+		c.reconnect = nil
+	}
+}
+
+func (c *Client) monitorConnection(state *reconnectState) {
+	ticker := time.NewTicker(state.opts.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-state.stop:
+			return
+		case <-ticker.C:
+		}
+
+		if err := c.beginCall(); err != nil {
+			// the client is closing; Close stops the monitor once it is drained
+			continue
+		}
+		valid := c.connectionValid()
+		c.endCall()
+		if valid {
+			continue
+		}
+
+		c.notifyDisconnect(errors.New("connection to nv-hostengine is not valid"))
+
+		log.Println("Lost connection to nv-hostengine, reconnecting...")
+
+		backoff := state.opts.InitialBackoff
+		for {
+			select {
+			case <-state.stop:
+				return
+			case <-time.After(backoff):
+			}
+
+			if err := c.beginCall(); err != nil {
+				continue
+			}
+			err := c.reestablish()
+			c.endCall()
+			if err == nil {
+				c.notifyConnected()
+				log.Println("Successfully reconnected to nv-hostengine.")
+				break
+			}
+
+			log.Printf("error reconnecting to nv-hostengine: %v", err)
+			backoff = min(backoff*2, state.opts.MaxBackoff)
+		}
+	}
+}
+
+func (c *Client) connectionValid() bool {
+	var (
+		gpuIDList [C.DCGM_MAX_NUM_DEVICES]C.uint
+		count     C.int
+	)
+
+	result := C.dcgmGetAllDevices(c.dcgmHandle(), &gpuIDList[0], &count)
+	return result != C.DCGM_ST_CONNECTION_NOT_VALID
+}
+
+// reestablish replaces the connection handle and replays the recorded state on the new connection.
+func (c *Client) reestablish() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect == nil {
+		return nil
+	}
+
+	_ = C.dcgmDisconnect(c.handle.handle)
+	if err := c.connectStandalone(c.connectOpts); err != nil {
+		return err
+	}
+
+	return c.replay()
+}
+
+// replay re-creates the recorded groups, field groups and watches on the current connection, in
+// the order they were created. It can be retried after a partial failure. The caller must hold c.mu.
+func (c *Client) replay() error {
+	if c.reconnect == nil {
+		return nil
+	}
+
+	for _, handle := range slices.Sorted(maps.Keys(c.reconnect.groups)) {
+		if err := c.replayGroup(c.reconnect.groups[handle]); err != nil {
+			return err
+		}
+	}
+
+	for _, handle := range slices.Sorted(maps.Keys(c.reconnect.fieldGroups)) {
+		if err := c.replayFieldGroup(c.reconnect.fieldGroups[handle]); err != nil {
+			return err
+		}
+	}
+
+	for _, watch := range c.reconnect.watches {
+		result := C.dcgmWatchFields(c.handle.handle, c.currentGroup(watch.group), c.currentFieldGroup(watch.fieldGroup),
+			C.longlong(watch.updateFreq), C.double(watch.maxKeepAge), C.int(watch.maxKeepSamples))
+		if err := errorString(result); err != nil {
+			return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+	}
+
+	return nil
+}
+
+// replayGroup re-creates a recorded group on the current connection. A group that still exists,
+// because it persisted or an earlier replay created it, is reused and only its missing members
+// are added.
+func (c *Client) replayGroup(group *registeredGroup) error {
+	var members []GroupEntityPair
+
+	info := C.dcgmGroupInfo_v3{version: C.dcgmGroupInfo_version3}
+	result := C.dcgmGroupGetInfo(c.handle.handle, group.current, &info)
+	if result == C.DCGM_ST_OK && C.GoString(&info.groupName[0]) == group.name {
+		for i := 0; i < int(info.count); i++ {
+			members = append(members, GroupEntityPair{
+				EntityGroupId: Field_Entity_Group(info.entityList[i].entityGroupId),
+				EntityId:      uint(info.entityList[i].entityId),
+			})
+		}
+	} else {
+		cname := C.CString(group.name)
+		result = C.dcgmGroupCreate(c.handle.handle, group.groupType, cname, &group.current)
+		freeCString(cname)
+		if err := errorString(result); err != nil {
+			return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+	}
+
+	for _, entity := range group.entities {
+		if slices.Contains(members, entity) {
+			continue
+		}
+		result = C.dcgmGroupAddEntity(c.handle.handle, group.current,
+			C.dcgm_field_entity_group_t(entity.EntityGroupId), C.uint(entity.EntityId))
+		if err := errorString(result); err != nil {
+			return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+		}
+	}
+	return nil
+}
+
+// replayFieldGroup re-creates a recorded field group on the current connection, unless it still
+// exists, because it persisted or an earlier replay created it
+func (c *Client) replayFieldGroup(fieldGroup *registeredFieldGroup) error {
+	info := C.dcgmFieldGroupInfo_v1{
+		version:      makeVersion1(unsafe.Sizeof(C.dcgmFieldGroupInfo_v1{})),
+		fieldGroupId: fieldGroup.current,
+	}
+	result := C.dcgmFieldGroupGetInfo(c.handle.handle, &info)
+	if result == C.DCGM_ST_OK && C.GoString(&info.fieldGroupName[0]) == fieldGroup.name {
+		return nil
+	}
+
+	cfields := make([]C.ushort, len(fieldGroup.fields))
+	for i, f := range fieldGroup.fields {
+		cfields[i] = C.ushort(f)
+	}
+
+	cname := C.CString(fieldGroup.name)
+	result = C.dcgmFieldGroupCreate(c.handle.handle, C.int(len(cfields)), &cfields[0], cname, &fieldGroup.current)
+	freeCString(cname)
+	if err := errorString(result); err != nil {
+		return &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+	return nil
+}
+
+// dcgmHandle returns the handle of the current connection
+func (c *Client) dcgmHandle() C.dcgmHandle_t {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.handle.handle
+}
+
+// groupHandle translates a group handle returned to the caller into the handle
+// of the same group on the current connection
+func (c *Client) groupHandle(group GroupHandle) C.dcgmGpuGrp_t {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.currentGroup(group)
+}
+
+// fieldGroupHandle translates a field group handle returned to the caller into the handle
+// of the same field group on the current connection
+func (c *Client) fieldGroupHandle(fieldGroup FieldHandle) C.dcgmFieldGrp_t {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.currentFieldGroup(fieldGroup)
+}
+
+func (c *Client) currentGroup(group GroupHandle) C.dcgmGpuGrp_t {
+	if c.reconnect != nil {
+		if registered, ok := c.reconnect.groups[group.handle]; ok {
+			return registered.current
+		}
+	}
+	return group.handle
+}
+
+// callerGroup translates the handle of a group on the current connection back into the handle
+// returned to the caller when the group was created
+func (c *Client) callerGroup(current C.dcgmGpuGrp_t) GroupHandle {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.reconnect != nil {
+		for handle, registered := range c.reconnect.groups {
+			if registered.current == current {
+				return GroupHandle{handle}
+			}
+		}
+	}
+	return GroupHandle{current}
+}
+
+// callerFieldGroup translates the handle of a field group on the current connection back into the
+// handle returned to the caller when the field group was created
+func (c *Client) callerFieldGroup(current C.dcgmFieldGrp_t) FieldHandle {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.reconnect != nil {
+		for handle, registered := range c.reconnect.fieldGroups {
+			if registered.current == current {
+				return FieldHandle{handle}
+			}
+		}
+	}
+	return FieldHandle{current}
+}
+
+func (c *Client) currentFieldGroup(fieldGroup FieldHandle) C.dcgmFieldGrp_t {
+	if c.reconnect != nil {
+		if registered, ok := c.reconnect.fieldGroups[fieldGroup.handle]; ok {
+			return registered.current
+		}
+	}
+	return fieldGroup.handle
+}
+
+// trackGroup records a group created on the current connection and returns the handle to give to
+// the caller for it
+func (c *Client) trackGroup(group C.dcgmGpuGrp_t, groupType C.dcgmGroupType_t, name string) GroupHandle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	handle := group
+	if c.reconnect != nil {
+		handle = C.dcgmGpuGrp_t(c.reconnect.newHandle())
+		c.reconnect.groups[handle] = &registeredGroup{groupType: groupType, name: name, current: group}
+	}
+
+	if c.leaks != nil {
+		c.leaks.groups[handle] = name
+	}
+	return GroupHandle{handle}
+}
+
+func (c *Client) trackGroupEntity(group GroupHandle, entity GroupEntityPair) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect != nil {
+		if registered, ok := c.reconnect.groups[group.handle]; ok {
+			registered.entities = append(registered.entities, entity)
+		}
+	}
+}
+
+func (c *Client) untrackGroupEntity(group GroupHandle, entity GroupEntityPair) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect != nil {
+		if registered, ok := c.reconnect.groups[group.handle]; ok {
+			registered.entities = slices.DeleteFunc(registered.entities, func(e GroupEntityPair) bool {
+				return e == entity
+			})
+		}
+	}
+}
+
+func (c *Client) untrackGroup(group GroupHandle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.leaks != nil {
+		delete(c.leaks.groups, group.handle)
+	}
+
+	if c.reconnect != nil {
+		delete(c.reconnect.groups, group.handle)
+		c.reconnect.watches = removeWatches(c.reconnect.watches, func(w registeredWatch) bool {
+			return w.group == group
+		})
+	}
+}
+
+// trackFieldGroup records a field group created on the current connection and returns the handle
+// to give to the caller for it
+func (c *Client) trackFieldGroup(fieldGroup C.dcgmFieldGrp_t, name string, fields []Short) FieldHandle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	handle := fieldGroup
+	if c.reconnect != nil {
+		handle = C.dcgmFieldGrp_t(c.reconnect.newHandle())
+		c.reconnect.fieldGroups[handle] = &registeredFieldGroup{name: name, fields: append([]Short(nil), fields...), current: fieldGroup}
+	}
+
+	if c.leaks != nil {
+		c.leaks.fieldGroups[handle] = name
+	}
+	return FieldHandle{handle}
+}
+
+func (c *Client) untrackFieldGroup(fieldGroup FieldHandle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.leaks != nil {
+		delete(c.leaks.fieldGroups, fieldGroup.handle)
+	}
+
+	if c.reconnect != nil {
+		delete(c.reconnect.fieldGroups, fieldGroup.handle)
+		c.reconnect.watches = removeWatches(c.reconnect.watches, func(w registeredWatch) bool {
+			return w.fieldGroup == fieldGroup
+		})
+	}
+}
+
+func (c *Client) trackWatch(group GroupHandle, fieldGroup FieldHandle, updateFreq int64, maxKeepAge float64, maxKeepSamples int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect != nil {
+		c.reconnect.watches = append(c.reconnect.watches, registeredWatch{
+			group:          group,
+			fieldGroup:     fieldGroup,
+			updateFreq:     updateFreq,
+			maxKeepAge:     maxKeepAge,
+			maxKeepSamples: maxKeepSamples,
+		})
+	}
+}
+
+func (c *Client) untrackWatch(group GroupHandle, fieldGroup FieldHandle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnect != nil {
+		c.reconnect.watches = removeWatches(c.reconnect.watches, func(w registeredWatch) bool {
+			return w.group == group && w.fieldGroup == fieldGroup
+		})
+	}
+}
+
+func removeWatches(watches []registeredWatch, match func(registeredWatch) bool) []registeredWatch {
+	kept := watches[:0]
+	for _, w := range watches {
+		if !match(w) {
+			kept = append(kept, w)
+		}
+	}
+	return kept
+}
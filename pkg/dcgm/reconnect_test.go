@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUntrackWatch(t *testing.T) {
+	group, otherGroup := GroupHandle{handle: 1}, GroupHandle{handle: 2}
+	fieldGroup, otherFieldGroup := FieldHandle{handle: 1}, FieldHandle{handle: 2}
+
+	c := &Client{reconnect: &reconnectState{watches: []registeredWatch{
+		{group: group, fieldGroup: fieldGroup},
+		{group: group, fieldGroup: otherFieldGroup},
+		{group: otherGroup, fieldGroup: fieldGroup},
+	}}}
+
+	c.untrackWatch(group, fieldGroup)
+	assert.Equal(t, []registeredWatch{
+		{group: group, fieldGroup: otherFieldGroup},
+		{group: otherGroup, fieldGroup: fieldGroup},
+	}, c.reconnect.watches)
+}
+
+func TestTrackGroupHandles(t *testing.T) {
+	c := &Client{reconnect: newReconnectState(ReconnectOptions{})}
+
+	group := c.trackGroup(1, 0, "group")
+	fieldGroup := c.trackFieldGroup(2, "fields", []Short{DCGM_FI_DEV_GPU_TEMP})
+	assert.Equal(t, uintptr(firstTrackedHandle), group.GetHandle())
+	assert.Equal(t, uintptr(firstTrackedHandle+1), fieldGroup.GetHandle())
+
+	// the hostengine renumbers the groups on replay, and may then hand out a handle again that it
+	// returned for another group before
+	c.reconnect.groups[group.handle].current = 2
+	other := c.trackGroup(1, 0, "other")
+	assert.NotEqual(t, group, other)
+	assert.Equal(t, uintptr(2), uintptr(c.groupHandle(group)))
+	assert.Equal(t, uintptr(1), uintptr(c.groupHandle(other)))
+	assert.Equal(t, group, c.callerGroup(2))
+	assert.Equal(t, other, c.callerGroup(1))
+	assert.Equal(t, uintptr(2), uintptr(c.fieldGroupHandle(fieldGroup)))
+	assert.Equal(t, fieldGroup, c.callerFieldGroup(2))
+
+	c.untrackGroup(group)
+	assert.Len(t, c.reconnect.groups, 1)
+	assert.Equal(t, uintptr(1), uintptr(c.groupHandle(other)))
+
+	// without auto-reconnect, the handles of the hostengine are given out as is
+	assert.Equal(t, GroupHandle{handle: 7}, (&Client{}).trackGroup(7, 0, "group"))
+}
@@ -0,0 +1,147 @@
+package dcgm
+
+import (
+	"fmt"
+	"slices"
+)
+
+// RowRemapStatus contains the row remapping and page retirement state of a GPU. GPUs use either
+// row remapping (Ampere and newer) or page retirement, the counters of the other mechanism are
+// zero.
+type RowRemapStatus struct {
+	GPU uint
+	// CorrectableRows is the number of rows remapped because of correctable errors
+	CorrectableRows int64
+	// UncorrectableRows is the number of rows remapped because of uncorrectable errors
+	UncorrectableRows int64
+	// Pending is set if a remapping is waiting for the next GPU reset
+	Pending bool
+	// Failed is set if a row could not be remapped
+	Failed bool
+	// RetiredPagesSBE is the number of pages retired because of single bit errors
+	RetiredPagesSBE int64
+	// RetiredPagesDBE is the number of pages retired because of double bit errors
+	RetiredPagesDBE int64
+	// RetiredPagesPending is the number of pages waiting for the next GPU reset to be retired
+	RetiredPagesPending int64
+}
+
+// NeedsReset reports whether the GPU must be reset or the node rebooted to apply pending row
+// remappings or page retirements
+func (s RowRemapStatus) NeedsReset() bool {
+	return s.Pending || s.RetiredPagesPending > 0
+}
+
+// NeedsRMA reports whether the GPU ran out of spare rows and should be replaced
+func (s RowRemapStatus) NeedsRMA() bool {
+	return s.Failed
+}
+
+var rowRemapFields = []Short{
+	DCGM_FI_DEV_CORRECTABLE_REMAPPED_ROWS,
+	DCGM_FI_DEV_UNCORRECTABLE_REMAPPED_ROWS,
+	DCGM_FI_DEV_ROW_REMAP_PENDING,
+	DCGM_FI_DEV_ROW_REMAP_FAILURE,
+	DCGM_FI_DEV_RETIRED_SBE,
+	DCGM_FI_DEV_RETIRED_DBE,
+	DCGM_FI_DEV_RETIRED_PENDING,
+}
+
+// GetRowRemapStatus returns the row remapping and page retirement state of the specified GPU
+func GetRowRemapStatus(gpuID uint) (RowRemapStatus, error) {
+	return defaultClient.GetRowRemapStatus(gpuID)
+}
+
+// GetRowRemapStatus returns the row remapping and page retirement state of the specified GPU
+func (c *Client) GetRowRemapStatus(gpuID uint) (RowRemapStatus, error) {
+	if err := c.beginCall(); err != nil {
+		return RowRemapStatus{}, err
+	}
+	defer c.endCall()
+
+	statuses, err := c.getRowRemapStatuses([]uint{gpuID})
+	if err != nil {
+		return RowRemapStatus{}, err
+	}
+	return statuses[0], nil
+}
+
+// GetAllRowRemapStatuses returns the row remapping and page retirement state of every supported
+// GPU, ordered by GPU ID
+func GetAllRowRemapStatuses() ([]RowRemapStatus, error) {
+	return defaultClient.GetAllRowRemapStatuses()
+}
+
+// GetAllRowRemapStatuses returns the row remapping and page retirement state of every supported
+// GPU, ordered by GPU ID
+func (c *Client) GetAllRowRemapStatuses() ([]RowRemapStatus, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	gpus, err := c.getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+	if len(gpus) == 0 {
+		return []RowRemapStatus{}, nil
+	}
+	slices.Sort(gpus)
+
+	return c.getRowRemapStatuses(gpus)
+}
+
+func (c *Client) getRowRemapStatuses(gpus []uint) ([]RowRemapStatus, error) {
+	entities := make([]GroupEntityPair, len(gpus))
+	for i, gpu := range gpus {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu}
+	}
+
+	values, err := c.sampleEntityFields("rowRemap", entities, rowRemapFields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting row remapping status: %s", err)
+	}
+
+	return toRowRemapStatuses(gpus, values), nil
+}
+
+// toRowRemapStatuses builds the status of each of gpus from their row remapping field values
+func toRowRemapStatuses(gpus []uint, values []FieldValue_v2) []RowRemapStatus {
+	statuses := make([]RowRemapStatus, len(gpus))
+	index := make(map[uint]int, len(gpus))
+	for i, gpu := range gpus {
+		statuses[i].GPU = gpu
+		index[gpu] = i
+	}
+
+	for _, value := range values {
+		i, ok := index[value.EntityID]
+		if !ok || value.EntityGroupId != FE_GPU {
+			continue
+		}
+		v, ok := value.Typed().AsInt64()
+		if !ok {
+			continue
+		}
+
+		status := &statuses[i]
+		switch value.FieldID {
+		case DCGM_FI_DEV_CORRECTABLE_REMAPPED_ROWS:
+			status.CorrectableRows = v
+		case DCGM_FI_DEV_UNCORRECTABLE_REMAPPED_ROWS:
+			status.UncorrectableRows = v
+		case DCGM_FI_DEV_ROW_REMAP_PENDING:
+			status.Pending = v != 0
+		case DCGM_FI_DEV_ROW_REMAP_FAILURE:
+			status.Failed = v != 0
+		case DCGM_FI_DEV_RETIRED_SBE:
+			status.RetiredPagesSBE = v
+		case DCGM_FI_DEV_RETIRED_DBE:
+			status.RetiredPagesDBE = v
+		case DCGM_FI_DEV_RETIRED_PENDING:
+			status.RetiredPagesPending = v
+		}
+	}
+	return statuses
+}
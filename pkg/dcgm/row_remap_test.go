@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRowRemapStatuses(t *testing.T) {
+	statuses := toRowRemapStatuses([]uint{0, 3}, []FieldValue_v2{
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_CORRECTABLE_REMAPPED_ROWS, 4, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_ROW_REMAP_PENDING, 1, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_RETIRED_SBE, DCGM_FT_INT64_NOT_SUPPORTED, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 3}, DCGM_FI_DEV_UNCORRECTABLE_REMAPPED_ROWS, 2, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 3}, DCGM_FI_DEV_ROW_REMAP_FAILURE, 1, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 3}, DCGM_FI_DEV_RETIRED_PENDING, 0, 0),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 7}, DCGM_FI_DEV_ROW_REMAP_FAILURE, 1, 0),
+	})
+
+	assert.Equal(t, []RowRemapStatus{
+		{GPU: 0, CorrectableRows: 4, Pending: true},
+		{GPU: 3, UncorrectableRows: 2, Failed: true},
+	}, statuses)
+
+	assert.True(t, statuses[0].NeedsReset())
+	assert.False(t, statuses[0].NeedsRMA())
+	assert.False(t, statuses[1].NeedsReset())
+	assert.True(t, statuses[1].NeedsRMA())
+	assert.True(t, RowRemapStatus{RetiredPagesPending: 1}.NeedsReset())
+}
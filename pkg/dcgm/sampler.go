@@ -0,0 +1,184 @@
+package dcgm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SamplerOptions configures a Sampler
+type SamplerOptions struct {
+	// Interval is how often the fields are sampled
+	Interval time.Duration
+	// Retention is how long samples are kept in memory
+	Retention time.Duration
+}
+
+func (o SamplerOptions) validate() error {
+	if o.Interval <= 0 {
+		return fmt.Errorf("invalid sampler interval %s", o.Interval)
+	}
+	if o.Retention < o.Interval {
+		return fmt.Errorf("sampler retention %s is shorter than the interval %s", o.Retention, o.Interval)
+	}
+	return nil
+}
+
+// capacity returns the number of samples a series keeps. A margin of a few intervals covers
+// jitter in the sampling times.
+func (o SamplerOptions) capacity() int {
+	return int(o.Retention/o.Interval) + 4
+}
+
+// Sampler watches a field group on the entities of a group and keeps the samples of the last
+// Retention in memory, independently of how much history the hostengine keeps. Every series, i.e.
+// field of an entity, is kept in a ring buffer sized for Retention at the sampling interval.
+type Sampler struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	opts   SamplerOptions
+	mu     sync.RWMutex
+	series map[streamKey]*sampleRing
+	err    error
+}
+
+// sampleRing is a fixed size ring buffer of the samples of one series, in the order they were
+// recorded
+type sampleRing struct {
+	samples []TypedValue
+	next    int
+	full    bool
+}
+
+func newSampleRing(capacity int) *sampleRing {
+	return &sampleRing{samples: make([]TypedValue, capacity)}
+}
+
+func (r *sampleRing) push(sample TypedValue) {
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// ordered returns the samples from the oldest to the latest
+func (r *sampleRing) ordered() []TypedValue {
+	if !r.full {
+		return r.samples[:r.next]
+	}
+	return append(r.samples[r.next:len(r.samples):len(r.samples)], r.samples[:r.next]...)
+}
+
+// NewSampler starts sampling the fields of fieldGroup on the entities of group every
+// opts.Interval, until ctx is done or Close is called
+func NewSampler(ctx context.Context, group GroupHandle, fieldGroup FieldHandle, opts SamplerOptions) (*Sampler, error) {
+	return defaultClient.NewSampler(ctx, group, fieldGroup, opts)
+}
+
+// NewSampler starts sampling the fields of fieldGroup on the entities of group every
+// opts.Interval, until ctx is done or Close is called
+func (c *Client) NewSampler(ctx context.Context, group GroupHandle, fieldGroup FieldHandle, opts SamplerOptions) (*Sampler, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	batches, err := c.StreamFieldValuesWithOptions(ctx, group, fieldGroup, WatchOptions{
+		UpdateFreq: opts.Interval,
+		MaxKeepAge: 5 * opts.Interval,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	s := newSampler(opts)
+	s.cancel = cancel
+	go func() {
+		defer close(s.done)
+		for batch := range batches {
+			s.add(batch)
+		}
+	}()
+	return s, nil
+}
+
+func newSampler(opts SamplerOptions) *Sampler {
+	return &Sampler{
+		cancel: func() {},
+		done:   make(chan struct{}),
+		opts:   opts,
+		series: make(map[streamKey]*sampleRing),
+	}
+}
+
+func (s *Sampler) add(batch FieldValueBatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = batch.Err
+	for _, value := range batch.Values {
+		key := streamKey{entity: Entity{Group: value.EntityGroupId, ID: value.EntityID}, field: value.FieldID}
+		ring, ok := s.series[key]
+		if !ok {
+			ring = newSampleRing(s.opts.capacity())
+			s.series[key] = ring
+		}
+		ring.push(value.Typed())
+	}
+}
+
+// Query returns the samples of a field of an entity taken in [from, to], from the oldest to the
+// latest. A zero from or to leaves that end of the range open. Samples older than the retention
+// are not returned.
+func (s *Sampler) Query(entity Entity, field Short, from, to time.Time) []TypedValue {
+	if oldest := time.Now().Add(-s.opts.Retention); from.Before(oldest) {
+		from = oldest
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ring, ok := s.series[streamKey{entity: entity, field: field}]
+	if !ok {
+		return nil
+	}
+
+	var samples []TypedValue
+	for _, sample := range ring.ordered() {
+		if sample.Timestamp.Before(from) || (!to.IsZero() && sample.Timestamp.After(to)) {
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// Latest returns the latest sample of a field of an entity
+func (s *Sampler) Latest(entity Entity, field Short) (TypedValue, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ring, ok := s.series[streamKey{entity: entity, field: field}]
+	if !ok || (ring.next == 0 && !ring.full) {
+		return TypedValue{}, false
+	}
+	return ring.samples[(ring.next+len(ring.samples)-1)%len(ring.samples)], true
+}
+
+// Err returns the error of the latest read, nil if it succeeded
+func (s *Sampler) Err() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.err
+}
+
+// Close stops sampling and unwatches the fields. The samples recorded so far can still be queried.
+func (s *Sampler) Close() {
+	s.cancel()
+	<-s.done
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampler(t *testing.T) {
+	require.Error(t, SamplerOptions{}.validate())
+	require.Error(t, SamplerOptions{Interval: time.Minute, Retention: time.Second}.validate())
+
+	opts := SamplerOptions{Interval: time.Second, Retention: 4500 * time.Millisecond}
+	require.NoError(t, opts.validate())
+	s := newSampler(opts)
+
+	gpu := Entity{Group: FE_GPU, ID: 1}
+	now := time.Now()
+
+	_, ok := s.Latest(gpu, DCGM_FI_DEV_GPU_TEMP)
+	assert.False(t, ok)
+
+	// more samples than the ring holds, the oldest of which are also past the retention
+	var temps []int64
+	for i := int64(0); i < 10; i++ {
+		s.add(FieldValueBatch{Values: []FieldValue_v2{fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 50+i, now.Add(-time.Duration(9-i)*time.Second).UnixMicro())}})
+		temps = append(temps, 50+i)
+	}
+	s.add(FieldValueBatch{Err: errors.New("read failed")})
+	require.Error(t, s.Err())
+
+	latest, ok := s.Latest(gpu, DCGM_FI_DEV_GPU_TEMP)
+	require.True(t, ok)
+	assert.Equal(t, int64(59), latest.Value)
+
+	samples := s.Query(gpu, DCGM_FI_DEV_GPU_TEMP, time.Time{}, time.Time{})
+	got := make([]int64, len(samples))
+	for i, sample := range samples {
+		got[i] = sample.Value.(int64)
+	}
+	assert.Equal(t, temps[5:], got)
+
+	samples = s.Query(gpu, DCGM_FI_DEV_GPU_TEMP, now.Add(-2500*time.Millisecond), now.Add(-500*time.Millisecond))
+	require.Len(t, samples, 2)
+	assert.Equal(t, int64(57), samples[0].Value)
+
+	assert.Empty(t, s.Query(Entity{Group: FE_GPU, ID: 2}, DCGM_FI_DEV_GPU_TEMP, time.Time{}, time.Time{}))
+}
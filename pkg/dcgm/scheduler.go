@@ -0,0 +1,85 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Schedule samples a field group at its own interval
+type Schedule struct {
+	FieldGroup FieldHandle
+	// Interval is how often the fields are sampled and delivered
+	Interval time.Duration
+	// OnBatch is called from the goroutine of the schedule with the samples recorded since the
+	// previous batch. A slow callback delays only its own schedule.
+	OnBatch func(FieldValueBatch)
+}
+
+func (s Schedule) validate() error {
+	if s.Interval <= 0 {
+		return fmt.Errorf("invalid schedule interval %s", s.Interval)
+	}
+	if s.OnBatch == nil {
+		return errors.New("schedule has no callback")
+	}
+	return nil
+}
+
+// Scheduler samples several field groups on the entities of one group, each at the interval of
+// its schedule, e.g. temperatures every second, profiling metrics every 100ms and inventory
+// fields every five minutes
+type Scheduler struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// StartScheduler watches the field group of every schedule on group with the interval of the
+// schedule and delivers the samples to its callback, until ctx is done or Stop is called
+func StartScheduler(ctx context.Context, group GroupHandle, schedules ...Schedule) (*Scheduler, error) {
+	return defaultClient.StartScheduler(ctx, group, schedules...)
+}
+
+// StartScheduler watches the field group of every schedule on group with the interval of the
+// schedule and delivers the samples to its callback, until ctx is done or Stop is called
+func (c *Client) StartScheduler(ctx context.Context, group GroupHandle, schedules ...Schedule) (*Scheduler, error) {
+	if len(schedules) == 0 {
+		return nil, errors.New("no schedules")
+	}
+	for _, schedule := range schedules {
+		if err := schedule.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Scheduler{cancel: cancel}
+
+	for _, schedule := range schedules {
+		opts := WatchOptions{UpdateFreq: schedule.Interval, MaxKeepAge: 5 * schedule.Interval}
+		batches, err := c.StreamFieldValuesWithOptions(ctx, group, schedule.FieldGroup, opts)
+		if err != nil {
+			s.Stop()
+			return nil, err
+		}
+
+		s.wg.Add(1)
+		go func(onBatch func(FieldValueBatch)) {
+			defer s.wg.Done()
+			for batch := range batches {
+				onBatch(batch)
+			}
+		}(schedule.OnBatch)
+	}
+
+	return s, nil
+}
+
+// Stop stops sampling and waits for the callbacks in progress to return. The fields are no
+// longer watched once Stop returns.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartSchedulerInvalid(t *testing.T) {
+	c := &Client{closing: true}
+	onBatch := func(FieldValueBatch) {}
+
+	_, err := c.StartScheduler(context.Background(), GroupAllGPUs())
+	require.Error(t, err)
+	_, err = c.StartScheduler(context.Background(), GroupAllGPUs(), Schedule{Interval: 0, OnBatch: onBatch})
+	require.Error(t, err)
+	_, err = c.StartScheduler(context.Background(), GroupAllGPUs(), Schedule{Interval: time.Second})
+	require.Error(t, err)
+
+	_, err = c.StartScheduler(context.Background(), GroupAllGPUs(), Schedule{Interval: time.Second, OnBatch: onBatch})
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,115 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// FieldValueBatch holds the samples recorded since the previous batch of a stream
+type FieldValueBatch struct {
+	// Time is when the batch was read
+	Time   time.Time
+	Values []FieldValue_v2
+	// Err is set if reading the batch failed. The stream keeps going, and the samples are delivered
+	// with the next successful read if they are still kept by the hostengine.
+	Err error
+}
+
+// streamKey identifies the series a sample belongs to
+type streamKey struct {
+	entity Entity
+	field  Short
+}
+
+// StreamFieldValues watches the fields of fieldGroup on the entities of group and delivers the new
+// samples on the returned channel, one batch per update interval of DefaultWatchOptions. Each
+// sample is delivered once. The fields are unwatched and the channel is closed once ctx is done or
+// the client is closed.
+func StreamFieldValues(ctx context.Context, group GroupHandle, fieldGroup FieldHandle) (<-chan FieldValueBatch, error) {
+	return defaultClient.StreamFieldValues(ctx, group, fieldGroup)
+}
+
+// StreamFieldValues watches the fields of fieldGroup on the entities of group and delivers the new
+// samples on the returned channel, one batch per update interval of DefaultWatchOptions. Each
+// sample is delivered once. The fields are unwatched and the channel is closed once ctx is done or
+// the client is closed.
+func (c *Client) StreamFieldValues(ctx context.Context, group GroupHandle, fieldGroup FieldHandle) (<-chan FieldValueBatch, error) {
+	opts := DefaultWatchOptions()
+	opts.MaxKeepAge = 5 * opts.UpdateFreq
+	opts.MaxKeepSamples = 0
+	return c.StreamFieldValuesWithOptions(ctx, group, fieldGroup, opts)
+}
+
+// StreamFieldValuesWithOptions is like StreamFieldValues, but watches the fields with opts and
+// reads a batch every opts.UpdateFreq. opts.MaxKeepAge and opts.MaxKeepSamples must keep enough
+// samples to cover a few update intervals, or samples are lost whenever a read is late.
+func StreamFieldValuesWithOptions(ctx context.Context, group GroupHandle, fieldGroup FieldHandle, opts WatchOptions) (<-chan FieldValueBatch, error) {
+	return defaultClient.StreamFieldValuesWithOptions(ctx, group, fieldGroup, opts)
+}
+
+// StreamFieldValuesWithOptions is like StreamFieldValues, but watches the fields with opts and
+// reads a batch every opts.UpdateFreq. opts.MaxKeepAge and opts.MaxKeepSamples must keep enough
+// samples to cover a few update intervals, or samples are lost whenever a read is late.
+func (c *Client) StreamFieldValuesWithOptions(ctx context.Context, group GroupHandle, fieldGroup FieldHandle, opts WatchOptions) (<-chan FieldValueBatch, error) {
+	start := time.Now()
+	if err := c.WatchFieldsWithOptions(fieldGroup, group, opts); err != nil {
+		return nil, err
+	}
+
+	batches := make(chan FieldValueBatch, 1)
+	cursor := c.NewValuesCursor(group, fieldGroup, start)
+
+	go func() {
+		defer close(batches)
+		defer func() { _ = c.UnwatchFields(group, fieldGroup) }()
+
+		ticker := time.NewTicker(opts.UpdateFreq)
+		defer ticker.Stop()
+
+		last := make(map[streamKey]int64)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			values, err := cursor.Next()
+			if errors.Is(err, ErrClientClosed) {
+				return
+			}
+
+			batch := FieldValueBatch{Time: time.Now(), Values: dedupeValues(values, last), Err: err}
+			if batch.Err == nil && len(batch.Values) == 0 {
+				continue
+			}
+
+			select {
+			case batches <- batch:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return batches, nil
+}
+
+// dedupeValues drops the samples that are not newer than the last sample delivered for their
+// series, and records the newest sample of every series in last
+func dedupeValues(values []FieldValue_v2, last map[streamKey]int64) []FieldValue_v2 {
+	fresh := values[:0]
+	for _, value := range values {
+		key := streamKey{entity: Entity{Group: value.EntityGroupId, ID: value.EntityID}, field: value.FieldID}
+		if ts, ok := last[key]; ok && value.TS <= ts {
+			continue
+		}
+		fresh = append(fresh, value)
+	}
+	for _, value := range fresh {
+		key := streamKey{entity: Entity{Group: value.EntityGroupId, ID: value.EntityID}, field: value.FieldID}
+		last[key] = max(last[key], value.TS)
+	}
+	return fresh
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupeValues(t *testing.T) {
+	gpu0, gpu1 := Entity{Group: FE_GPU, ID: 0}, Entity{Group: FE_GPU, ID: 1}
+
+	last := make(map[streamKey]int64)
+	first := dedupeValues([]FieldValue_v2{
+		fakeFieldValue(gpu0, DCGM_FI_DEV_GPU_TEMP, 0, 10),
+		fakeFieldValue(gpu0, DCGM_FI_DEV_GPU_TEMP, 0, 20),
+		fakeFieldValue(gpu1, DCGM_FI_DEV_GPU_TEMP, 0, 10),
+	}, last)
+	assert.Len(t, first, 3)
+
+	second := dedupeValues([]FieldValue_v2{
+		fakeFieldValue(gpu0, DCGM_FI_DEV_GPU_TEMP, 0, 20),
+		fakeFieldValue(gpu0, DCGM_FI_DEV_GPU_TEMP, 0, 30),
+		fakeFieldValue(gpu1, DCGM_FI_DEV_GPU_TEMP, 0, 10),
+		fakeFieldValue(gpu1, DCGM_FI_DEV_POWER_USAGE, 0, 10),
+	}, last)
+	assert.Equal(t, []FieldValue_v2{
+		fakeFieldValue(gpu0, DCGM_FI_DEV_GPU_TEMP, 0, 30),
+		fakeFieldValue(gpu1, DCGM_FI_DEV_POWER_USAGE, 0, 10),
+	}, second)
+}
+
+func TestStreamFieldValuesClosedClient(t *testing.T) {
+	_, err := (&Client{closing: true}).StreamFieldValues(context.Background(), GroupHandle{}, FieldHandle{})
+	require.ErrorIs(t, err, ErrClientClosed)
+}
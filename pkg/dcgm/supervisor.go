@@ -0,0 +1,207 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultSupervisorInitialBackoff = time.Second
+	defaultSupervisorMaxBackoff     = 30 * time.Second
+
+	hostengineStartTimeout      = 10 * time.Second
+	hostengineStartPollInterval = 100 * time.Millisecond
+)
+
+// HostengineEventType identifies what happened to a supervised nv-hostengine
+type HostengineEventType int
+
+const (
+	// HostengineExited is emitted when the child nv-hostengine exits unexpectedly
+	HostengineExited HostengineEventType = iota
+	// HostengineRestarted is emitted once a new nv-hostengine is running and connected
+	HostengineRestarted
+	// HostengineRestartFailed is emitted for every failed restart attempt
+	HostengineRestartFailed
+)
+
+func (t HostengineEventType) String() string {
+	switch t {
+	case HostengineExited:
+		return "exited"
+	case HostengineRestarted:
+		return "restarted"
+	case HostengineRestartFailed:
+		return "restart failed"
+	default:
+		return fmt.Sprintf("HostengineEventType(%d)", int(t))
+	}
+}
+
+// HostengineEvent describes a change in the state of a supervised nv-hostengine
+type HostengineEvent struct {
+	Type HostengineEventType
+	// Pid is the pid of the nv-hostengine the event refers to
+	Pid int
+	// Err describes why the hostengine exited or why a restart failed
+	Err error
+}
+
+// SupervisorOptions configures the supervision of a child nv-hostengine
+type SupervisorOptions struct {
+	// Restart starts a new nv-hostengine and reconnects when the child exits
+	Restart bool
+	// InitialBackoff is the delay before the first restart attempt. Defaults to 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between restart attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+	// OnEvent, if set, is called from the supervisor goroutine for every event
+	OnEvent func(HostengineEvent)
+}
+
+func (opts SupervisorOptions) emit(event HostengineEvent) {
+	if opts.OnEvent != nil {
+		opts.OnEvent(event)
+	}
+}
+
+type supervisorState struct {
+	stop chan struct{}
+}
+
+// startSupervisor starts watching the child nv-hostengine
+func (c *Client) startSupervisor(opts SupervisorOptions) {
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultSupervisorInitialBackoff
+	}
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = max(defaultSupervisorMaxBackoff, opts.InitialBackoff)
+	}
+
+	c.supervisor = &supervisorState{stop: make(chan struct{})}
+	go c.supervise(opts, c.supervisor.stop)
+}
+
+// stopSupervisor stops watching the child nv-hostengine, if it is supervised.
+func (c *Client) stopSupervisor() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.supervisor != nil {
+		close(c.supervisor.stop)
+		c.supervisor = nil
+	}
+}
+
+func (c *Client) supervise(opts SupervisorOptions, stop chan struct{}) {
+	for {
+		pid := c.childPid()
+
+		var status syscall.WaitStatus
+		_, err := syscall.Wait4(pid, &status, 0, nil)
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		if err == nil {
+			err = exitError(status)
+		}
+		opts.emit(HostengineEvent{Type: HostengineExited, Pid: pid, Err: err})
+
+		if !opts.Restart {
+			return
+		}
+
+		backoff := opts.InitialBackoff
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(backoff):
+			}
+
+			if err := c.beginCall(); err != nil {
+				// the client is closing; Close stops the supervisor once it is drained
+				continue
+			}
+			err := c.restartHostengine()
+			c.endCall()
+
+			if err == nil {
+				opts.emit(HostengineEvent{Type: HostengineRestarted, Pid: c.childPid()})
+				break
+			}
+
+			opts.emit(HostengineEvent{Type: HostengineRestartFailed, Err: err})
+			backoff = min(backoff*2, opts.MaxBackoff)
+		}
+	}
+}
+
+// restartHostengine starts a new child nv-hostengine, connects to it and replays the
+// state recorded for auto-reconnect, if enabled.
+func (c *Client) restartHostengine() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_ = C.dcgmDisconnect(c.handle.handle)
+
+	pid, err := c.spawnHostengine()
+	if err != nil {
+		return err
+	}
+	c.hostengineAsChildPid = pid
+
+	if err = c.connectSpawned(); err != nil {
+		// Do not leave a hostengine behind that nobody is connected to
+		_ = syscall.Kill(pid, syscall.SIGKILL)
+		_, _ = syscall.Wait4(pid, nil, 0, nil)
+		return err
+	}
+
+	return c.replay()
+}
+
+// connectSpawned connects to a freshly started nv-hostengine, retrying while it starts listening
+func (c *Client) connectSpawned() (err error) {
+	deadline := time.Now().Add(hostengineStartTimeout)
+	for {
+		if err = c.connectStandalone(c.connectOpts); err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(hostengineStartPollInterval)
+	}
+}
+
+// childPid returns the pid of the child nv-hostengine
+func (c *Client) childPid() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.hostengineAsChildPid
+}
+
+func exitError(status syscall.WaitStatus) error {
+	switch {
+	case status.Signaled():
+		return fmt.Errorf("nv-hostengine killed by signal %s", status.Signal())
+	case status.Exited() && status.ExitStatus() != 0:
+		return fmt.Errorf("nv-hostengine exited with status %d", status.ExitStatus())
+	default:
+		return errors.New("nv-hostengine exited")
+	}
+}
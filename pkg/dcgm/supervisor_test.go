@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitError(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "exit 3")
+	require.Error(t, cmd.Run())
+
+	status := cmd.ProcessState.Sys().(syscall.WaitStatus)
+	assert.EqualError(t, exitError(status), "nv-hostengine exited with status 3")
+}
+
+func TestHostengineEventTypeString(t *testing.T) {
+	assert.Equal(t, "exited", HostengineExited.String())
+	assert.Equal(t, "restarted", HostengineRestarted.String())
+	assert.Equal(t, "restart failed", HostengineRestartFailed.String())
+}
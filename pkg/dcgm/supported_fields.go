@@ -0,0 +1,92 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+)
+
+// isProfilingField reports whether fieldID is a DCGM_FI_PROF_* field, whose support is decided by
+// the profiling module rather than by the driver
+func isProfilingField(fieldID Short) bool {
+	return fieldID >= DCGM_FI_PROF_GR_ENGINE_ACTIVE && fieldID <= DCGM_FI_PROF_C2C_RX_DATA_BYTES
+}
+
+// fieldValueSupported reports whether a sampled field value shows the field is supported. Blank
+// values are considered supported, since they only mean no sample was taken yet.
+func fieldValueSupported(fv FieldValue_v2) bool {
+	switch fv.State() {
+	case ValueNotSupported, ValueNotFound:
+		return false
+	}
+	return true
+}
+
+// GetSupportedFields returns the subset of fields that are supported on the specified GPU with the
+// current driver. Profiling fields are checked against the metric groups the GPU supports, other
+// fields are sampled once.
+func GetSupportedFields(gpuID uint, fields []Short) ([]Short, error) {
+	return defaultClient.GetSupportedFields(gpuID, fields)
+}
+
+// GetSupportedFields returns the subset of fields that are supported on the specified GPU with the
+// current driver. Profiling fields are checked against the metric groups the GPU supports, other
+// fields are sampled once.
+func (c *Client) GetSupportedFields(gpuID uint, fields []Short) ([]Short, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	var profFields, devFields []Short
+	for _, field := range fields {
+		if isProfilingField(field) {
+			profFields = append(profFields, field)
+		} else {
+			devFields = append(devFields, field)
+		}
+	}
+
+	supported := make(map[Short]bool, len(fields))
+
+	if len(profFields) > 0 {
+		groups, err := c.getSupportedMetricGroups(gpuID)
+		var dcgmErr *Error
+		switch {
+		case errors.As(err, &dcgmErr) && (dcgmErr.Code == DCGM_ST_MODULE_NOT_LOADED ||
+			dcgmErr.Code == DCGM_ST_PROFILING_NOT_SUPPORTED || dcgmErr.Code == DCGM_ST_NOT_SUPPORTED):
+			// no profiling fields are supported
+		case err != nil:
+			return nil, err
+		}
+
+		for _, group := range groups {
+			for _, field := range group.FieldIds {
+				supported[Short(field)] = true
+			}
+		}
+	}
+
+	if len(devFields) > 0 {
+		values, err := c.sampleEntityFields("supportedFields", []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}}, devFields)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range values {
+			supported[value.FieldID] = fieldValueSupported(value)
+		}
+	}
+
+	result := make([]Short, 0, len(fields))
+	for _, field := range fields {
+		if supported[field] {
+			result = append(result, field)
+		}
+	}
+	return result, nil
+}
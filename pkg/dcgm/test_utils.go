@@ -17,6 +17,8 @@
 package dcgm
 
 import (
+	"encoding/binary"
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
@@ -70,7 +72,7 @@ func setupTest(tb testing.TB) func(testing.TB) {
 func runOnlyWithLiveGPUs(t *testing.T) {
 	t.Helper()
 
-	gpus, err := getSupportedDevices()
+	gpus, err := GetSupportedDevices()
 	require.NoError(t, err)
 
 	if len(gpus) < 1 {
@@ -87,14 +89,7 @@ func withInjectionGPUs(tb testing.TB, count int) ([]uint, error) {
 		tb.Skipf("Unable to add fake GPU with more than %d gpus", MAX_NUM_DEVICES)
 	}
 
-	entityList := make([]MigHierarchyInfo, count)
-	for i := range entityList {
-		entityList[i] = MigHierarchyInfo{
-			Entity: GroupEntityPair{EntityGroupId: FE_GPU},
-		}
-	}
-
-	return CreateFakeEntities(entityList)
+	return CreateFakeGPUs(count)
 }
 
 // withInjectionGPUInstances creates fake GPU instances on the specified GPU.
@@ -217,3 +212,25 @@ func withNvsdmMockConfig(t *testing.T, configYamlPath string, testFunc func(t *t
 	// Run the test
 	testFunc(t)
 }
+
+// fakeFieldValue returns an int64 value of a field of an entity, as DCGM returns it, with the
+// timestamp ts in microseconds
+func fakeFieldValue(entity Entity, field Short, value int64, ts int64) FieldValue_v2 {
+	fv := FieldValue_v2{EntityGroupId: entity.Group, EntityID: entity.ID, FieldID: field, FieldType: DCGM_FT_INT64, TS: ts}
+	binary.LittleEndian.PutUint64(fv.Value[:], uint64(value))
+	return fv
+}
+
+// fakeFloat64FieldValue is like fakeFieldValue for a float64 value
+func fakeFloat64FieldValue(entity Entity, field Short, value float64, ts int64) FieldValue_v2 {
+	fv := FieldValue_v2{EntityGroupId: entity.Group, EntityID: entity.ID, FieldID: field, FieldType: DCGM_FT_DOUBLE, TS: ts}
+	binary.LittleEndian.PutUint64(fv.Value[:], math.Float64bits(value))
+	return fv
+}
+
+// fakeStringFieldValue is like fakeFieldValue for a string value
+func fakeStringFieldValue(entity Entity, field Short, value string, ts int64) FieldValue_v2 {
+	fv := FieldValue_v2{EntityGroupId: entity.Group, EntityID: entity.ID, FieldID: field, FieldType: DCGM_FT_STRING, TS: ts}
+	copy(fv.Value[:], value)
+	return fv
+}
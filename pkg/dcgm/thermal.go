@@ -0,0 +1,128 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// GPUThermals is a sample of the temperatures of a GPU and its memory together with their
+// thresholds, all in °C. Values the GPU does not report, such as the memory temperature of GPUs
+// without HBM, are NaN.
+type GPUThermals struct {
+	GPU  uint
+	Time time.Time
+	// Temperature is the current GPU core temperature
+	Temperature float64
+	// MemoryTemperature is the current memory (HBM) temperature
+	MemoryTemperature float64
+	// Margin is the distance of the GPU temperature to the nearest slowdown threshold
+	Margin float64
+	// MaxOperating and MemoryMaxOperating are the maximum operating temperatures of the GPU and
+	// its memory, above which the clocks are lowered by the software thermal slowdown
+	MaxOperating       float64
+	MemoryMaxOperating float64
+	// Slowdown is the temperature at which the hardware slows the GPU down
+	Slowdown float64
+	// Shutdown is the temperature at which the GPU shuts down
+	Shutdown float64
+}
+
+var gpuThermalFields = []Short{
+	DCGM_FI_DEV_GPU_TEMP,
+	DCGM_FI_DEV_MEMORY_TEMP,
+	DCGM_FI_DEV_GPU_TEMP_LIMIT,
+	DCGM_FI_DEV_GPU_MAX_OP_TEMP,
+	DCGM_FI_DEV_MEM_MAX_OP_TEMP,
+	DCGM_FI_DEV_SLOWDOWN_TEMP,
+	DCGM_FI_DEV_SHUTDOWN_TEMP,
+}
+
+// GetGPUThermals samples the temperatures and thermal thresholds of the specified GPUs, or of all
+// supported GPUs if none are specified. Readings are in the order of the GPUs.
+func GetGPUThermals(gpuIDs ...uint) ([]GPUThermals, error) {
+	return defaultClient.GetGPUThermals(gpuIDs...)
+}
+
+// GetGPUThermals samples the temperatures and thermal thresholds of the specified GPUs, or of all
+// supported GPUs if none are specified. Readings are in the order of the GPUs.
+func (c *Client) GetGPUThermals(gpuIDs ...uint) ([]GPUThermals, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	if len(gpuIDs) == 0 {
+		gpus, err := c.getSupportedDevices()
+		if err != nil {
+			return nil, err
+		}
+		gpuIDs = gpus
+	}
+	if len(gpuIDs) == 0 {
+		return []GPUThermals{}, nil
+	}
+
+	entities := make([]GroupEntityPair, len(gpuIDs))
+	for i, gpuID := range gpuIDs {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID}
+	}
+	values, err := c.sampleEntityFields("gpuThermals", entities, gpuThermalFields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting GPU thermals: %s", err)
+	}
+
+	return toGPUThermals(gpuIDs, values), nil
+}
+
+func toGPUThermals(gpuIDs []uint, values []FieldValue_v2) []GPUThermals {
+	nan := math.NaN()
+	thermals := make([]GPUThermals, len(gpuIDs))
+	index := make(map[uint]*GPUThermals, len(gpuIDs))
+	for i, gpuID := range gpuIDs {
+		thermals[i] = GPUThermals{
+			GPU:         gpuID,
+			Temperature: nan, MemoryTemperature: nan, Margin: nan,
+			MaxOperating: nan, MemoryMaxOperating: nan, Slowdown: nan, Shutdown: nan,
+		}
+		index[gpuID] = &thermals[i]
+	}
+
+	for _, value := range values {
+		if value.EntityGroupId != FE_GPU {
+			continue
+		}
+		t, ok := index[value.EntityID]
+		if !ok {
+			continue
+		}
+		v, ok := scaledValue(value, 1)
+		if !ok {
+			continue
+		}
+
+		switch value.FieldID {
+		case DCGM_FI_DEV_GPU_TEMP:
+			t.Temperature = v
+		case DCGM_FI_DEV_MEMORY_TEMP:
+			t.MemoryTemperature = v
+		case DCGM_FI_DEV_GPU_TEMP_LIMIT:
+			t.Margin = v
+		case DCGM_FI_DEV_GPU_MAX_OP_TEMP:
+			t.MaxOperating = v
+		case DCGM_FI_DEV_MEM_MAX_OP_TEMP:
+			t.MemoryMaxOperating = v
+		case DCGM_FI_DEV_SLOWDOWN_TEMP:
+			t.Slowdown = v
+		case DCGM_FI_DEV_SHUTDOWN_TEMP:
+			t.Shutdown = v
+		default:
+			continue
+		}
+		if ts := timestampUSECToTime(value.TS); ts.After(t.Time) {
+			t.Time = ts
+		}
+	}
+
+	return thermals
+}
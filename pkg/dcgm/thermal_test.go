@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGPUThermals(t *testing.T) {
+	thermals := toGPUThermals([]uint{1, 0}, []FieldValue_v2{
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP, 64, 1_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_MEMORY_TEMP, 71, 2_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_GPU_TEMP_LIMIT, 23, 1_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_SLOWDOWN_TEMP, 87, 1_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 0}, DCGM_FI_DEV_SHUTDOWN_TEMP, 92, 1_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 1}, DCGM_FI_DEV_GPU_TEMP, 40, 1_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 1}, DCGM_FI_DEV_MEMORY_TEMP, DCGM_FT_INT32_NOT_SUPPORTED, 1_000_000),
+		fakeFieldValue(Entity{Group: FE_GPU, ID: 7}, DCGM_FI_DEV_GPU_TEMP, 99, 1_000_000),
+	})
+	require.Len(t, thermals, 2)
+
+	assert.Equal(t, uint(1), thermals[0].GPU)
+	assert.InDelta(t, 40, thermals[0].Temperature, 0)
+	assert.True(t, math.IsNaN(thermals[0].MemoryTemperature))
+
+	assert.Equal(t, uint(0), thermals[1].GPU)
+	assert.InDelta(t, 64, thermals[1].Temperature, 0)
+	assert.InDelta(t, 71, thermals[1].MemoryTemperature, 0)
+	assert.InDelta(t, 23, thermals[1].Margin, 0)
+	assert.InDelta(t, 87, thermals[1].Slowdown, 0)
+	assert.InDelta(t, 92, thermals[1].Shutdown, 0)
+	assert.True(t, math.IsNaN(thermals[1].MaxOperating))
+	assert.Equal(t, timestampUSECToTime(2_000_000), thermals[1].Time)
+}
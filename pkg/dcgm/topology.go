@@ -103,22 +103,22 @@ func getP2PLink(path uint) P2PLinkType {
 	return P2PLinkUnknown
 }
 
-func getBusID(gpuID uint) (string, error) {
+func (c *Client) getBusID(gpuID uint) (string, error) {
 	var device C.dcgmDeviceAttributes_v3
 	device.version = makeVersion3(unsafe.Sizeof(device))
 
-	result := C.dcgmGetDeviceAttributes(handle.handle, C.uint(gpuID), &device)
+	result := C.dcgmGetDeviceAttributes(c.dcgmHandle(), C.uint(gpuID), &device)
 	if err := errorString(result); err != nil {
 		return "", fmt.Errorf("error getting device busid: %s", err)
 	}
 	return *stringPtr(&device.identifiers.pciBusId[0]), nil
 }
 
-func getDeviceTopology(gpuID uint) (links []P2PLink, err error) {
+func (c *Client) getDeviceTopology(gpuID uint) (links []P2PLink, err error) {
 	var topology C.dcgmDeviceTopology_v1
 	topology.version = makeVersion1(unsafe.Sizeof(topology))
 
-	result := C.dcgmGetDeviceTopology(handle.handle, C.uint(gpuID), &topology)
+	result := C.dcgmGetDeviceTopology(c.dcgmHandle(), C.uint(gpuID), &topology)
 	if result == C.DCGM_ST_NOT_SUPPORTED {
 		return links, nil
 	}
@@ -126,7 +126,7 @@ func getDeviceTopology(gpuID uint) (links []P2PLink, err error) {
 		return links, &Error{msg: C.GoString(C.errorString(result)), Code: result}
 	}
 
-	busid, err := getBusID(gpuID)
+	busid, err := c.getBusID(gpuID)
 	if err != nil {
 		return
 	}
@@ -165,11 +165,11 @@ type NvLinkStatus struct {
 	Index uint
 }
 
-func getNvLinkLinkStatus() ([]NvLinkStatus, error) {
+func (c *Client) getNvLinkLinkStatus() ([]NvLinkStatus, error) {
 	var linkStatus C.dcgmNvLinkStatus_v4
 	linkStatus.version = makeVersion4(unsafe.Sizeof(linkStatus))
 
-	result := C.dcgmGetNvLinkLinkStatus(handle.handle, &linkStatus)
+	result := C.dcgmGetNvLinkLinkStatus(c.dcgmHandle(), &linkStatus)
 	if result == C.DCGM_ST_NOT_SUPPORTED {
 		return nil, nil
 	}
@@ -0,0 +1,241 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"unsafe"
+)
+
+// nvLinkPathMask masks the NVLink bits of a DCGM_TOPOLOGY_* path, as DCGM_TOPOLOGY_PATH_NVLINK does
+const nvLinkPathMask = 0xFFFFFF00
+
+// TopologyGroupBuilder creates GPU groups from topology constraints, such as "4 GPUs with full
+// NVLink connectivity" or "the GPUs on NUMA node 0". Constraints are combined, and the GPUs are
+// selected from the topology that DCGM reports when Select or Create is called.
+//
+// DCGM does not report NUMA nodes, so GPUs are placed on them by their CPU affinity: the GPUs close
+// to the same CPUs share a node, and nodes are numbered in the order of their first CPU, which
+// matches the numbering of the kernel on common systems.
+type TopologyGroupBuilder struct {
+	c          *Client
+	gpus       []uint
+	count      int
+	fullNvLink bool
+	numaNode   int
+}
+
+// NewTopologyGroupBuilder returns a builder that selects among all supported GPUs, without any
+// constraint
+func NewTopologyGroupBuilder() *TopologyGroupBuilder {
+	return defaultClient.NewTopologyGroupBuilder()
+}
+
+// NewTopologyGroupBuilder returns a builder that selects among all supported GPUs, without any
+// constraint
+func (c *Client) NewTopologyGroupBuilder() *TopologyGroupBuilder {
+	return &TopologyGroupBuilder{c: c, numaNode: -1}
+}
+
+// FromGPUs restricts the selection to the specified GPUs, for instance the ones not in use yet
+func (b *TopologyGroupBuilder) FromGPUs(gpuIDs ...uint) *TopologyGroupBuilder {
+	b.gpus = gpuIDs
+	return b
+}
+
+// Count sets the number of GPUs to select. By default, all GPUs matching the constraints are
+// selected, or the largest set of them that is fully connected with NVLink.
+func (b *TopologyGroupBuilder) Count(n int) *TopologyGroupBuilder {
+	b.count = n
+	return b
+}
+
+// FullNvLink requires every selected GPU to be connected to every other with NVLink
+func (b *TopologyGroupBuilder) FullNvLink() *TopologyGroupBuilder {
+	b.fullNvLink = true
+	return b
+}
+
+// NUMANode restricts the selection to the GPUs on the specified NUMA node
+func (b *TopologyGroupBuilder) NUMANode(node int) *TopologyGroupBuilder {
+	b.numaNode = node
+	return b
+}
+
+// Select returns the IDs of the GPUs matching the constraints, in ascending order. When several
+// sets match, the one with the lowest IDs is returned. It fails if fewer GPUs than the requested
+// count match.
+func (b *TopologyGroupBuilder) Select() ([]uint, error) {
+	if err := b.c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer b.c.endCall()
+
+	if b.count < 0 {
+		return nil, fmt.Errorf("invalid GPU count %d", b.count)
+	}
+
+	gpuIDs := b.gpus
+	if len(gpuIDs) == 0 {
+		gpus, err := b.c.getSupportedDevices()
+		if err != nil {
+			return nil, err
+		}
+		gpuIDs = gpus
+	}
+
+	topologies := make([]gpuTopology, len(gpuIDs))
+	for i, gpuID := range gpuIDs {
+		topology, err := b.c.getGPUTopology(gpuID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting topology of GPU %d: %s", gpuID, err)
+		}
+		topologies[i] = topology
+	}
+
+	return selectByTopology(topologies, b.count, b.fullNvLink, b.numaNode)
+}
+
+// Create creates a group with the specified name holding the GPUs returned by Select
+func (b *TopologyGroupBuilder) Create(groupName string) (GroupHandle, error) {
+	if err := b.c.beginCall(); err != nil {
+		return GroupHandle{}, err
+	}
+	defer b.c.endCall()
+
+	gpuIDs, err := b.Select()
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	entities := make([]GroupEntityPair, len(gpuIDs))
+	for i, gpuID := range gpuIDs {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID}
+	}
+	return b.c.createGroupWithEntities(GroupEmpty, groupName, entities)
+}
+
+// gpuTopology is the part of the topology of a GPU that groups are built from
+type gpuTopology struct {
+	GPU uint
+	// CPUAffinity is the mask of the CPUs close to the GPU
+	CPUAffinity []uint64
+	// NvLinkPeers are the GPUs connected to the GPU with NVLink
+	NvLinkPeers []uint
+}
+
+func (c *Client) getGPUTopology(gpuID uint) (gpuTopology, error) {
+	var topology C.dcgmDeviceTopology_v1
+	topology.version = makeVersion1(unsafe.Sizeof(topology))
+
+	result := C.dcgmGetDeviceTopology(c.dcgmHandle(), C.uint(gpuID), &topology)
+	if result == C.DCGM_ST_NOT_SUPPORTED {
+		return gpuTopology{GPU: gpuID}, nil
+	}
+	if result != C.DCGM_ST_OK {
+		return gpuTopology{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	t := gpuTopology{GPU: gpuID, CPUAffinity: make([]uint64, C.DCGM_AFFINITY_BITMASK_ARRAY_SIZE)}
+	for i := range t.CPUAffinity {
+		t.CPUAffinity[i] = uint64(topology.cpuAffinityMask[i])
+	}
+	for i := uint(0); i < uint(topology.numGpus); i++ {
+		if uint(topology.gpuPaths[i].path)&nvLinkPathMask != 0 {
+			t.NvLinkPeers = append(t.NvLinkPeers, uint(topology.gpuPaths[i].gpuId))
+		}
+	}
+	return t, nil
+}
+
+// numaNodes returns the NUMA node of every GPU, numbering the distinct CPU affinities in the order
+// of their first CPU. GPUs without CPU affinity are on node -1.
+func numaNodes(topologies []gpuTopology) map[uint]int {
+	firstCPU := func(mask []uint64) int {
+		for i, word := range mask {
+			if word != 0 {
+				return i*64 + bits.TrailingZeros64(word)
+			}
+		}
+		return -1
+	}
+
+	var masks [][]uint64
+	for _, t := range topologies {
+		if firstCPU(t.CPUAffinity) >= 0 && !slices.ContainsFunc(masks, func(m []uint64) bool { return slices.Equal(m, t.CPUAffinity) }) {
+			masks = append(masks, t.CPUAffinity)
+		}
+	}
+	slices.SortFunc(masks, func(a, b []uint64) int { return firstCPU(a) - firstCPU(b) })
+
+	nodes := make(map[uint]int, len(topologies))
+	for _, t := range topologies {
+		nodes[t.GPU] = slices.IndexFunc(masks, func(m []uint64) bool { return slices.Equal(m, t.CPUAffinity) })
+	}
+	return nodes
+}
+
+func selectByTopology(topologies []gpuTopology, count int, fullNvLink bool, numaNode int) ([]uint, error) {
+	nodes := numaNodes(topologies)
+	var candidates []uint
+	for _, t := range topologies {
+		if numaNode < 0 || nodes[t.GPU] == numaNode {
+			candidates = append(candidates, t.GPU)
+		}
+	}
+	slices.Sort(candidates)
+	candidates = slices.Compact(candidates)
+
+	if !fullNvLink {
+		if count == 0 {
+			return candidates, nil
+		}
+		if len(candidates) < count {
+			return nil, fmt.Errorf("only %d of %d GPUs match the topology constraints", len(candidates), count)
+		}
+		return candidates[:count], nil
+	}
+
+	linked := make(map[[2]uint]bool)
+	for _, t := range topologies {
+		for _, peer := range t.NvLinkPeers {
+			linked[[2]uint{t.GPU, peer}] = true
+			linked[[2]uint{peer, t.GPU}] = true
+		}
+	}
+
+	// the first set of size GPUs, in ID order, that are all connected to each other
+	var connected func(chosen []uint, start, size int) []uint
+	connected = func(chosen []uint, start, size int) []uint {
+		if len(chosen) == size {
+			return chosen
+		}
+		for i := start; i <= len(candidates)-(size-len(chosen)); i++ {
+			if !slices.ContainsFunc(chosen, func(gpu uint) bool { return !linked[[2]uint{gpu, candidates[i]}] }) {
+				if set := connected(append(chosen, candidates[i]), i+1, size); set != nil {
+					return set
+				}
+			}
+		}
+		return nil
+	}
+
+	if count > 0 {
+		if set := connected(nil, 0, count); set != nil {
+			return set, nil
+		}
+		return nil, fmt.Errorf("no %d GPUs fully connected with NVLink match the topology constraints", count)
+	}
+	for size := len(candidates); size > 0; size-- {
+		if set := connected(nil, 0, size); set != nil {
+			return set, nil
+		}
+	}
+	return []uint{}, nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectByTopology(t *testing.T) {
+	// two sockets with four GPUs each; GPUs 0-2 and 4-7 are fully connected with NVLink
+	node0, node1 := []uint64{0xffff, 0}, []uint64{0, 0xffff}
+	topologies := []gpuTopology{
+		{GPU: 0, CPUAffinity: node0, NvLinkPeers: []uint{1, 2}},
+		{GPU: 1, CPUAffinity: node0, NvLinkPeers: []uint{0, 2}},
+		{GPU: 2, CPUAffinity: node0, NvLinkPeers: []uint{0, 1}},
+		{GPU: 3, CPUAffinity: node0},
+		{GPU: 7, CPUAffinity: node1, NvLinkPeers: []uint{4, 5, 6}},
+		{GPU: 4, CPUAffinity: node1, NvLinkPeers: []uint{5, 6, 7}},
+		{GPU: 5, CPUAffinity: node1, NvLinkPeers: []uint{4, 6, 7}},
+		{GPU: 6, CPUAffinity: node1, NvLinkPeers: []uint{4, 5, 7}},
+	}
+
+	gpus, err := selectByTopology(topologies, 0, false, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{0, 1, 2, 3}, gpus)
+
+	gpus, err = selectByTopology(topologies, 2, false, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{4, 5}, gpus)
+
+	gpus, err = selectByTopology(topologies, 4, true, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{4, 5, 6, 7}, gpus)
+
+	gpus, err = selectByTopology(topologies, 0, true, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{0, 1, 2}, gpus)
+
+	_, err = selectByTopology(topologies, 4, true, 0)
+	require.Error(t, err)
+	_, err = selectByTopology(topologies, 5, false, 1)
+	require.Error(t, err)
+
+	gpus, err = selectByTopology(topologies, 1, false, 2)
+	require.Error(t, err)
+	assert.Nil(t, gpus)
+}
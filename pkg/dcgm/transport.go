@@ -0,0 +1,146 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Dialer opens a connection to a remote nv-hostengine, for example through an SSH tunnel or
+// a sidecar proxy. It is called once for every connection DCGM makes to the hostengine.
+type Dialer func(ctx context.Context) (net.Conn, error)
+
+// relay listens on a private Unix domain socket and forwards every connection made to it
+// through a Dialer, so that DCGM can reach hostengines it cannot connect to directly
+type relay struct {
+	dial     Dialer
+	dir      string
+	path     string
+	listener net.Listener
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
+}
+
+// startRelay starts forwarding connections made to the returned relay's socket through dial
+func startRelay(dial Dialer) (*relay, error) {
+	dir, err := os.MkdirTemp("", "dcgm-relay")
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, "nv-hostengine.sock")
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &relay{
+		dial:     dial,
+		dir:      dir,
+		path:     path,
+		listener: listener,
+		ctx:      ctx,
+		cancel:   cancel,
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	r.wg.Add(1)
+	go r.serve()
+
+	return r, nil
+}
+
+func (r *relay) serve() {
+	defer r.wg.Done()
+
+	for {
+		local, err := r.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		r.wg.Add(1)
+		go r.forward(local)
+	}
+}
+
+func (r *relay) forward(local net.Conn) {
+	defer r.wg.Done()
+
+	remote, err := r.dial(r.ctx)
+	if err != nil {
+		log.Printf("error dialing nv-hostengine: %v", err)
+		local.Close()
+		return
+	}
+
+	if !r.track(local, remote) {
+		local.Close()
+		remote.Close()
+		return
+	}
+	defer r.untrack(local, remote)
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pipe(remote, local)
+	go pipe(local, remote)
+
+	// Tear down both directions as soon as either side goes away
+	<-done
+	local.Close()
+	remote.Close()
+	<-done
+}
+
+// track registers the connections so that close can interrupt them. It fails once the relay is closed.
+func (r *relay) track(conns ...net.Conn) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ctx.Err() != nil {
+		return false
+	}
+	for _, conn := range conns {
+		r.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (r *relay) untrack(conns ...net.Conn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, conn := range conns {
+		delete(r.conns, conn)
+	}
+}
+
+// close stops accepting connections, closes the forwarded ones and removes the socket
+func (r *relay) close() error {
+	r.mu.Lock()
+	r.cancel()
+	for conn := range r.conns {
+		conn.Close()
+	}
+	r.mu.Unlock()
+
+	err := r.listener.Close()
+	r.wg.Wait()
+
+	return errors.Join(err, os.RemoveAll(r.dir))
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayForwardsThroughDialer(t *testing.T) {
+	dials := 0
+	r, err := startRelay(func(ctx context.Context) (net.Conn, error) {
+		dials++
+		client, server := net.Pipe()
+		go func() {
+			defer server.Close()
+			_, _ = io.Copy(server, server)
+		}()
+		return client, nil
+	})
+	require.NoError(t, err)
+
+	conn, err := net.Dial("unix", r.path)
+	require.NoError(t, err)
+
+	_, err = conn.Write([]byte("ping\n"))
+	require.NoError(t, err)
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "ping\n", line)
+	assert.Equal(t, 1, dials)
+
+	require.NoError(t, r.close())
+	conn.Close()
+
+	_, err = os.Stat(r.dir)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -37,6 +37,13 @@ func toInt64(c C.longlong) int64 {
 	return i
 }
 
+func boolToCUint(b bool) C.uint {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func dblToFloat(val C.double) *float64 {
 	i := float64(val)
 	return &i
@@ -79,6 +86,18 @@ func IsInt64Blank(value int64) bool {
 	return value >= dcgmInt64Blank
 }
 
+// IsFloat64Blank checks if a floating-point value represents DCGM's "blank" or sentinel value (2^47).
+// These values indicate that no valid data is available for the field.
+func IsFloat64Blank(value float64) bool {
+	return value >= DCGM_FT_FP64_BLANK
+}
+
+// IsStringBlank checks if a string value is one of DCGM's sentinel strings, such as "<<<NULL>>>".
+// These values indicate that no valid data is available for the field.
+func IsStringBlank(value string) bool {
+	return BlankReason(value) != ValueOK
+}
+
 func makeVersion1(struct_type uintptr) C.uint {
 	version := C.uint(struct_type | 1<<24)
 	return version
@@ -116,3 +135,12 @@ func roundFloat(f *float64) *float64 {
 	}
 	return &val
 }
+
+// counterDelta returns how much a cumulative counter grew from prev to cur. A counter that
+// decreased was reset, e.g. by a driver reload, and counts from zero.
+func counterDelta(prev, cur int64) int64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
@@ -0,0 +1,172 @@
+package dcgm
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValueState tells whether a field value holds data and, if not, why. DCGM reports missing data
+// either through the status of the value or through sentinel values, such as
+// DCGM_FT_INT64_NOT_SUPPORTED, stored in place of the data; ValueState covers both.
+type ValueState int
+
+const (
+	// ValueOK means the value holds data
+	ValueOK ValueState = iota
+	// ValueBlank means the field has no sample yet, e.g. because it was only just watched
+	ValueBlank
+	// ValueNotFound means the field does not exist on the entity
+	ValueNotFound
+	// ValueNotSupported means the entity or driver does not support the field
+	ValueNotSupported
+	// ValueNotPermissioned means reading the field needs privileges the hostengine does not have
+	ValueNotPermissioned
+	// ValueError means the hostengine failed to read the field; the status has the reason
+	ValueError
+)
+
+func (s ValueState) String() string {
+	switch s {
+	case ValueOK:
+		return "OK"
+	case ValueBlank:
+		return "Blank"
+	case ValueNotFound:
+		return "Not Found"
+	case ValueNotSupported:
+		return "Not Supported"
+	case ValueNotPermissioned:
+		return "Not Permissioned"
+	case ValueError:
+		return "Error"
+	}
+	return fmt.Sprintf("Unknown(%d)", int(s))
+}
+
+// BlankReason returns why a value read from a field holds a sentinel instead of data, or ValueOK
+// if it holds data. Values beyond the known sentinels, which DCGM also treats as blank, are
+// ValueBlank.
+func BlankReason[T int32 | int64 | float64 | string](v T) ValueState {
+	switch v := any(v).(type) {
+	case int32:
+		if IsInt32Blank(int(v)) {
+			return blankReason(int64(v) - DCGM_FT_INT32_BLANK)
+		}
+	case int64:
+		if IsInt64Blank(v) {
+			return blankReason(v - DCGM_FT_INT64_BLANK)
+		}
+	case float64:
+		if IsFloat64Blank(v) {
+			return blankReason(int64(v - DCGM_FT_FP64_BLANK))
+		}
+	case string:
+		switch v {
+		case DCGM_FT_STR_BLANK:
+			return ValueBlank
+		case DCGM_FT_STR_NOT_FOUND:
+			return ValueNotFound
+		case DCGM_FT_STR_NOT_SUPPORTED:
+			return ValueNotSupported
+		case DCGM_FT_STR_NOT_PERMISSIONED:
+			return ValueNotPermissioned
+		}
+	}
+	return ValueOK
+}
+
+// blankReason returns the reason of the sentinel at offset from the blank sentinel of its type;
+// the sentinels of every type are in the same order
+func blankReason(offset int64) ValueState {
+	switch offset {
+	case 1:
+		return ValueNotFound
+	case 2:
+		return ValueNotSupported
+	case 3:
+		return ValueNotPermissioned
+	}
+	return ValueBlank
+}
+
+// State returns whether the value holds data and, if not, why
+func (fv FieldValue_v2) State() ValueState {
+	switch fv.Status {
+	case DCGM_ST_OK:
+	case DCGM_ST_NO_DATA:
+		return ValueBlank
+	case DCGM_ST_NOT_SUPPORTED:
+		return ValueNotSupported
+	case DCGM_ST_NO_PERMISSION:
+		return ValueNotPermissioned
+	default:
+		return ValueError
+	}
+
+	switch fv.FieldType {
+	case DCGM_FT_INT64, DCGM_FT_TIMESTAMP:
+		return BlankReason(fv.Int64())
+	case DCGM_FT_DOUBLE:
+		return BlankReason(fv.Float64())
+	case DCGM_FT_STRING:
+		return BlankReason(fv.String())
+	}
+	return ValueOK
+}
+
+// IsValid reports whether the value holds data
+func (fv FieldValue_v2) IsValid() bool {
+	return fv.State() == ValueOK
+}
+
+// IsBlank reports whether the field has no sample yet. Unlike a value that is not supported, a
+// blank value may hold data later.
+func (fv FieldValue_v2) IsBlank() bool {
+	return fv.State() == ValueBlank
+}
+
+// IsNotSupported reports whether the entity or driver does not support the field
+func (fv FieldValue_v2) IsNotSupported() bool {
+	return fv.State() == ValueNotSupported
+}
+
+// Time returns the time the value was sampled at
+func (fv FieldValue_v2) Time() time.Time {
+	return timestampUSECToTime(fv.TS)
+}
+
+// State returns whether the value holds data and, if not, why
+func (fv FieldValue_v1) State() ValueState {
+	return FieldValue_v2{FieldType: fv.FieldType, Status: fv.Status, Value: fv.Value}.State()
+}
+
+// IsValid reports whether the value holds data
+func (fv FieldValue_v1) IsValid() bool {
+	return fv.State() == ValueOK
+}
+
+// IsBlank reports whether the field has no sample yet. Unlike a value that is not supported, a
+// blank value may hold data later.
+func (fv FieldValue_v1) IsBlank() bool {
+	return fv.State() == ValueBlank
+}
+
+// IsNotSupported reports whether the entity or driver does not support the field
+func (fv FieldValue_v1) IsNotSupported() bool {
+	return fv.State() == ValueNotSupported
+}
+
+// Time returns the time the value was sampled at
+func (fv FieldValue_v1) Time() time.Time {
+	return timestampUSECToTime(fv.TS)
+}
+
+// IsBlank reports whether the field had no sample yet
+func (v TypedValue) IsBlank() bool {
+	return v.State == ValueBlank
+}
+
+// IsNotSupported reports whether the entity or driver does not support the field
+func (v TypedValue) IsNotSupported() bool {
+	return v.State == ValueNotSupported
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueState(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 0}
+
+	for _, tc := range []struct {
+		value FieldValue_v2
+		state ValueState
+	}{
+		{fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 42, 1_500_000), ValueOK},
+		{fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_BLANK, 1_500_000), ValueBlank},
+		{fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_NOT_SUPPORTED, 1_500_000), ValueNotSupported},
+		{fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT32_NOT_SUPPORTED, 1_500_000), ValueOK},
+		{fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_NOT_PERMISSIONED, 1_500_000), ValueNotPermissioned},
+		{fakeFloat64FieldValue(gpu, DCGM_FI_DEV_POWER_USAGE, 1.5, 0), ValueOK},
+		{fakeFloat64FieldValue(gpu, DCGM_FI_DEV_POWER_USAGE, DCGM_FT_FP64_NOT_FOUND, 0), ValueNotFound},
+		{fakeStringFieldValue(gpu, DCGM_FI_DEV_NAME, "Tesla", 0), ValueOK},
+		{fakeStringFieldValue(gpu, DCGM_FI_DEV_NAME, DCGM_FT_STR_BLANK, 0), ValueBlank},
+		{FieldValue_v2{FieldType: DCGM_FT_INT64, Status: DCGM_ST_NO_DATA}, ValueBlank},
+		{FieldValue_v2{FieldType: DCGM_FT_INT64, Status: DCGM_ST_NOT_SUPPORTED}, ValueNotSupported},
+		{FieldValue_v2{FieldType: DCGM_FT_INT64, Status: DCGM_ST_NOT_WATCHED}, ValueError},
+	} {
+		assert.Equal(t, tc.state, tc.value.State(), "%v", tc.value.Value[:8])
+		assert.Equal(t, tc.state, tc.value.Typed().State)
+	}
+
+	blank := fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, DCGM_FT_INT64_BLANK, 1_500_000)
+	assert.True(t, blank.IsBlank())
+	assert.False(t, blank.IsNotSupported())
+	assert.False(t, blank.IsValid())
+	assert.True(t, blank.Typed().IsBlank())
+	assert.Nil(t, blank.Typed().Value)
+
+	notSupported := FieldValue_v1{FieldType: DCGM_FT_INT64}
+	binary.LittleEndian.PutUint64(notSupported.Value[:], uint64(DCGM_FT_INT64_NOT_SUPPORTED))
+	assert.True(t, notSupported.IsNotSupported())
+	assert.False(t, notSupported.IsBlank())
+
+	assert.Equal(t, timestampUSECToTime(1_500_000), fakeFieldValue(gpu, DCGM_FI_DEV_GPU_TEMP, 1, 1_500_000).Time())
+	assert.Equal(t, "Not Supported", ValueNotSupported.String())
+}
+
+func TestBlankReason(t *testing.T) {
+	assert.Equal(t, ValueOK, BlankReason(int64(42)))
+	assert.Equal(t, ValueBlank, BlankReason(DCGM_FT_INT64_BLANK))
+	assert.Equal(t, ValueNotFound, BlankReason(DCGM_FT_INT64_NOT_FOUND))
+	assert.Equal(t, ValueNotSupported, BlankReason(DCGM_FT_INT64_NOT_SUPPORTED))
+	assert.Equal(t, ValueNotPermissioned, BlankReason(DCGM_FT_INT64_NOT_PERMISSIONED))
+	assert.Equal(t, ValueBlank, BlankReason(int64(math.MaxInt64)))
+
+	assert.Equal(t, ValueOK, BlankReason(int32(42)))
+	assert.Equal(t, ValueNotSupported, BlankReason(int32(DCGM_FT_INT32_NOT_SUPPORTED)))
+
+	assert.Equal(t, ValueOK, BlankReason(42.5))
+	assert.Equal(t, ValueNotPermissioned, BlankReason(DCGM_FT_FP64_NOT_PERMISSIONED))
+
+	assert.Equal(t, ValueOK, BlankReason("H100"))
+	assert.Equal(t, ValueNotFound, BlankReason(DCGM_FT_STR_NOT_FOUND))
+
+	assert.True(t, IsInt64Blank(DCGM_FT_INT64_NOT_FOUND))
+	assert.False(t, IsInt64Blank(DCGM_FT_INT32_BLANK))
+	assert.True(t, IsFloat64Blank(DCGM_FT_FP64_BLANK))
+	assert.False(t, IsFloat64Blank(math.NaN()))
+	assert.True(t, IsStringBlank(DCGM_FT_STR_BLANK))
+	assert.False(t, IsStringBlank(""))
+}
@@ -0,0 +1,263 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// VersionInfo describes a DCGM build, as reported by the library or by a hostengine
+type VersionInfo struct {
+	// Version is the DCGM version, e.g. "4.1.1"
+	Version       string
+	Arch          string
+	BuildID       string
+	Commit        string
+	Author        string
+	Branch        string
+	BuildType     string
+	BuildDate     string
+	BuildPlatform string
+	// RawBuildInfo is the unparsed "key:value;key:value" build info string
+	RawBuildInfo string
+}
+
+// Major returns the major component of Version, or 0 if it cannot be parsed
+func (v VersionInfo) Major() int {
+	return v.component(0)
+}
+
+// Minor returns the minor component of Version, or 0 if it cannot be parsed
+func (v VersionInfo) Minor() int {
+	return v.component(1)
+}
+
+// Patch returns the patch component of Version, or 0 if it cannot be parsed
+func (v VersionInfo) Patch() int {
+	return v.component(2)
+}
+
+// AtLeast reports whether Version is major.minor.patch or newer
+func (v VersionInfo) AtLeast(major, minor, patch int) bool {
+	if v.Major() != major {
+		return v.Major() > major
+	}
+	if v.Minor() != minor {
+		return v.Minor() > minor
+	}
+	return v.Patch() >= patch
+}
+
+func (v VersionInfo) component(i int) int {
+	parts := strings.Split(v.Version, ".")
+	if i >= len(parts) {
+		return 0
+	}
+	n, err := strconv.Atoi(parts[i])
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func toVersionInfo(versionInfo C.dcgmVersionInfo_t) VersionInfo {
+	raw := C.GoString(&versionInfo.rawBuildInfoString[0])
+	info := parseBuildInfo(raw)
+
+	return VersionInfo{
+		Version:       info["version"],
+		Arch:          info["arch"],
+		BuildID:       info["buildid"],
+		Commit:        info["commit"],
+		Author:        info["author"],
+		Branch:        info["branch"],
+		BuildType:     info["buildtype"],
+		BuildDate:     info["builddate"],
+		BuildPlatform: info["buildplatform"],
+		RawBuildInfo:  raw,
+	}
+}
+
+// GetVersionInfo returns the build information of the loaded libdcgm
+// Returns an error if the library is not loaded
+func GetVersionInfo() (VersionInfo, error) {
+	libMux.Lock()
+	defer libMux.Unlock()
+
+	if libRefCount == 0 {
+		return VersionInfo{}, errors.New("libdcgm is not loaded")
+	}
+
+	var versionInfo C.dcgmVersionInfo_t
+	versionInfo.version = C.dcgmVersionInfo_version
+
+	result := C.dcgmVersionInfo(&versionInfo)
+	if err := errorString(result); err != nil {
+		return VersionInfo{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return toVersionInfo(versionInfo), nil
+}
+
+// GetHostengineVersionInfo returns the build information of the hostengine DCGM is connected to
+func GetHostengineVersionInfo() (VersionInfo, error) {
+	return defaultClient.GetHostengineVersionInfo()
+}
+
+// GetHostengineVersionInfo returns the build information of the hostengine this client is connected to
+func (c *Client) GetHostengineVersionInfo() (VersionInfo, error) {
+	if err := c.beginCall(); err != nil {
+		return VersionInfo{}, err
+	}
+	defer c.endCall()
+
+	var versionInfo C.dcgmVersionInfo_t
+	versionInfo.version = C.dcgmVersionInfo_version
+
+	result := C.dcgmHostengineVersionInfo(c.dcgmHandle(), &versionInfo)
+	if err := errorString(result); err != nil {
+		return VersionInfo{}, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	return toVersionInfo(versionInfo), nil
+}
+
+// Version is a parsed dotted version number, such as a driver, NVML or CUDA version. Missing
+// components are zero, so versions compare correctly with ==, Compare and AtLeast.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+}
+
+// ParseVersion parses a version of up to four dot-separated numbers, e.g. "535.104.05" or
+// "12.535.104.05"
+func ParseVersion(s string) (Version, error) {
+	parts := strings.Split(strings.TrimSpace(s), ".")
+	if len(parts) > 4 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+
+	var components [4]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		components[i] = n
+	}
+
+	return Version{Major: components[0], Minor: components[1], Patch: components[2], Build: components[3]}, nil
+}
+
+func (v Version) String() string {
+	switch {
+	case v.Build != 0:
+		return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Patch, v.Build)
+	case v.Patch != 0:
+		return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Compare returns -1, 0 or 1 if v is older than, equal to or newer than other
+func (v Version) Compare(other Version) int {
+	a := [4]int{v.Major, v.Minor, v.Patch, v.Build}
+	b := [4]int{other.Major, other.Minor, other.Patch, other.Build}
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is major.minor.patch or newer
+func (v Version) AtLeast(major, minor, patch int) bool {
+	return v.Compare(Version{Major: major, Minor: minor, Patch: patch}) >= 0
+}
+
+// SoftwareVersions contains the versions of the GPU software stack on a node
+type SoftwareVersions struct {
+	Driver     Version
+	NVML       Version
+	CUDADriver Version
+}
+
+// toCUDAVersion decodes a DCGM_FI_CUDA_DRIVER_VERSION value. The hostengine passes the NVML value
+// through, which holds the major version in the thousands and the minor version in the tens, e.g.
+// 12020 for CUDA 12.2.
+func toCUDAVersion(value int64) Version {
+	if value <= 0 || value >= DCGM_FT_INT64_BLANK {
+		return Version{}
+	}
+	return Version{Major: int(value / 1000), Minor: int(value%1000) / 10}
+}
+
+// GetSoftwareVersions returns the driver, NVML and CUDA driver versions of the node
+func GetSoftwareVersions() (SoftwareVersions, error) {
+	return defaultClient.GetSoftwareVersions()
+}
+
+// GetSoftwareVersions returns the driver, NVML and CUDA driver versions of the node
+func (c *Client) GetSoftwareVersions() (SoftwareVersions, error) {
+	if err := c.beginCall(); err != nil {
+		return SoftwareVersions{}, err
+	}
+	defer c.endCall()
+
+	fields := []Short{C.DCGM_FI_DRIVER_VERSION, C.DCGM_FI_NVML_VERSION, C.DCGM_FI_CUDA_DRIVER_VERSION}
+
+	fieldsID, err := c.FieldGroupCreate(fmt.Sprintf("softwareVersions%d", rand.Uint64()), fields)
+	if err != nil {
+		return SoftwareVersions{}, err
+	}
+	defer func() { _ = c.FieldGroupDestroy(fieldsID) }()
+
+	// global fields are watched through any group
+	if err = c.WatchFieldsWithGroup(fieldsID, GroupAllGPUs()); err != nil {
+		return SoftwareVersions{}, err
+	}
+	if err = c.updateAllFields(true); err != nil {
+		return SoftwareVersions{}, err
+	}
+
+	values, err := c.EntityGetLatestValues(FE_NONE, 0, fields)
+	if err != nil {
+		return SoftwareVersions{}, fmt.Errorf("error getting software versions: %s", err)
+	}
+
+	var versions SoftwareVersions
+	for _, value := range values {
+		if value.Status != C.DCGM_ST_OK {
+			continue
+		}
+
+		switch value.FieldID {
+		case C.DCGM_FI_DRIVER_VERSION:
+			if versions.Driver, err = ParseVersion(value.String()); err != nil {
+				return SoftwareVersions{}, fmt.Errorf("error parsing driver version: %w", err)
+			}
+		case C.DCGM_FI_NVML_VERSION:
+			if versions.NVML, err = ParseVersion(value.String()); err != nil {
+				return SoftwareVersions{}, fmt.Errorf("error parsing NVML version: %w", err)
+			}
+		case C.DCGM_FI_CUDA_DRIVER_VERSION:
+			versions.CUDADriver = toCUDAVersion(value.Int64())
+		}
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionInfoAtLeast(t *testing.T) {
+	v := VersionInfo{Version: "3.3.5"}
+	assert.Equal(t, 3, v.Major())
+	assert.Equal(t, 3, v.Minor())
+	assert.Equal(t, 5, v.Patch())
+
+	assert.True(t, v.AtLeast(3, 3, 5))
+	assert.True(t, v.AtLeast(3, 2, 9))
+	assert.True(t, v.AtLeast(2, 9, 9))
+	assert.False(t, v.AtLeast(3, 3, 6))
+	assert.False(t, v.AtLeast(4, 0, 0))
+
+	assert.Equal(t, 0, VersionInfo{}.Major())
+}
+
+func TestGetHostengineVersionInfo(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+
+	hostengine, err := GetHostengineVersionInfo()
+	require.NoError(t, err)
+	assert.NotEmpty(t, hostengine.Version)
+
+	library, err := GetVersionInfo()
+	require.NoError(t, err)
+	assert.NotEmpty(t, library.Version)
+}
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("535.104.05")
+	require.NoError(t, err)
+	assert.Equal(t, Version{Major: 535, Minor: 104, Patch: 5}, v)
+	assert.Equal(t, "535.104.5", v.String())
+
+	v, err = ParseVersion("12.535.104.05")
+	require.NoError(t, err)
+	assert.Equal(t, Version{Major: 12, Minor: 535, Patch: 104, Build: 5}, v)
+
+	for _, s := range []string{"", "abc", "1..2", "1.2.3.4.5", "-1.0"} {
+		_, err = ParseVersion(s)
+		assert.Error(t, err, s)
+	}
+
+	assert.Equal(t, 0, Version{Major: 550}.Compare(Version{Major: 550}))
+	assert.Equal(t, -1, Version{Major: 535, Minor: 104}.Compare(Version{Major: 550}))
+	assert.Equal(t, 1, Version{Major: 550, Minor: 54, Patch: 15}.Compare(Version{Major: 550, Minor: 54, Patch: 14}))
+	assert.True(t, v.AtLeast(12, 535, 0))
+	assert.False(t, v.AtLeast(12, 550, 0))
+
+	assert.Equal(t, Version{Major: 12, Minor: 2}, toCUDAVersion(12020))
+	assert.Equal(t, Version{Major: 11, Minor: 1}, toCUDAVersion(11010))
+	assert.Equal(t, Version{}, toCUDAVersion(DCGM_FT_INT64_BLANK))
+	assert.Equal(t, "12.2", toCUDAVersion(12020).String())
+}
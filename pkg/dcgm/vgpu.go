@@ -0,0 +1,217 @@
+package dcgm
+
+/*
+#include "dcgm_agent.h"
+#include "dcgm_structs.h"
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+)
+
+// VGPUInstance contains the attributes of a vGPU instance running on a physical GPU
+type VGPUInstance struct {
+	// ID is the DCGM entity ID of the vGPU instance
+	ID uint
+	// GPU is the ID of the physical GPU the instance runs on
+	GPU            uint
+	VMID           string
+	VMName         string
+	Type           int64
+	UUID           string
+	DriverVersion  string
+	MemoryUsage    int64 // MB
+	Licensed       bool
+	FrameRateLimit int64
+	PCIID          string
+}
+
+// VGPUUtilization contains the utilization of a vGPU instance
+type VGPUUtilization struct {
+	// ID is the DCGM entity ID of the vGPU instance
+	ID      uint
+	SMUtil  uint // %
+	MemUtil uint // %
+	EncUtil uint // %
+	DecUtil uint // %
+}
+
+// GetVGPUInstanceIDs returns the IDs of the vGPU instances currently active on the specified GPU
+func GetVGPUInstanceIDs(gpuID uint) ([]uint, error) {
+	return defaultClient.GetVGPUInstanceIDs(gpuID)
+}
+
+// GetVGPUInstanceIDs returns the IDs of the vGPU instances currently active on the specified GPU
+func (c *Client) GetVGPUInstanceIDs(gpuID uint) ([]uint, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getVGPUInstanceIDs(gpuID)
+}
+
+// GetVGPUInstances returns the attributes of the vGPU instances currently active on the specified GPU
+func GetVGPUInstances(gpuID uint) ([]VGPUInstance, error) {
+	return defaultClient.GetVGPUInstances(gpuID)
+}
+
+// GetVGPUInstances returns the attributes of the vGPU instances currently active on the specified GPU
+func (c *Client) GetVGPUInstances(gpuID uint) ([]VGPUInstance, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	return c.getVGPUInstances(gpuID)
+}
+
+// GetVGPUUtilizations returns the utilization of the vGPU instances running on the specified GPU
+func GetVGPUUtilizations(gpuID uint) ([]VGPUUtilization, error) {
+	return defaultClient.GetVGPUUtilizations(gpuID)
+}
+
+// GetVGPUUtilizations returns the utilization of the vGPU instances running on the specified GPU
+func (c *Client) GetVGPUUtilizations(gpuID uint) ([]VGPUUtilization, error) {
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	values, err := c.sampleEntityFields("vgpuUtil", []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}},
+		[]Short{C.DCGM_FI_DEV_VGPU_INSTANCE_IDS, C.DCGM_FI_DEV_VGPU_UTILIZATIONS})
+	if err != nil {
+		return nil, fmt.Errorf("error getting vGPU utilizations: %s", err)
+	}
+
+	var ids []uint
+	var blob *[4096]byte
+	for i := range values {
+		if values[i].Status != C.DCGM_ST_OK {
+			continue
+		}
+		switch values[i].FieldID {
+		case C.DCGM_FI_DEV_VGPU_INSTANCE_IDS:
+			ids = parseVGPUInstanceIDs(values[i].Value)
+		case C.DCGM_FI_DEV_VGPU_UTILIZATIONS:
+			blob = &values[i].Value
+		}
+	}
+	if blob == nil {
+		return []VGPUUtilization{}, nil
+	}
+
+	return parseVGPUUtilizations(*blob, len(ids)), nil
+}
+
+func (c *Client) getVGPUInstanceIDs(gpuID uint) ([]uint, error) {
+	values, err := c.sampleEntityFields("vgpuIds", []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpuID}},
+		[]Short{C.DCGM_FI_DEV_VGPU_INSTANCE_IDS})
+	if err != nil {
+		return nil, fmt.Errorf("error getting vGPU instance IDs: %s", err)
+	}
+	if len(values) == 0 || values[0].Status != C.DCGM_ST_OK {
+		return []uint{}, nil
+	}
+
+	return parseVGPUInstanceIDs(values[0].Value), nil
+}
+
+func (c *Client) getVGPUInstances(gpuID uint) ([]VGPUInstance, error) {
+	ids, err := c.getVGPUInstanceIDs(gpuID)
+	if err != nil || len(ids) == 0 {
+		return []VGPUInstance{}, err
+	}
+
+	instances := make([]VGPUInstance, len(ids))
+	index := make(map[uint]*VGPUInstance, len(ids))
+	entities := make([]GroupEntityPair, len(ids))
+	for i, id := range ids {
+		instances[i] = VGPUInstance{ID: id, GPU: gpuID}
+		index[id] = &instances[i]
+		entities[i] = GroupEntityPair{EntityGroupId: FE_VGPU, EntityId: id}
+	}
+
+	fields := []Short{
+		C.DCGM_FI_DEV_VGPU_VM_ID,
+		C.DCGM_FI_DEV_VGPU_VM_NAME,
+		C.DCGM_FI_DEV_VGPU_TYPE,
+		C.DCGM_FI_DEV_VGPU_UUID,
+		C.DCGM_FI_DEV_VGPU_DRIVER_VERSION,
+		C.DCGM_FI_DEV_VGPU_MEMORY_USAGE,
+		C.DCGM_FI_DEV_VGPU_LICENSE_STATUS,
+		C.DCGM_FI_DEV_VGPU_FRAME_RATE_LIMIT,
+		C.DCGM_FI_DEV_VGPU_PCI_ID,
+	}
+
+	values, err := c.sampleEntityFields("vgpuInstances", entities, fields)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vGPU instance attributes: %s", err)
+	}
+
+	for _, value := range values {
+		instance, ok := index[value.EntityID]
+		if !ok || value.Status != C.DCGM_ST_OK {
+			continue
+		}
+
+		switch value.FieldID {
+		case C.DCGM_FI_DEV_VGPU_VM_ID:
+			instance.VMID = value.String()
+		case C.DCGM_FI_DEV_VGPU_VM_NAME:
+			instance.VMName = value.String()
+		case C.DCGM_FI_DEV_VGPU_TYPE:
+			instance.Type = value.Int64()
+		case C.DCGM_FI_DEV_VGPU_UUID:
+			instance.UUID = value.String()
+		case C.DCGM_FI_DEV_VGPU_DRIVER_VERSION:
+			instance.DriverVersion = value.String()
+		case C.DCGM_FI_DEV_VGPU_MEMORY_USAGE:
+			instance.MemoryUsage = value.Int64()
+		case C.DCGM_FI_DEV_VGPU_LICENSE_STATUS:
+			instance.Licensed = value.Int64() == 1
+		case C.DCGM_FI_DEV_VGPU_FRAME_RATE_LIMIT:
+			instance.FrameRateLimit = value.Int64()
+		case C.DCGM_FI_DEV_VGPU_PCI_ID:
+			instance.PCIID = value.String()
+		}
+	}
+
+	return instances, nil
+}
+
+// parseVGPUInstanceIDs decodes the DCGM_FI_DEV_VGPU_INSTANCE_IDS blob, an array of unsigned ints
+// holding the number of instances followed by their IDs
+func parseVGPUInstanceIDs(blob [4096]byte) []uint {
+	count := min(uint(binary.LittleEndian.Uint32(blob[0:])), uint(C.DCGM_MAX_VGPU_INSTANCES_PER_PGPU))
+
+	ids := make([]uint, count)
+	for i := range ids {
+		ids[i] = uint(binary.LittleEndian.Uint32(blob[4*(i+1):]))
+	}
+	return ids
+}
+
+// parseVGPUUtilizations decodes the first count entries of the DCGM_FI_DEV_VGPU_UTILIZATIONS blob,
+// an array of dcgmDeviceVgpuUtilInfo_t with one entry per active vGPU instance
+func parseVGPUUtilizations(blob [4096]byte, count int) []VGPUUtilization {
+	var info C.dcgmDeviceVgpuUtilInfo_t
+	size := int(unsafe.Sizeof(info))
+	count = min(count, int(C.DCGM_MAX_VGPU_INSTANCES_PER_PGPU), len(blob)/size)
+
+	utilizations := make([]VGPUUtilization, count)
+	for i := range utilizations {
+		entry := blob[i*size:]
+		utilizations[i] = VGPUUtilization{
+			ID:      uint(binary.LittleEndian.Uint32(entry[4:])),
+			SMUtil:  uint(binary.LittleEndian.Uint32(entry[8:])),
+			MemUtil: uint(binary.LittleEndian.Uint32(entry[12:])),
+			EncUtil: uint(binary.LittleEndian.Uint32(entry[16:])),
+			DecUtil: uint(binary.LittleEndian.Uint32(entry[20:])),
+		}
+	}
+	return utilizations
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVGPUInstanceIDs(t *testing.T) {
+	var blob [4096]byte
+	for i, v := range []uint32{3, 7, 8, 12} {
+		binary.LittleEndian.PutUint32(blob[4*i:], v)
+	}
+	assert.Equal(t, []uint{7, 8, 12}, parseVGPUInstanceIDs(blob))
+
+	assert.Empty(t, parseVGPUInstanceIDs([4096]byte{}))
+}
+
+func TestParseVGPUUtilizations(t *testing.T) {
+	var blob [4096]byte
+	entries := [][6]uint32{
+		{1, 7, 50, 20, 5, 0},
+		{1, 8, 10, 30, 0, 15},
+	}
+	for i, entry := range entries {
+		for j, v := range entry {
+			binary.LittleEndian.PutUint32(blob[24*i+4*j:], v)
+		}
+	}
+
+	assert.Equal(t, []VGPUUtilization{
+		{ID: 7, SMUtil: 50, MemUtil: 20, EncUtil: 5, DecUtil: 0},
+		{ID: 8, SMUtil: 10, MemUtil: 30, EncUtil: 0, DecUtil: 15},
+	}, parseVGPUUtilizations(blob, 2))
+
+	assert.Len(t, parseVGPUUtilizations(blob, 1), 1)
+}
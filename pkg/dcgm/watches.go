@@ -0,0 +1,195 @@
+package dcgm
+
+/*
+#include "dcgm_test_apis.h"
+#include "dcgm_structs_internal.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+	"unsafe"
+)
+
+// WatcherType is the kind of component that watches a field
+type WatcherType int
+
+const (
+	// WatcherClient is a client connection, such as this package
+	WatcherClient WatcherType = C.DcgmWatcherTypeClient
+	// WatcherHostEngine is the hostengine itself
+	WatcherHostEngine WatcherType = C.DcgmWatcherTypeHostEngine
+	// WatcherHealthWatch is the health module, watching fields for health checks
+	WatcherHealthWatch WatcherType = C.DcgmWatcherTypeHealthWatch
+	// WatcherPolicyManager is the policy module, watching fields for policy violations
+	WatcherPolicyManager WatcherType = C.DcgmWatcherTypePolicyManager
+	// WatcherCacheManager is the cache manager
+	WatcherCacheManager WatcherType = C.DcgmWatcherTypeCacheManager
+	// WatcherConfigManager is the config module
+	WatcherConfigManager WatcherType = C.DcgmWatcherTypeConfigManager
+	// WatcherNvSwitchManager is the NvSwitch module
+	WatcherNvSwitchManager WatcherType = C.DcgmWatcherTypeNvSwitchManager
+)
+
+func (t WatcherType) String() string {
+	switch t {
+	case WatcherClient:
+		return "Client"
+	case WatcherHostEngine:
+		return "HostEngine"
+	case WatcherHealthWatch:
+		return "HealthWatch"
+	case WatcherPolicyManager:
+		return "PolicyManager"
+	case WatcherCacheManager:
+		return "CacheManager"
+	case WatcherConfigManager:
+		return "ConfigManager"
+	case WatcherNvSwitchManager:
+		return "NvSwitchManager"
+	}
+	return fmt.Sprintf("Unknown(%d)", int(t))
+}
+
+// FieldWatcher is one watcher of a field
+type FieldWatcher struct {
+	Type WatcherType
+	// ConnectionID is the hostengine connection of a WatcherClient watcher, 0 for other watchers
+	ConnectionID uint
+	// UpdateInterval is how often the watcher wants the field sampled
+	UpdateInterval time.Duration
+	// MaxKeepAge is how long the watcher wants samples kept, 0 for the hostengine default
+	MaxKeepAge time.Duration
+}
+
+// FieldWatchInfo describes the watch of a field on an entity. The hostengine merges the watches of
+// all watchers, sampling the field at the shortest interval any of them asked for.
+type FieldWatchInfo struct {
+	Entity  Entity
+	FieldID Short
+	// UpdateInterval is how often the field is sampled
+	UpdateInterval time.Duration
+	// MaxKeepAge is how long samples are kept
+	MaxKeepAge time.Duration
+	// Samples is the number of samples currently cached
+	Samples int
+	// Oldest and Newest are the timestamps of the oldest and newest cached samples
+	Oldest, Newest time.Time
+	// Watchers are the watchers of the field; the hostengine reports at most 10
+	Watchers []FieldWatcher
+}
+
+// GetFieldWatchInfo returns the watch of a field on an entity. ok is false if the field is not
+// watched on the entity.
+func GetFieldWatchInfo(entity Entity, field Short) (info FieldWatchInfo, ok bool, err error) {
+	return defaultClient.GetFieldWatchInfo(entity, field)
+}
+
+// GetFieldWatchInfo returns the watch of a field on an entity. ok is false if the field is not
+// watched on the entity.
+func (c *Client) GetFieldWatchInfo(entity Entity, field Short) (info FieldWatchInfo, ok bool, err error) {
+	if err = c.beginCall(); err != nil {
+		return FieldWatchInfo{}, false, err
+	}
+	defer c.endCall()
+
+	return c.getFieldWatchInfo(entity, field)
+}
+
+func (c *Client) getFieldWatchInfo(entity Entity, field Short) (FieldWatchInfo, bool, error) {
+	var cinfo C.dcgmCacheManagerFieldInfo_v4_t
+	cinfo.version = makeVersion4(unsafe.Sizeof(cinfo))
+	cinfo.entityGroupId = C.uint(entity.Group)
+	cinfo.entityId = C.uint(entity.ID)
+	cinfo.fieldId = C.ushort(field)
+
+	result := C.dcgmGetCacheManagerFieldInfo(c.dcgmHandle(), &cinfo)
+	if result == C.DCGM_ST_NOT_WATCHED || result == C.DCGM_ST_NO_DATA {
+		return FieldWatchInfo{}, false, nil
+	}
+	if err := errorString(result); err != nil {
+		return FieldWatchInfo{}, false, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+	if cinfo.flags&C.DCGM_CMI_F_WATCHED == 0 {
+		return FieldWatchInfo{}, false, nil
+	}
+
+	info := FieldWatchInfo{
+		Entity:         entity,
+		FieldID:        field,
+		UpdateInterval: time.Duration(cinfo.monitorIntervalUsec) * time.Microsecond,
+		MaxKeepAge:     time.Duration(cinfo.maxAgeUsec) * time.Microsecond,
+		Samples:        int(cinfo.numSamples),
+	}
+	if cinfo.oldestTimestamp != 0 {
+		info.Oldest = timestampUSECToTime(int64(cinfo.oldestTimestamp))
+	}
+	if cinfo.newestTimestamp != 0 {
+		info.Newest = timestampUSECToTime(int64(cinfo.newestTimestamp))
+	}
+
+	count := min(int(cinfo.numWatchers), int(C.DCGM_CM_FIELD_INFO_NUM_WATCHERS))
+	info.Watchers = make([]FieldWatcher, count)
+	for i := range count {
+		watcher := cinfo.watchers[i]
+		info.Watchers[i] = FieldWatcher{
+			Type:           WatcherType(watcher.watcherType),
+			ConnectionID:   uint(watcher.connectionId),
+			UpdateInterval: time.Duration(watcher.monitorIntervalUsec) * time.Microsecond,
+			MaxKeepAge:     time.Duration(watcher.maxAgeUsec) * time.Microsecond,
+		}
+	}
+
+	return info, true, nil
+}
+
+// ListWatches returns the watched fields of the entities. If fields is empty, every field known
+// to this package is checked. Every field of every entity is queried separately, so checking all
+// fields takes a while. Daemons can use it to find watches held by connections of components that
+// are gone; the hostengine only drops the watches of a connection when it disconnects, unless
+// the connection persists its watches.
+func ListWatches(entities []Entity, fields []Short) ([]FieldWatchInfo, error) {
+	return defaultClient.ListWatches(entities, fields)
+}
+
+// ListWatches returns the watched fields of the entities. If fields is empty, every field known
+// to this package is checked. Every field of every entity is queried separately, so checking all
+// fields takes a while. Daemons can use it to find watches held by connections of components that
+// are gone; the hostengine only drops the watches of a connection when it disconnects, unless
+// the connection persists its watches.
+func (c *Client) ListWatches(entities []Entity, fields []Short) ([]FieldWatchInfo, error) {
+	if len(entities) == 0 {
+		return nil, errors.New("no entities given")
+	}
+	if len(fields) == 0 {
+		fields = knownFieldIDs()
+	}
+
+	if err := c.beginCall(); err != nil {
+		return nil, err
+	}
+	defer c.endCall()
+
+	var watches []FieldWatchInfo
+	for _, entity := range entities {
+		for _, field := range fields {
+			info, ok, err := c.getFieldWatchInfo(entity, field)
+			if err != nil {
+				return nil, fmt.Errorf("error getting watch of field %d on %s: %w", field, entity, err)
+			}
+			if ok {
+				watches = append(watches, info)
+			}
+		}
+	}
+	return watches, nil
+}
+
+// knownFieldIDs returns the IDs of all fields in dcgmFields, in ascending order
+func knownFieldIDs() []Short {
+	return slices.Compact(slices.Sorted(maps.Values(dcgmFields)))
+}
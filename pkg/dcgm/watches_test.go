@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcherType(t *testing.T) {
+	assert.Equal(t, "Client", WatcherClient.String())
+	assert.Equal(t, "NvSwitchManager", WatcherNvSwitchManager.String())
+	assert.Equal(t, "Unknown(42)", WatcherType(42).String())
+}
+
+func TestKnownFieldIDs(t *testing.T) {
+	fields := knownFieldIDs()
+	require.NotEmpty(t, fields)
+	assert.True(t, slices.IsSorted(fields))
+	assert.Equal(t, len(fields), len(slices.Compact(slices.Clone(fields))))
+	assert.Contains(t, fields, DCGM_FI_DEV_GPU_TEMP)
+}
+
+func TestListWatchesInvalid(t *testing.T) {
+	_, err := (&Client{}).ListWatches(nil, nil)
+	require.Error(t, err)
+
+	_, err = (&Client{closing: true}).ListWatches([]Entity{{Group: FE_GPU}}, nil)
+	require.ErrorIs(t, err, ErrClientClosed)
+}
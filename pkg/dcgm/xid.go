@@ -0,0 +1,126 @@
+package dcgm
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// xidWatchInterval is how often WatchXidEvents reads new XIDs
+const xidWatchInterval = time.Second
+
+// xidDescriptions are the descriptions of the XIDs a GPU commonly reports, from the NVIDIA XID
+// catalog
+var xidDescriptions = map[uint]string{
+	8:   "GPU stopped processing",
+	13:  "Graphics engine exception",
+	31:  "GPU memory page fault",
+	32:  "Invalid or corrupted push buffer stream",
+	38:  "Driver firmware error",
+	43:  "GPU stopped processing",
+	45:  "Preemptive cleanup, due to previous errors",
+	48:  "Double bit ECC error",
+	61:  "Internal micro-controller breakpoint/warning",
+	62:  "Internal micro-controller halt",
+	63:  "ECC page retirement or row remapping recording event",
+	64:  "ECC page retirement or row remapper recording failure",
+	68:  "Video processor exception",
+	69:  "Graphics engine class error",
+	74:  "NVLink error",
+	79:  "GPU has fallen off the bus",
+	92:  "High single-bit ECC error rate",
+	94:  "Contained ECC error",
+	95:  "Uncontained ECC error",
+	109: "Context switch timeout error",
+	119: "GSP RPC timeout",
+	120: "GSP error",
+	121: "C2C link corrected error",
+	140: "Unrecovered ECC error",
+	143: "GPU initialization failure",
+	154: "GPU recovery action changed",
+}
+
+// XidDescription returns a short description of xid, or "Unknown XID" for XIDs without one
+func XidDescription(xid uint) string {
+	if description, ok := xidDescriptions[xid]; ok {
+		return description
+	}
+	return "Unknown XID"
+}
+
+// XidEvent is an XID error reported by a GPU
+type XidEvent struct {
+	GPU         uint
+	Xid         uint
+	Timestamp   time.Time
+	Description string
+}
+
+func (e XidEvent) String() string {
+	return fmt.Sprintf("GPU %d: XID %d (%s) at %s", e.GPU, e.Xid, e.Description, e.Timestamp.Format(time.RFC3339))
+}
+
+// WatchXidEvents watches the XIDs of all GPUs and sends one event per XID reported after the call
+// on the returned channel. The channel is closed once ctx is done or the client is closed.
+func WatchXidEvents(ctx context.Context) (<-chan XidEvent, error) {
+	return defaultClient.WatchXidEvents(ctx)
+}
+
+// WatchXidEvents watches the XIDs of all GPUs and sends one event per XID reported after the call
+// on the returned channel. The channel is closed once ctx is done or the client is closed.
+func (c *Client) WatchXidEvents(ctx context.Context) (<-chan XidEvent, error) {
+	fieldGroup, err := c.FieldGroupCreate(fmt.Sprintf("xidEvents%d", rand.Uint64()), []Short{DCGM_FI_DEV_XID_ERRORS})
+	if err != nil {
+		return nil, err
+	}
+
+	opts := WatchOptions{UpdateFreq: xidWatchInterval, MaxKeepAge: 5 * xidWatchInterval}
+	batches, err := c.StreamFieldValuesWithOptions(ctx, GroupAllGPUs(), fieldGroup, opts)
+	if err != nil {
+		_ = c.FieldGroupDestroy(fieldGroup)
+		return nil, err
+	}
+
+	events := make(chan XidEvent, 1)
+	go func() {
+		defer close(events)
+		defer func() { _ = c.FieldGroupDestroy(fieldGroup) }()
+
+		for batch := range batches {
+			for _, value := range batch.Values {
+				event, ok := toXidEvent(value)
+				if !ok {
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					// drain so the stream can unwatch the field and stop
+					for range batches {
+					}
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// toXidEvent converts a DCGM_FI_DEV_XID_ERRORS sample to an event. Blank and failed samples are
+// not XIDs.
+func toXidEvent(value FieldValue_v2) (XidEvent, bool) {
+	xid, ok := value.Typed().AsInt64()
+	if !ok || xid <= 0 {
+		return XidEvent{}, false
+	}
+
+	return XidEvent{
+		GPU:         value.EntityID,
+		Xid:         uint(xid),
+		Timestamp:   timestampUSECToTime(value.TS),
+		Description: XidDescription(uint(xid)),
+	}, true
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dcgm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToXidEvent(t *testing.T) {
+	gpu := Entity{Group: FE_GPU, ID: 2}
+
+	event, ok := toXidEvent(fakeFieldValue(gpu, DCGM_FI_DEV_XID_ERRORS, 79, 1_000_000))
+	require.True(t, ok)
+	assert.Equal(t, XidEvent{
+		GPU:         2,
+		Xid:         79,
+		Timestamp:   timestampUSECToTime(1_000_000),
+		Description: "GPU has fallen off the bus",
+	}, event)
+
+	event, ok = toXidEvent(fakeFieldValue(gpu, DCGM_FI_DEV_XID_ERRORS, 9999, 1_000_000))
+	require.True(t, ok)
+	assert.Equal(t, "Unknown XID", event.Description)
+
+	_, ok = toXidEvent(fakeFieldValue(gpu, DCGM_FI_DEV_XID_ERRORS, 0, 1_000_000))
+	assert.False(t, ok)
+	_, ok = toXidEvent(fakeFieldValue(gpu, DCGM_FI_DEV_XID_ERRORS, DCGM_FT_INT64_BLANK, 1_000_000))
+	assert.False(t, ok)
+}
+
+func TestWatchXidEventsClosedClient(t *testing.T) {
+	_, err := (&Client{closing: true}).WatchXidEvents(context.Background())
+	require.ErrorIs(t, err, ErrClientClosed)
+}